@@ -0,0 +1,544 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/reddit"
+	"github.com/spf13/cobra"
+)
+
+// DefaultCacheWarmPastDays covers the TUI's 5-day stats view: today plus
+// the four days before it.
+const DefaultCacheWarmPastDays = 4
+
+// Names of the on-disk caches reported by `golazo cache`.
+const (
+	cacheEmptyResults = "empty_results"
+	cachePageURLs     = "page_urls"
+	cacheGoalLinks    = "goal_links"
+	cacheLiveUpdates  = "live_updates"
+	cacheVersionCheck = "version_check"
+	cacheMatchDetails = "match_details"
+)
+
+// cacheStat describes one on-disk cache. For live_updates every
+// updates_<id>.json file counts as one entry. match_details has no TTL, so
+// its expired count is the files that are corrupted or use an old schema.
+type cacheStat struct {
+	Name      string `json:"name"`
+	Path      string `json:"path"`
+	Entries   int    `json:"entries"`
+	Expired   int    `json:"expired"`
+	SizeBytes int64  `json:"size_bytes"`
+}
+
+// cacheChange is a cacheStat taken after `prune` or `clear`, with the number
+// of entries that were removed.
+type cacheChange struct {
+	cacheStat
+	Removed int `json:"removed"`
+}
+
+// cacheStore ties a cache name to its files and its TTL handling.
+type cacheStore struct {
+	name string
+	// files returns the display path and the files currently backing the store.
+	files func() (path string, files []string, err error)
+	// count returns the number of entries and how many are past their TTL.
+	count func() (entries, expired int, err error)
+	// prune drops expired entries and returns how many were removed.
+	prune func() (removed int, err error)
+}
+
+// cacheStores lists every cache golazo keeps on disk, in output order.
+var cacheStores = []cacheStore{
+	{
+		name:  cacheEmptyResults,
+		files: singleCacheFile(data.ConfigDir, fotmob.EmptyCacheFileName),
+		count: func() (int, int, error) {
+			c, err := fotmob.OpenEmptyResultsCache()
+			if err != nil {
+				return 0, 0, err
+			}
+			total, expired := c.Stats()
+			return total, expired, nil
+		},
+		prune: func() (int, error) {
+			c, err := fotmob.OpenEmptyResultsCache()
+			if err != nil {
+				return 0, err
+			}
+			removed := c.Prune()
+			if removed == 0 {
+				return 0, nil
+			}
+			return removed, c.Save()
+		},
+	},
+	{
+		name:  cachePageURLs,
+		files: singleCacheFile(data.CacheDir, fotmob.PageURLCacheFileName),
+		count: func() (int, int, error) {
+			c, err := fotmob.OpenPageURLCache()
+			if err != nil {
+				return 0, 0, err
+			}
+			total, expired := c.Stats()
+			return total, expired, nil
+		},
+		prune: func() (int, error) {
+			c, err := fotmob.OpenPageURLCache()
+			if err != nil {
+				return 0, err
+			}
+			removed := c.Prune()
+			if removed == 0 {
+				return 0, nil
+			}
+			return removed, c.Save()
+		},
+	},
+	{
+		name:  cacheGoalLinks,
+		files: singleCacheFile(data.ConfigDir, reddit.GoalLinksFileName),
+		count: func() (int, int, error) {
+			c, err := reddit.OpenGoalLinkCache()
+			if err != nil {
+				return 0, 0, err
+			}
+			return c.Size(), c.Expired(), nil
+		},
+		prune: func() (int, error) {
+			c, err := reddit.OpenGoalLinkCache()
+			if err != nil {
+				return 0, err
+			}
+			before := c.Size()
+			if err := c.CleanExpired(); err != nil {
+				return 0, err
+			}
+			return before - c.Size(), nil
+		},
+	},
+	{
+		name: cacheLiveUpdates,
+		files: func() (string, []string, error) {
+			dir, err := data.ConfigDir()
+			if err != nil {
+				return "", nil, err
+			}
+			files, err := data.LiveUpdateFiles()
+			return filepath.Join(dir, "updates_*.json"), files, err
+		},
+		count: func() (int, int, error) {
+			files, err := data.LiveUpdateFiles()
+			if err != nil {
+				return 0, 0, err
+			}
+			return len(files), len(staleFiles(files, data.LiveUpdatesTTL)), nil
+		},
+		prune: func() (int, error) {
+			files, err := data.LiveUpdateFiles()
+			if err != nil {
+				return 0, err
+			}
+			return removeFiles(staleFiles(files, data.LiveUpdatesTTL))
+		},
+	},
+	{
+		name:  cacheVersionCheck,
+		files: singleCacheFile(data.ConfigDir, data.LatestVersionFileName),
+		count: func() (int, int, error) {
+			files, err := versionCheckFiles()
+			if err != nil {
+				return 0, 0, err
+			}
+			return len(files), len(staleFiles(files, data.VersionCheckInterval)), nil
+		},
+		prune: func() (int, error) {
+			files, err := versionCheckFiles()
+			if err != nil {
+				return 0, err
+			}
+			return removeFiles(staleFiles(files, data.VersionCheckInterval))
+		},
+	},
+	{
+		name:  cacheMatchDetails,
+		files: matchDetailsFiles,
+		count: func() (int, int, error) {
+			s, err := fotmob.OpenDetailsStore()
+			if err != nil {
+				return 0, 0, err
+			}
+			total, unusable := s.Stats()
+			return total, unusable, nil
+		},
+		prune: func() (int, error) {
+			s, err := fotmob.OpenDetailsStore()
+			if errors.Is(err, os.ErrNotExist) {
+				return 0, nil
+			}
+			if err != nil {
+				return 0, err
+			}
+			return s.Prune(), nil
+		},
+	},
+}
+
+// matchDetailsFiles backs the finished match details store: one
+// <matchID>.json file per match in its own directory.
+func matchDetailsFiles() (string, []string, error) {
+	dir, err := fotmob.DetailsStoreDir()
+	if err != nil {
+		return "", nil, err
+	}
+	pattern := filepath.Join(dir, "*.json")
+	files, err := filepath.Glob(pattern)
+	return pattern, files, err
+}
+
+// singleCacheFile backs a store with one file in dir, if it exists.
+func singleCacheFile(dir func() (string, error), name string) func() (string, []string, error) {
+	return func() (string, []string, error) {
+		d, err := dir()
+		if err != nil {
+			return "", nil, err
+		}
+		path := filepath.Join(d, name)
+		if _, err := os.Stat(path); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return path, nil, nil
+			}
+			return path, nil, err
+		}
+		return path, []string{path}, nil
+	}
+}
+
+func versionCheckFiles() ([]string, error) {
+	_, files, err := singleCacheFile(data.ConfigDir, data.LatestVersionFileName)()
+	return files, err
+}
+
+// staleFiles returns the files last modified more than ttl ago.
+func staleFiles(files []string, ttl time.Duration) []string {
+	var out []string
+	for _, f := range files {
+		if info, err := os.Stat(f); err == nil && time.Since(info.ModTime()) > ttl {
+			out = append(out, f)
+		}
+	}
+	return out
+}
+
+// removeFiles deletes files, ignoring ones that are already gone, and
+// returns how many it removed.
+func removeFiles(files []string) (int, error) {
+	removed := 0
+	for _, f := range files {
+		err := os.Remove(f)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+// statCacheStore reports the current state of s.
+func statCacheStore(s cacheStore) (cacheStat, error) {
+	path, files, err := s.files()
+	if err != nil {
+		return cacheStat{}, fmt.Errorf("%s: %w", s.name, err)
+	}
+	st := cacheStat{Name: s.name, Path: path}
+	for _, f := range files {
+		if info, err := os.Stat(f); err == nil {
+			st.SizeBytes += info.Size()
+		}
+	}
+	if len(files) > 0 {
+		if st.Entries, st.Expired, err = s.count(); err != nil {
+			return cacheStat{}, fmt.Errorf("%s: %w", s.name, err)
+		}
+	}
+	return st, nil
+}
+
+// collectCacheStats reports every store in cacheStores order.
+func collectCacheStats() ([]cacheStat, error) {
+	out := make([]cacheStat, 0, len(cacheStores))
+	for _, s := range cacheStores {
+		st, err := statCacheStore(s)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, st)
+	}
+	return out, nil
+}
+
+// runCacheStats is the testable core of `cache stats`.
+func runCacheStats(stdout, stderr io.Writer, flags cliFlags) int {
+	applyPretty(flags)
+
+	stats, err := collectCacheStats()
+	if err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+	if err := WriteJSON(stdout, stats); err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+	return ExitOK
+}
+
+// runCacheChange is the testable core of `cache prune` (all false) and
+// `cache clear` (all true): it drops expired entries, or every file, from
+// each store and reports the state afterwards.
+func runCacheChange(stdout, stderr io.Writer, all bool, flags cliFlags) int {
+	applyPretty(flags)
+
+	out := make([]cacheChange, 0, len(cacheStores))
+	for _, s := range cacheStores {
+		var removed int
+		if all {
+			before, err := statCacheStore(s)
+			if err != nil {
+				return WriteError(stderr, ErrCodeUpstreamError, err)
+			}
+			_, files, err := s.files()
+			if err == nil {
+				_, err = removeFiles(files)
+			}
+			if err != nil {
+				return WriteError(stderr, ErrCodeUpstreamError, fmt.Errorf("%s: %w", s.name, err))
+			}
+			removed = before.Entries
+		} else {
+			var err error
+			if removed, err = s.prune(); err != nil {
+				return WriteError(stderr, ErrCodeUpstreamError, fmt.Errorf("%s: %w", s.name, err))
+			}
+		}
+
+		after, err := statCacheStore(s)
+		if err != nil {
+			return WriteError(stderr, ErrCodeUpstreamError, err)
+		}
+		out = append(out, cacheChange{cacheStat: after, Removed: removed})
+	}
+
+	if err := WriteJSON(stdout, out); err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+	return ExitOK
+}
+
+// cacheWarmFlags extends the common flag set with --past-days.
+type cacheWarmFlags struct {
+	cliFlags
+	pastDays int
+}
+
+var (
+	cacheStatsFlagSet cliFlags
+	cachePruneFlagSet cliFlags
+	cacheClearFlagSet cliFlags
+	cacheWarmFlagSet  cacheWarmFlags
+)
+
+// runCacheWarm is the testable core of `cache warm`. It fetches today's slate
+// and the results of the previous --past-days days across the active leagues,
+// the same queries the TUI makes at startup, so the page-slug and
+// empty-result caches are filled when it opens. It then fetches the details of
+// the finished matches into the match details store, which the stats view
+// reads instead of the network. With --mock nothing is fetched. Prints the
+// cache stats afterwards.
+func runCacheWarm(stdout, stderr io.Writer, flags cacheWarmFlags) int {
+	applyPretty(flags.cliFlags)
+
+	if flags.pastDays < 0 || flags.pastDays > MaxFinishedDays {
+		return WriteError(stderr, ErrCodeInvalidArgs,
+			NewInvalidArg("--past-days must be between 0 and %d, got %d", MaxFinishedDays, flags.pastDays))
+	}
+
+	client, ctx, cancel, err := newHeadlessClient(runtimeOpts{
+		mock:    flags.mock,
+		debug:   flags.debug,
+		timeout: flags.timeout,
+	})
+	defer cancel()
+	if err == ErrOffline {
+		return WriteError(stderr, ErrCodeOffline, err)
+	}
+	if err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+
+	var failedDates []string
+	if !flags.mock {
+		var matches []api.Match
+		matches, failedDates, err = collectCalendar(ctx, defaultFinishedFetcher(client, nil), time.Now(), flags.pastDays, 0)
+		if err != nil {
+			return WriteError(stderr, ClassifyClientError(err, isTimeout(ctx)), err)
+		}
+		if isTimeout(ctx) {
+			return WriteError(stderr, ErrCodeTimeout,
+				fmt.Errorf("cache warm timed out after %s", flags.timeout))
+		}
+		if err := savePageURLs(client); err != nil {
+			return WriteError(stderr, ErrCodeUpstreamError, err)
+		}
+		warmMatchDetails(ctx, client.MatchDetails, matches)
+	}
+
+	stats, err := collectCacheStats()
+	if err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+	if len(failedDates) > 0 {
+		err = WriteDegraded(stdout, stats, failedDates)
+	} else {
+		err = WriteJSON(stdout, stats)
+	}
+	if err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+	return ExitOK
+}
+
+// warmMatchDetails fetches the details of the finished matches, newest first,
+// so the client stores them on disk. It is best-effort: failed fetches are
+// skipped and it stops when ctx expires, leaving the rest for the TUI to
+// fetch on demand. Returns how many details were fetched.
+func warmMatchDetails(ctx context.Context, fetch func(context.Context, int) (*api.MatchDetails, error), matches []api.Match) int {
+	finished := make([]api.Match, 0, len(matches))
+	for _, m := range matches {
+		if m.Status == api.MatchStatusFinished {
+			finished = append(finished, m)
+		}
+	}
+	sort.SliceStable(finished, func(i, j int) bool {
+		a, b := finished[i].MatchTime, finished[j].MatchTime
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return a.After(*b)
+	})
+
+	fetched := 0
+	for _, m := range finished {
+		if ctx.Err() != nil {
+			break
+		}
+		if details, err := fetch(ctx, m.ID); err == nil && details != nil {
+			fetched++
+		}
+	}
+	return fetched
+}
+
+var cacheCmd = &cobra.Command{
+	Use:   "cache",
+	Short: "Inspect, prune, clear and warm golazo's on-disk caches",
+	Long: `Manages the state golazo keeps on disk. Pick a subcommand:
+
+  golazo cache stats   entries, expired entries and size of every cache
+  golazo cache prune   drop entries past their TTL
+  golazo cache clear   delete every cache file
+  golazo cache warm    prefetch today's slate and recent match details
+
+Caches: empty_results (league+date pairs with no matches, 7 days; future "no fixtures" markers 1 day), page_urls (match page slugs, 30 days), goal_links (Reddit replay links, 7 days; "not found" markers 1 hour), live_updates (updates_<id>.json files, 7 days since last write), match_details (finished match details, one file per match; no TTL, least recently used evicted past 64 MiB) and version_check (latest release, 24 hours).`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+}
+
+var cacheStatsCmd = &cobra.Command{
+	Use:   "stats",
+	Short: "Report entry counts, expired entries and sizes of the on-disk caches",
+	Long: `Prints one object per cache with its path, entry count, expired entry count and size in bytes. Reading does not prune. No network calls.
+
+Example output:
+  {"status":"ok","count":6,"data":[{"name":"empty_results","path":"/home/me/.config/golazo/empty-results.json","entries":212,"expired":9,"size_bytes":11876}]}`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		code := runCacheStats(os.Stdout, os.Stderr, cacheStatsFlagSet)
+		if code != ExitOK {
+			os.Exit(code)
+		}
+	},
+}
+
+var cachePruneCmd = &cobra.Command{
+	Use:           "prune",
+	Short:         "Drop cache entries past their TTL",
+	Long:          `Applies each cache's TTL and prints the stats afterwards, with "removed" set to the number of entries dropped. No network calls.`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		code := runCacheChange(os.Stdout, os.Stderr, false, cachePruneFlagSet)
+		if code != ExitOK {
+			os.Exit(code)
+		}
+	},
+}
+
+var cacheClearCmd = &cobra.Command{
+	Use:           "clear",
+	Short:         "Delete every cache file",
+	Long:          `Removes every cache file and prints the stats afterwards, with "removed" set to the number of entries deleted. Settings are not touched. Restart a running TUI to drop its in-memory caches too. No network calls.`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		code := runCacheChange(os.Stdout, os.Stderr, true, cacheClearFlagSet)
+		if code != ExitOK {
+			os.Exit(code)
+		}
+	},
+}
+
+var cacheWarmCmd = &cobra.Command{
+	Use:   "warm",
+	Short: "Prefetch today's slate and recent match details into the on-disk caches",
+	Long: `Fetches today's fixtures and results, plus the results of the previous --past-days days (default 4, the TUI's 5-day stats view), across the active leagues. This persists the match page slugs and the leagues with no matches on each past day, so the TUI and later CLI calls skip those lookups. It then fetches the details of the finished matches, newest first, into the match_details store, so the stats view opens them without a network call.
+
+League listings and live matches are not persisted: the TUI still fetches today's slate when it starts. Details not fetched before --timeout are skipped and fetched on demand; raise --timeout to warm more. Prints the cache stats afterwards; partial failures set degraded and failed_dates.
+
+Example:
+  golazo cache warm --timeout 60s`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		code := runCacheWarm(os.Stdout, os.Stderr, cacheWarmFlagSet)
+		if code != ExitOK {
+			os.Exit(code)
+		}
+	},
+}
+
+func init() {
+	addPrettyOnlyFlag(cacheStatsCmd, &cacheStatsFlagSet)
+	addPrettyOnlyFlag(cachePruneCmd, &cachePruneFlagSet)
+	addPrettyOnlyFlag(cacheClearCmd, &cacheClearFlagSet)
+	addCommonCLIFlags(cacheWarmCmd, &cacheWarmFlagSet.cliFlags)
+	cacheWarmCmd.Flags().IntVar(&cacheWarmFlagSet.pastDays, "past-days", DefaultCacheWarmPastDays, "Also fetch results for the last N days (0..7)")
+
+	cacheCmd.AddCommand(cacheStatsCmd, cachePruneCmd, cacheClearCmd, cacheWarmCmd)
+	rootCmd.AddCommand(cacheCmd)
+}
@@ -0,0 +1,237 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/reddit"
+)
+
+// seedCaches points the config and cache dirs at a temp dir and writes one
+// fresh and one expired entry into each cache.
+func seedCaches(t *testing.T) (configDir string) {
+	t.Helper()
+	tmp := t.TempDir()
+	t.Setenv("HOME", tmp)
+	t.Setenv("XDG_CONFIG_HOME", tmp)
+	t.Setenv("XDG_CACHE_HOME", filepath.Join(tmp, "cache"))
+	configDir = filepath.Join(tmp, "golazo")
+	cacheDir := filepath.Join(tmp, "cache", "golazo")
+	for _, dir := range []string{configDir, cacheDir} {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	now := time.Now()
+	writeJSONFile(t, filepath.Join(configDir, fotmob.EmptyCacheFileName), fotmob.EmptyCacheData{
+		Version: 1,
+		EmptyResults: map[string]fotmob.EmptyCacheEntry{
+			"2026-10-10:47": {Expires: now.Add(time.Hour)},
+			"2026-09-01:47": {Expires: now.Add(-time.Hour)},
+		},
+	})
+	writeJSONFile(t, filepath.Join(cacheDir, fotmob.PageURLCacheFileName), fotmob.PageURLCacheData{
+		Version: 1,
+		PageURLs: map[int]fotmob.PageURLEntry{
+			1: {PageURL: "/matches/a-vs-b/1", Seen: now},
+			2: {PageURL: "/matches/c-vs-d/2", Seen: now.Add(-fotmob.PageURLCacheExpiry - time.Hour)},
+		},
+	})
+	writeJSONFile(t, filepath.Join(configDir, reddit.GoalLinksFileName), []reddit.GoalLink{
+		{MatchID: 1, Minute: 10, URL: "https://example.com/goal", FetchedAt: now},
+		{MatchID: 1, Minute: 20, URL: reddit.NotFoundMarker, FetchedAt: now.Add(-2 * reddit.NotFoundTTL)},
+	})
+
+	detailsDir := filepath.Join(cacheDir, fotmob.DetailsStoreDirName)
+	if err := os.MkdirAll(detailsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	writeJSONFile(t, filepath.Join(detailsDir, "1.json"), map[string]any{
+		"version": fotmob.DetailsStoreVersion,
+		"details": map[string]any{"id": 1, "status": "finished"},
+	})
+	if err := os.WriteFile(filepath.Join(detailsDir, "2.json"), []byte(`{"version":1,"deta`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	old := now.Add(-8 * 24 * time.Hour)
+	for _, name := range []string{"updates_1.json", "updates_2.json", "latest_version.txt"} {
+		path := filepath.Join(configDir, name)
+		if err := os.WriteFile(path, []byte("[]"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if name != "updates_1.json" {
+			if err := os.Chtimes(path, old, old); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	return configDir
+}
+
+func writeJSONFile(t *testing.T, path string, v any) {
+	t.Helper()
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, b, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func decodeCacheChanges(t *testing.T, raw []byte) map[string]cacheChange {
+	t.Helper()
+	var env struct {
+		Data []cacheChange `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &env); err != nil {
+		t.Fatalf("unmarshal: %v (%s)", err, raw)
+	}
+	out := make(map[string]cacheChange, len(env.Data))
+	for _, c := range env.Data {
+		out[c.Name] = c
+	}
+	return out
+}
+
+func TestRunCacheStats_CountsExpiredWithoutPruning(t *testing.T) {
+	configDir := seedCaches(t)
+
+	var stdout, stderr bytes.Buffer
+	if code := runCacheStats(&stdout, &stderr, cliFlags{}); code != ExitOK {
+		t.Fatalf("exit = %d, stderr=%s", code, stderr.String())
+	}
+	got := decodeCacheChanges(t, stdout.Bytes())
+	want := map[string][2]int{
+		cacheEmptyResults: {2, 1},
+		cachePageURLs:     {2, 1},
+		cacheGoalLinks:    {2, 1},
+		cacheLiveUpdates:  {2, 1},
+		cacheVersionCheck: {1, 1},
+		cacheMatchDetails: {2, 1},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("caches = %d, want %d", len(got), len(want))
+	}
+	for name, w := range want {
+		st := got[name]
+		if st.Entries != w[0] || st.Expired != w[1] {
+			t.Errorf("%s entries/expired = %d/%d, want %d/%d", name, st.Entries, st.Expired, w[0], w[1])
+		}
+		if st.SizeBytes == 0 || st.Path == "" {
+			t.Errorf("%s size = %d, path = %q", name, st.SizeBytes, st.Path)
+		}
+	}
+
+	// Stats must not rewrite the goal link file (NewGoalLinkCache would).
+	links, err := os.ReadFile(filepath.Join(configDir, reddit.GoalLinksFileName))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var onDisk []reddit.GoalLink
+	if err := json.Unmarshal(links, &onDisk); err != nil || len(onDisk) != 2 {
+		t.Errorf("goal links on disk = %d (%v), want 2", len(onDisk), err)
+	}
+}
+
+func TestRunCacheChange_PruneThenClear(t *testing.T) {
+	configDir := seedCaches(t)
+
+	var stdout, stderr bytes.Buffer
+	if code := runCacheChange(&stdout, &stderr, false, cliFlags{}); code != ExitOK {
+		t.Fatalf("prune exit = %d, stderr=%s", code, stderr.String())
+	}
+	for name, c := range decodeCacheChanges(t, stdout.Bytes()) {
+		wantEntries := 1
+		if name == cacheVersionCheck {
+			wantEntries = 0
+		}
+		if c.Removed != 1 || c.Expired != 0 || c.Entries != wantEntries {
+			t.Errorf("%s after prune = %+v, want removed 1, expired 0, entries %d", name, c, wantEntries)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(configDir, "updates_1.json")); err != nil {
+		t.Errorf("fresh updates file pruned: %v", err)
+	}
+
+	stdout.Reset()
+	if code := runCacheChange(&stdout, &stderr, true, cliFlags{}); code != ExitOK {
+		t.Fatalf("clear exit = %d, stderr=%s", code, stderr.String())
+	}
+	for name, c := range decodeCacheChanges(t, stdout.Bytes()) {
+		if c.Entries != 0 || c.SizeBytes != 0 {
+			t.Errorf("%s after clear = %+v, want empty", name, c)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(configDir, fotmob.EmptyCacheFileName)); !os.IsNotExist(err) {
+		t.Errorf("empty results file still present: %v", err)
+	}
+}
+
+func TestRunCacheWarm_MockAndInvalidArgs(t *testing.T) {
+	seedCaches(t)
+	t.Setenv(EnvOffline, "")
+
+	var stdout, stderr bytes.Buffer
+	code := runCacheWarm(&stdout, &stderr, cacheWarmFlags{cliFlags: cliFlags{mock: true, timeout: time.Second}, pastDays: DefaultCacheWarmPastDays})
+	if code != ExitOK {
+		t.Fatalf("exit = %d, stderr=%s", code, stderr.String())
+	}
+	if got := decodeCacheChanges(t, stdout.Bytes()); len(got) != len(cacheStores) {
+		t.Errorf("caches = %d, want %d", len(got), len(cacheStores))
+	}
+
+	stdout.Reset()
+	if code := runCacheWarm(&stdout, &stderr, cacheWarmFlags{cliFlags: cliFlags{mock: true}, pastDays: 8}); code != ExitInvalidArgs {
+		t.Errorf("exit = %d, want %d", code, ExitInvalidArgs)
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("stdout should be empty on invalid args, got: %s", stdout.String())
+	}
+}
+
+func TestWarmMatchDetails_FinishedNewestFirst(t *testing.T) {
+	day := func(d int) *time.Time {
+		ts := time.Date(2026, 3, d, 15, 0, 0, 0, time.UTC)
+		return &ts
+	}
+	matches := []api.Match{
+		{ID: 1, Status: api.MatchStatusFinished, MatchTime: day(8)},
+		{ID: 2, Status: api.MatchStatusNotStarted, MatchTime: day(10)},
+		{ID: 3, Status: api.MatchStatusFinished, MatchTime: day(10)},
+		{ID: 4, Status: api.MatchStatusFinished, MatchTime: day(9)},
+	}
+
+	var order []int
+	fetch := func(_ context.Context, id int) (*api.MatchDetails, error) {
+		order = append(order, id)
+		if id == 4 {
+			return nil, errors.New("boom")
+		}
+		return &api.MatchDetails{}, nil
+	}
+	if got := warmMatchDetails(context.Background(), fetch, matches); got != 2 {
+		t.Errorf("fetched = %d, want 2", got)
+	}
+	if want := []int{3, 4, 1}; !reflect.DeepEqual(order, want) {
+		t.Errorf("fetch order = %v, want %v", order, want)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	order = nil
+	if got := warmMatchDetails(ctx, fetch, matches); got != 0 || len(order) != 0 {
+		t.Errorf("expired ctx fetched %d (%v), want none", got, order)
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"io"
 	"os"
 
+	"github.com/0xjuanma/golazo/internal/cassette"
+	"github.com/0xjuanma/golazo/internal/provider"
 	"github.com/spf13/cobra"
 )
 
@@ -56,12 +58,84 @@ func buildCapabilities() capabilities {
 	prettyOnly := []capabilityFlag{
 		{Name: "pretty", Type: "bool", Default: false, Description: "Indent JSON output"},
 	}
-	finishedFlagDefs := append([]capabilityFlag{}, commonFlags...)
+	// List subcommands also accept --format; GOLAZO_AGENT forces json.
+	formatFlag := capabilityFlag{Name: "format", Type: "string", Default: "json", Description: "Output format: json, table, csv or md (GOLAZO_AGENT=1 forces json)"}
+	listFlagDefs := append(append([]capabilityFlag{}, commonFlags...), formatFlag)
+	// live and finished also filter; --league narrows the fetch itself.
+	filterFlagDefs := []capabilityFlag{
+		{Name: "league", Type: "[]string", Description: "Only these leagues, by ID or name; narrows the fetch (repeatable)"},
+		{Name: "team", Type: "[]string", Description: "Only matches involving this team, by ID or diacritic-insensitive name fragment (repeatable)"},
+		{Name: "status", Type: "[]string", Description: "Only matches with this status: not_started, live, finished, postponed, cancelled (repeatable)"},
+	}
+	liveFlagDefs := append(append([]capabilityFlag{}, listFlagDefs...), filterFlagDefs...)
+	finishedFlagDefs := append([]capabilityFlag{}, liveFlagDefs...)
 	finishedFlagDefs = append(finishedFlagDefs,
 		capabilityFlag{Name: "days", Type: "int", Default: 1, Description: "Number of days to look back (1..7)"},
 		capabilityFlag{Name: "include-upcoming", Type: "bool", Default: false, Description: "Also include today's not-yet-started matches"},
 	)
+	fixturesFlagDefs := append([]capabilityFlag{}, listFlagDefs...)
+	fixturesFlagDefs = append(fixturesFlagDefs,
+		capabilityFlag{Name: "days-ahead", Type: "int", Default: 7, Description: "Number of days to look ahead (1..14)"},
+	)
+	icalFlagDefs := []capabilityFlag{
+		{Name: "mock", Type: "bool", Default: false, Description: "Use bundled mock data, no network"},
+		{Name: "debug", Type: "bool", Default: false, Description: "Emit debug logs to stderr"},
+		{Name: "timeout", Type: "duration", Default: "15s", Description: "Overall request timeout"},
+		{Name: "days-ahead", Type: "int", Default: 7, Description: "Number of days to look ahead (0..14)"},
+		{Name: "past-days", Type: "int", Default: 0, Description: "Also include finished matches from the last N days, with the final score (0..7)"},
+		filterFlagDefs[0],
+		filterFlagDefs[1],
+	}
+	matchFlagDefs := append([]capabilityFlag{}, commonFlags...)
+	matchFlagDefs = append(matchFlagDefs,
+		capabilityFlag{Name: "date", Type: "string", Description: "Kickoff date hint (YYYY-MM-DD) used to find the match page"},
+		capabilityFlag{Name: "league", Type: "[]string", Description: "League ID or name hint used to find the match page (repeatable)"},
+		capabilityFlag{Name: "page-url", Type: "string", Description: "FotMob match page slug or URL (the page_url field); skips the lookup"},
+		capabilityFlag{Name: "commentary", Type: "bool", Default: false, Description: "Include the live commentary in match order"},
+		capabilityFlag{Name: "h2h", Type: "bool", Default: false, Description: "Include the head-to-head record and recent meetings of the two teams"},
+	)
+	teamFlagDefs := append([]capabilityFlag{}, commonFlags...)
+	teamFlagDefs = append(teamFlagDefs,
+		capabilityFlag{Name: "league", Type: "[]string", Description: "Leagues searched for a team name, by ID or name (repeatable; default: active leagues)"},
+	)
+	topScorersFlagDefs := append([]capabilityFlag{}, listFlagDefs...)
+	topScorersFlagDefs = append(topScorersFlagDefs,
+		capabilityFlag{Name: "stat", Type: "string", Default: "goals", Description: "Stat to rank by: goals, assists, rating, clean_sheets, yellow_cards or red_cards"},
+		capabilityFlag{Name: "season", Type: "string", Description: "Season, e.g. 2024/2025, or a year for tournaments (default: current)"},
+	)
+	watchFlagDefs := []capabilityFlag{
+		{Name: "mock", Type: "bool", Default: false, Description: "Use bundled mock data, no network"},
+		{Name: "debug", Type: "bool", Default: false, Description: "Emit debug logs to stderr"},
+		{Name: "timeout", Type: "duration", Default: "15s", Description: "Per-poll request timeout"},
+		{Name: "match", Type: "[]int", Description: "Only stream these match IDs (repeatable)"},
+		{Name: "league", Type: "[]int", Description: "Only stream these league IDs (repeatable; default: active leagues)"},
+		{Name: "team", Type: "string", Description: "Only stream matches for this team (ID or name fragment)"},
+	}
+	mcpFlagDefs := []capabilityFlag{
+		{Name: "mock", Type: "bool", Default: false, Description: "Use bundled mock data, no network"},
+		{Name: "debug", Type: "bool", Default: false, Description: "Emit debug logs to stderr"},
+		{Name: "timeout", Type: "duration", Default: "15s", Description: "Per-tool-call request timeout"},
+	}
+	serveFlagDefs := []capabilityFlag{
+		{Name: "addr", Type: "string", Default: "127.0.0.1:8080", Description: "Listen address (host:port)"},
+		{Name: "mock", Type: "bool", Default: false, Description: "Use bundled mock data, no network"},
+		{Name: "debug", Type: "bool", Default: false, Description: "Emit debug logs to stderr"},
+		{Name: "timeout", Type: "duration", Default: "15s", Description: "Per-request upstream timeout"},
+	}
+	wcFlagDefs := append([]capabilityFlag{}, commonFlags...)
+	wcFlagDefs = append(wcFlagDefs,
+		capabilityFlag{Name: "season", Type: "string", Description: "Tournament year, e.g. 2022 or 2026 (default: current)"},
+	)
+	wcUpcomingFlagDefs := append([]capabilityFlag{}, listFlagDefs...)
+	wcUpcomingFlagDefs = append(wcUpcomingFlagDefs,
+		capabilityFlag{Name: "days-ahead", Type: "int", Default: DefaultWCUpcomingDaysAhead, Description: "Number of days to look ahead (1..14)"},
+	)
+	cacheWarmFlagDefs := append([]capabilityFlag{}, commonFlags...)
+	cacheWarmFlagDefs = append(cacheWarmFlagDefs,
+		capabilityFlag{Name: "past-days", Type: "int", Default: DefaultCacheWarmPastDays, Description: "Also fetch results for the last N days (0..7)"},
+	)
 	leaguesFlagDefs := append([]capabilityFlag{}, prettyOnly...)
+	leaguesFlagDefs = append(leaguesFlagDefs, formatFlag)
 	leaguesFlagDefs = append(leaguesFlagDefs,
 		capabilityFlag{Name: "all", Type: "bool", Default: false, Description: "List every supported league, not just the active selection"},
 	)
@@ -69,30 +143,83 @@ func buildCapabilities() capabilities {
 	return capabilities{
 		SchemaVersion: CapabilitiesSchemaVersion,
 		Tool:          "golazo",
-		Description:   "JSON CLI for football match data (live, finished, details, leagues)",
+		Description:   "JSON CLI for football match data (live, finished, fixtures, details, standings, leagues, World Cup) plus an NDJSON live event stream (watch), an iCalendar export (ical) on-disk cache maintenance (cache) and environment diagnostics (doctor)",
 		Docs:          "https://github.com/0xjuanma/golazo/blob/main/docs/CLI.md",
 		Commands: []capabilityCommand{
 			{
 				Name:        "live",
-				Description: "List live matches across active leagues",
-				Flags:       commonFlags,
-				Example:     "golazo live",
-				ExitCodes:   []int{ExitOK, ExitUpstream, ExitTimeout, ExitOffline},
+				Description: "List live matches across active leagues, optionally filtered by league, team and status",
+				Flags:       liveFlagDefs,
+				Example:     "golazo live --league \"Premier League\" --team arsenal",
+				ExitCodes:   []int{ExitOK, ExitUpstream, ExitInvalidArgs, ExitTimeout, ExitOffline, ExitCircuitOpen},
 			},
 			{
 				Name:        "finished",
 				Description: "List finished matches over a day window; optionally include today's upcoming matches",
 				Flags:       finishedFlagDefs,
 				Example:     "golazo finished --days 3 --include-upcoming",
-				ExitCodes:   []int{ExitOK, ExitUpstream, ExitInvalidArgs, ExitTimeout, ExitOffline},
+				ExitCodes:   []int{ExitOK, ExitUpstream, ExitInvalidArgs, ExitTimeout, ExitOffline, ExitCircuitOpen},
+			},
+			{
+				Name:        "fixtures",
+				Description: "List not-yet-started matches from today through the next N days across active leagues",
+				Flags:       fixturesFlagDefs,
+				Example:     "golazo fixtures --days-ahead 7",
+				ExitCodes:   []int{ExitOK, ExitUpstream, ExitInvalidArgs, ExitTimeout, ExitOffline, ExitCircuitOpen},
 			},
 			{
 				Name:        "match",
-				Description: "Get full match details (events, lineups, stats). BEST-EFFORT ONLY — cold calls against arbitrary IDs typically fail with upstream_error due to a FotMob slug-cache constraint. Reliable only with --mock or from the TUI. Not recommended for production agent pipelines.",
+				Description: "Get full match details (events, lineups with per-player stats, stats); --h2h adds the head-to-head record. The match page slug is resolved from persisted slugs or the active league pages; use --league/--date hints or --page-url for matches outside the active leagues.",
+				Args:        "<id>",
+				Flags:       matchFlagDefs,
+				Example:     "golazo match 4506424 --league 47",
+				ExitCodes:   []int{ExitOK, ExitUpstream, ExitInvalidArgs, ExitNotFound, ExitTimeout, ExitOffline, ExitCircuitOpen},
+			},
+			{
+				Name:        "ical",
+				Description: "Write an RFC 5545 iCalendar (.ics) feed of matches to stdout, not the envelope: one VEVENT per match with a stable UID, kickoff, \"Home vs Away (League)\" summary (final score for finished matches) and FotMob URL. Errors still use the JSON error envelope on stderr.",
+				Flags:       icalFlagDefs,
+				Example:     "golazo ical --team arsenal --days-ahead 14 > arsenal.ics",
+				ExitCodes:   []int{ExitOK, ExitUpstream, ExitInvalidArgs, ExitTimeout, ExitOffline, ExitCircuitOpen},
+			},
+			{
+				Name:        "watch",
+				Description: "Stream live match events as NDJSON (one object per line, not the envelope): goal, card, substitution, status and final_whistle. Polls every 90s until SIGINT; events already on the board at startup are not replayed.",
+				Flags:       watchFlagDefs,
+				Example:     "golazo watch --league 47 | jq -c 'select(.type == \"goal\")'",
+				ExitCodes:   []int{ExitOK, ExitUpstream, ExitInvalidArgs, ExitOffline},
+			},
+			{
+				Name:        "standings",
+				Description: "Get the current league table for a league ID. Knockout competitions and sub-season leagues resolve to their parent league; leagues without a table return not_found.",
+				Args:        "<league-id>",
+				Flags:       listFlagDefs,
+				Example:     "golazo standings 47",
+				ExitCodes:   []int{ExitOK, ExitUpstream, ExitInvalidArgs, ExitNotFound, ExitTimeout, ExitOffline, ExitCircuitOpen},
+			},
+			{
+				Name:        "team",
+				Description: "Get a team overview (TeamOverview): squad, last 5 results, next 5 fixtures, primary league standing and W/D/L form. Names are matched against the teams in the active (or --league) leagues; ambiguous names return invalid_args listing the candidates. FotMob only; other providers return not_found.",
+				Args:        "<id|name>",
+				Flags:       teamFlagDefs,
+				Example:     "golazo team arsenal",
+				ExitCodes:   []int{ExitOK, ExitUpstream, ExitInvalidArgs, ExitNotFound, ExitTimeout, ExitOffline, ExitCircuitOpen},
+			},
+			{
+				Name:        "player",
+				Description: "Get a player's profile (PlayerProfile): club, position, shirt number, age, country, height and season stats in their main league. Player IDs appear in match lineups and team squads. FotMob only; other providers return not_found.",
 				Args:        "<id>",
 				Flags:       commonFlags,
-				Example:     "golazo match 2001 --mock",
-				ExitCodes:   []int{ExitOK, ExitUpstream, ExitInvalidArgs, ExitNotFound, ExitTimeout, ExitOffline},
+				Example:     "golazo player 737066",
+				ExitCodes:   []int{ExitOK, ExitUpstream, ExitInvalidArgs, ExitNotFound, ExitTimeout, ExitOffline, ExitCircuitOpen},
+			},
+			{
+				Name:        "topscorers",
+				Description: "Get a league's ranked player list (LeagueStatEntry objects) for one stat: goals, assists, rating, clean_sheets, yellow_cards or red_cards. The league is an ID or name; leagues without player stats return not_found. football-data.org serves goals and assists only.",
+				Args:        "<league>",
+				Flags:       topScorersFlagDefs,
+				Example:     "golazo topscorers 47 --stat assists",
+				ExitCodes:   []int{ExitOK, ExitUpstream, ExitInvalidArgs, ExitNotFound, ExitTimeout, ExitOffline, ExitCircuitOpen},
 			},
 			{
 				Name:        "leagues",
@@ -101,6 +228,83 @@ func buildCapabilities() capabilities {
 				Example:     "golazo leagues --all",
 				ExitCodes:   []int{ExitOK},
 			},
+			{
+				Name:        "worldcup groups",
+				Description: "List FIFA World Cup group tables (WCGroup objects; teams use the standings schema). --mock serves 2022 for --season 2022, the 2026 preview otherwise.",
+				Flags:       wcFlagDefs,
+				Example:     "golazo worldcup groups --season 2026",
+				ExitCodes:   []int{ExitOK, ExitUpstream, ExitInvalidArgs, ExitTimeout, ExitOffline, ExitCircuitOpen},
+			},
+			{
+				Name:        "worldcup bracket",
+				Description: "List FIFA World Cup knockout rounds in order (WCKnockoutRound objects), ending with the final and a \"bronze\" third-place round. Matchups carry scores, penalty scores and winner_id once decided.",
+				Flags:       wcFlagDefs,
+				Example:     "golazo worldcup bracket --season 2022",
+				ExitCodes:   []int{ExitOK, ExitUpstream, ExitInvalidArgs, ExitTimeout, ExitOffline, ExitCircuitOpen},
+			},
+			{
+				Name:        "worldcup scorers",
+				Description: "List FIFA World Cup top scorers ranked by goals (WCTopScorer objects)",
+				Flags:       wcFlagDefs,
+				Example:     "golazo worldcup scorers --season 2022",
+				ExitCodes:   []int{ExitOK, ExitUpstream, ExitInvalidArgs, ExitTimeout, ExitOffline, ExitCircuitOpen},
+			},
+			{
+				Name:        "worldcup upcoming",
+				Description: "List not-yet-started FIFA World Cup matches over the next N days, whether or not the World Cup is an active league",
+				Flags:       wcUpcomingFlagDefs,
+				Example:     "golazo worldcup upcoming --days-ahead 7",
+				ExitCodes:   []int{ExitOK, ExitUpstream, ExitInvalidArgs, ExitTimeout, ExitOffline, ExitCircuitOpen},
+			},
+			{
+				Name:        "cache stats",
+				Description: "Report every on-disk cache (empty_results, page_urls, goal_links, live_updates, version_check) with its path, entries, expired entries and size_bytes. Does not prune. No network calls.",
+				Flags:       prettyOnly,
+				Example:     "golazo cache stats",
+				ExitCodes:   []int{ExitOK, ExitUpstream},
+			},
+			{
+				Name:        "cache prune",
+				Description: "Drop cache entries past their TTL; prints the stats afterwards with the number removed per cache. No network calls.",
+				Flags:       prettyOnly,
+				Example:     "golazo cache prune",
+				ExitCodes:   []int{ExitOK, ExitUpstream},
+			},
+			{
+				Name:        "cache clear",
+				Description: "Delete every cache file (settings are kept); prints the stats afterwards with the number removed per cache. No network calls.",
+				Flags:       prettyOnly,
+				Example:     "golazo cache clear",
+				ExitCodes:   []int{ExitOK, ExitUpstream},
+			},
+			{
+				Name:        "cache warm",
+				Description: "Prefetch today's slate and the last N days of results across active leagues to fill the page slug and empty-result caches, then the finished matches' details into the match details store; prints the cache stats afterwards",
+				Flags:       cacheWarmFlagDefs,
+				Example:     "golazo cache warm --timeout 60s",
+				ExitCodes:   []int{ExitOK, ExitUpstream, ExitInvalidArgs, ExitTimeout, ExitOffline, ExitCircuitOpen},
+			},
+			{
+				Name:        "doctor",
+				Description: "Check config/cache dir writability, settings.yaml (unknown league IDs), terminal hyperlink and desktop notification support, FotMob reachability, league page parsing and the Reddit search endpoint (including blocks). Each check reports status pass, warn, fail or skip with a detail; network checks are skipped with --mock or GOLAZO_OFFLINE. Failed checks do not change the exit code.",
+				Flags:       listFlagDefs,
+				Example:     "golazo doctor --format table",
+				ExitCodes:   []int{ExitOK, ExitUpstream, ExitInvalidArgs},
+			},
+			{
+				Name:        "mcp",
+				Description: "Serve live, finished, fixtures, match, standings and leagues as Model Context Protocol tools over stdio until stdin closes. Tool inputs mirror these flags as snake_case arguments; results carry the JSON envelope.",
+				Flags:       mcpFlagDefs,
+				Example:     "golazo mcp --timeout 30s",
+				ExitCodes:   []int{ExitOK, ExitUpstream, ExitInvalidArgs},
+			},
+			{
+				Name:        "serve",
+				Description: "Serve live, finished, fixtures, match, standings, leagues and capabilities over HTTP with the same envelope (GET /v1/live, /v1/finished, /v1/fixtures, /v1/match/{id}, /v1/standings/{league_id}, /v1/leagues, /v1/capabilities) plus a server-sent-events stream of watch events at /v1/events. Flags map to snake_case query parameters; one client backs every request. Runs until SIGINT.",
+				Flags:       serveFlagDefs,
+				Example:     "golazo serve --addr :8080",
+				ExitCodes:   []int{ExitOK, ExitUpstream, ExitInvalidArgs, ExitOffline},
+			},
 			{
 				Name:        "capabilities",
 				Description: "Print this machine-readable contract describing every subcommand, flag, error and exit code",
@@ -115,6 +319,7 @@ func buildCapabilities() capabilities {
 			string(ErrCodeUpstreamError): ExitUpstream,
 			string(ErrCodeTimeout):       ExitTimeout,
 			string(ErrCodeOffline):       ExitOffline,
+			string(ErrCodeCircuitOpen):   ExitCircuitOpen,
 		},
 		ExitCodes: map[string]string{
 			"0": "ok",
@@ -123,10 +328,14 @@ func buildCapabilities() capabilities {
 			"3": "not_found",
 			"4": "timeout",
 			"5": "offline",
+			"6": "circuit_open",
 		},
 		EnvVars: map[string]string{
-			EnvAgent:   "Forces compact JSON, enables stderr debug logging",
-			EnvOffline: "Refuses any network call; subcommands return offline unless --mock is set",
+			EnvAgent:                      "Forces compact JSON, enables stderr debug logging",
+			EnvOffline:                    "Refuses any network call; subcommands return offline unless --mock is set",
+			cassette.EnvRecord:            "Records every HTTP response into this cassette directory (same as --record)",
+			cassette.EnvReplay:            "Serves HTTP responses from this cassette directory without network access (same as --replay)",
+			provider.EnvFootballDataToken: "API token for --provider football-data; overrides football_data.token in settings.yaml",
 		},
 		Envelope: map[string]any{
 			"success":  map[string]any{"status": "ok", "count": "int", "data": "[]object", "degraded": "bool (optional)", "failed_dates": "[]string (optional)"},
@@ -135,6 +344,7 @@ func buildCapabilities() capabilities {
 				"Errors always go to stderr; stdout stays empty on error.",
 				"Single-item responses (match <id>) still use a data array with count: 1.",
 				"List output is sorted by match_time then id for deterministic ordering.",
+				"watch streams bare NDJSON event objects on stdout instead of the envelope.",
 			},
 		},
 	}
@@ -3,8 +3,10 @@ package cmd
 import (
 	"bytes"
 	"encoding/json"
+	"strings"
 	"testing"
 
+	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 )
 
@@ -44,11 +46,29 @@ func TestRunCapabilities_EmitsContract(t *testing.T) {
 func TestCapabilities_EnumeratesAllSubcommands(t *testing.T) {
 	caps := buildCapabilities()
 	want := map[string]bool{
-		"live":         false,
-		"finished":     false,
-		"match":        false,
-		"leagues":      false,
-		"capabilities": false,
+		"live":              false,
+		"finished":          false,
+		"fixtures":          false,
+		"match":             false,
+		"ical":              false,
+		"watch":             false,
+		"standings":         false,
+		"team":              false,
+		"player":            false,
+		"topscorers":        false,
+		"leagues":           false,
+		"worldcup groups":   false,
+		"worldcup bracket":  false,
+		"worldcup scorers":  false,
+		"worldcup upcoming": false,
+		"cache stats":       false,
+		"cache prune":       false,
+		"cache clear":       false,
+		"cache warm":        false,
+		"doctor":            false,
+		"mcp":               false,
+		"serve":             false,
+		"capabilities":      false,
 	}
 	for _, cmd := range caps.Commands {
 		if _, ok := want[cmd.Name]; ok {
@@ -95,10 +115,23 @@ func TestCapabilities_FlagsMatchCobra(t *testing.T) {
 		capsByName[c.Name] = c
 	}
 
-	for _, cobraCmd := range rootCmd.Commands() {
-		entry, ok := capsByName[cobraCmd.Name()]
+	// Nested subcommands are listed by their path below root, e.g.
+	// "worldcup groups".
+	var cobraCmds []*cobra.Command
+	var walk func(*cobra.Command)
+	walk = func(c *cobra.Command) {
+		for _, sub := range c.Commands() {
+			cobraCmds = append(cobraCmds, sub)
+			walk(sub)
+		}
+	}
+	walk(rootCmd)
+
+	for _, cobraCmd := range cobraCmds {
+		path := strings.TrimPrefix(cobraCmd.CommandPath(), rootCmd.Name()+" ")
+		entry, ok := capsByName[path]
 		if !ok {
-			continue // cobra builtins (help, completion) aren't in the contract
+			continue // cobra builtins (help, completion) and parent commands aren't in the contract
 		}
 
 		cobraFlagNames := map[string]bool{}
@@ -115,12 +148,12 @@ func TestCapabilities_FlagsMatchCobra(t *testing.T) {
 
 		for name := range cobraFlagNames {
 			if !capsFlagNames[name] {
-				t.Errorf("subcommand %q exposes --%s but capabilities contract omits it", cobraCmd.Name(), name)
+				t.Errorf("subcommand %q exposes --%s but capabilities contract omits it", path, name)
 			}
 		}
 		for name := range capsFlagNames {
 			if !cobraFlagNames[name] {
-				t.Errorf("capabilities contract claims subcommand %q has --%s but cobra doesn't expose it", cobraCmd.Name(), name)
+				t.Errorf("capabilities contract claims subcommand %q has --%s but cobra doesn't expose it", path, name)
 			}
 		}
 	}
@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/spf13/cobra"
+)
+
+// OutputFormat selects how list subcommands render their results.
+type OutputFormat string
+
+const (
+	FormatJSON     OutputFormat = "json"
+	FormatTable    OutputFormat = "table"
+	FormatCSV      OutputFormat = "csv"
+	FormatMarkdown OutputFormat = "md"
+)
+
+// kickoffLayout renders kickoff times in the user's local time zone.
+const kickoffLayout = "2006-01-02 15:04"
+
+// addFormatFlag registers --format on a list subcommand.
+func addFormatFlag(cmd *cobra.Command, f *cliFlags) {
+	cmd.Flags().StringVar(&f.format, "format", string(FormatJSON), "Output format: json, table, csv or md")
+}
+
+// outputFormat resolves the effective format. GOLAZO_AGENT forces JSON
+// regardless of --format; an unknown value is an invalid argument.
+func outputFormat(f cliFlags) (OutputFormat, error) {
+	if agentMode() {
+		return FormatJSON, nil
+	}
+	switch OutputFormat(f.format) {
+	case "", FormatJSON:
+		return FormatJSON, nil
+	case FormatTable, FormatCSV, FormatMarkdown:
+		return OutputFormat(f.format), nil
+	}
+	return "", NewInvalidArg("--format must be one of json, table, csv, md, got %q", f.format)
+}
+
+// writeList renders a list result. JSON keeps the envelope (degraded when
+// failedDates is non-empty); the human formats print rows only, so partial
+// failures are reported as a warning on stderr instead.
+func writeList(stdout, stderr io.Writer, format OutputFormat, data any, failedDates []string) error {
+	if format == FormatJSON {
+		if len(failedDates) > 0 {
+			return WriteDegraded(stdout, data, failedDates)
+		}
+		return WriteJSON(stdout, data)
+	}
+	if len(failedDates) > 0 {
+		fmt.Fprintf(stderr, "warning: partial results, failed dates: %s\n", strings.Join(failedDates, ", "))
+	}
+	headers, rows := tableRows(data)
+	switch format {
+	case FormatCSV:
+		return writeCSV(stdout, headers, rows)
+	case FormatMarkdown:
+		return writeMarkdown(stdout, headers, rows)
+	default:
+		return writeTable(stdout, headers, rows)
+	}
+}
+
+// tableRows is the column model for every list type the CLI emits. Register
+// new agent-facing list types here alongside sliceLen.
+func tableRows(data any) (headers []string, rows [][]string) {
+	switch s := data.(type) {
+	case []api.Match:
+		headers = []string{"ID", "Kickoff", "League", "Home", "Score", "Away", "Status"}
+		for _, m := range s {
+			rows = append(rows, []string{
+				strconv.Itoa(m.ID),
+				formatKickoff(m),
+				m.League.Name,
+				m.HomeTeam.Name,
+				formatScore(m),
+				m.AwayTeam.Name,
+				formatStatus(m),
+			})
+		}
+	case []api.League:
+		headers = []string{"ID", "Name", "Country"}
+		for _, l := range s {
+			rows = append(rows, []string{strconv.Itoa(l.ID), l.Name, l.Country})
+		}
+	case []api.LeagueTableEntry:
+		headers = []string{"Pos", "Team", "P", "W", "D", "L", "GF", "GA", "GD", "Pts"}
+		for _, e := range s {
+			rows = append(rows, []string{
+				strconv.Itoa(e.Position), e.Team.Name,
+				strconv.Itoa(e.Played), strconv.Itoa(e.Won), strconv.Itoa(e.Drawn), strconv.Itoa(e.Lost),
+				strconv.Itoa(e.GoalsFor), strconv.Itoa(e.GoalsAgainst), strconv.Itoa(e.GoalDifference),
+				strconv.Itoa(e.Points),
+			})
+		}
+	case []api.LeagueStatEntry:
+		headers = []string{"Rank", "Player", "Team", "Value"}
+		for _, e := range s {
+			rows = append(rows, []string{
+				strconv.Itoa(e.Rank), e.PlayerName, e.Team, strconv.FormatFloat(e.Value, 'f', -1, 64),
+			})
+		}
+	case []doctorCheck:
+		headers = []string{"Check", "Status", "Detail"}
+		for _, c := range s {
+			rows = append(rows, []string{c.Name, c.Status, c.Detail})
+		}
+	}
+	return headers, rows
+}
+
+func formatKickoff(m api.Match) string {
+	if m.MatchTime == nil {
+		return ""
+	}
+	return m.MatchTime.Local().Format(kickoffLayout)
+}
+
+func formatScore(m api.Match) string {
+	if m.HomeScore == nil || m.AwayScore == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d-%d", *m.HomeScore, *m.AwayScore)
+}
+
+func formatStatus(m api.Match) string {
+	if m.Status == api.MatchStatusLive && m.LiveTime != nil && *m.LiveTime != "" {
+		return fmt.Sprintf("%s (%s)", m.Status, *m.LiveTime)
+	}
+	return string(m.Status)
+}
+
+func writeTable(w io.Writer, headers []string, rows [][]string) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, strings.Join(headers, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(tw, strings.Join(row, "\t"))
+	}
+	return tw.Flush()
+}
+
+func writeCSV(w io.Writer, headers []string, rows [][]string) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(headers); err != nil {
+		return err
+	}
+	if err := cw.WriteAll(rows); err != nil {
+		return err
+	}
+	return cw.Error()
+}
+
+func writeMarkdown(w io.Writer, headers []string, rows [][]string) error {
+	escape := func(cells []string) string {
+		out := make([]string, len(cells))
+		for i, c := range cells {
+			out[i] = strings.ReplaceAll(c, "|", `\|`)
+		}
+		return "| " + strings.Join(out, " | ") + " |"
+	}
+	sep := make([]string, len(headers))
+	for i := range sep {
+		sep[i] = "---"
+	}
+	if _, err := fmt.Fprintln(w, escape(headers)); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w, "|"+strings.Join(sep, "|")+"|"); err != nil {
+		return err
+	}
+	for _, row := range rows {
+		if _, err := fmt.Fprintln(w, escape(row)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
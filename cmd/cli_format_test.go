@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestOutputFormat(t *testing.T) {
+	t.Setenv(EnvAgent, "")
+	for _, in := range []string{"", "json", "table", "csv", "md"} {
+		if _, err := outputFormat(cliFlags{format: in}); err != nil {
+			t.Errorf("outputFormat(%q) err = %v", in, err)
+		}
+	}
+	if _, err := outputFormat(cliFlags{format: "xml"}); err == nil {
+		t.Errorf("outputFormat(xml) should fail")
+	}
+
+	t.Setenv(EnvAgent, "1")
+	if got, _ := outputFormat(cliFlags{format: "table"}); got != FormatJSON {
+		t.Errorf("agent mode format = %q, want json", got)
+	}
+}
+
+func TestWriteList_MatchColumns(t *testing.T) {
+	kickoff := time.Date(2026, 6, 12, 19, 0, 0, 0, time.UTC)
+	live := "67'"
+	matches := []api.Match{{
+		ID:        4506424,
+		League:    api.League{Name: "Premier League"},
+		HomeTeam:  api.Team{Name: "Chelsea"},
+		AwayTeam:  api.Team{Name: "Tottenham | Spurs"},
+		Status:    api.MatchStatusLive,
+		HomeScore: intPtr(2),
+		AwayScore: intPtr(1),
+		MatchTime: &kickoff,
+		LiveTime:  &live,
+	}}
+	wantKickoff := kickoff.Local().Format(kickoffLayout)
+
+	var out bytes.Buffer
+	if err := writeList(&out, &out, FormatCSV, matches, nil); err != nil {
+		t.Fatalf("csv: %v", err)
+	}
+	records, err := csv.NewReader(&out).ReadAll()
+	if err != nil {
+		t.Fatalf("csv parse: %v", err)
+	}
+	want := []string{"4506424", wantKickoff, "Premier League", "Chelsea", "2-1", "Tottenham | Spurs", "live (67')"}
+	if len(records) != 2 || strings.Join(records[1], ",") != strings.Join(want, ",") {
+		t.Errorf("csv rows = %v, want header + %v", records, want)
+	}
+
+	out.Reset()
+	if err := writeList(&out, &out, FormatMarkdown, matches, nil); err != nil {
+		t.Fatalf("md: %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if len(lines) != 3 || !strings.HasPrefix(lines[1], "|---|") {
+		t.Fatalf("markdown = %q", out.String())
+	}
+	if !strings.Contains(lines[2], `Tottenham \| Spurs`) {
+		t.Errorf("markdown cell pipe not escaped: %q", lines[2])
+	}
+
+	out.Reset()
+	if err := writeList(&out, &out, FormatTable, matches, nil); err != nil {
+		t.Fatalf("table: %v", err)
+	}
+	if !strings.HasPrefix(out.String(), "ID ") || !strings.Contains(out.String(), "2-1") {
+		t.Errorf("table = %q", out.String())
+	}
+}
+
+func TestWriteList_HumanFormatWarnsOnDegraded(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	if err := writeList(&stdout, &stderr, FormatTable, []api.Match{}, []string{"2026-06-11"}); err != nil {
+		t.Fatalf("writeList: %v", err)
+	}
+	if !strings.Contains(stderr.String(), "2026-06-11") {
+		t.Errorf("stderr = %q, want failed-date warning", stderr.String())
+	}
+	if strings.Contains(stdout.String(), "degraded") {
+		t.Errorf("table output leaked the JSON envelope: %q", stdout.String())
+	}
+}
+
+func TestRunLeagues_FormatFlag(t *testing.T) {
+	t.Setenv(EnvAgent, "")
+
+	var stdout, stderr bytes.Buffer
+	if code := runLeagues(&stdout, &stderr, leaguesFlags{cliFlags: cliFlags{format: "csv"}}); code != ExitOK {
+		t.Fatalf("exit = %d, stderr=%s", code, stderr.String())
+	}
+	if !strings.HasPrefix(stdout.String(), "ID,Name,Country\n") {
+		t.Errorf("csv output = %q", stdout.String())
+	}
+
+	stdout.Reset()
+	stderr.Reset()
+	if code := runLeagues(&stdout, &stderr, leaguesFlags{cliFlags: cliFlags{format: "yaml"}}); code != ExitInvalidArgs {
+		t.Errorf("exit = %d, want %d", code, ExitInvalidArgs)
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("stdout should be empty on invalid args, got: %s", stdout.String())
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"sort"
 
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/footballdata"
+	"github.com/0xjuanma/golazo/internal/fotmob"
 )
 
 // ErrorCode is a typed, machine-readable error category for CLI consumers.
@@ -19,6 +21,7 @@ const (
 	ErrCodeUpstreamError ErrorCode = "upstream_error"
 	ErrCodeTimeout       ErrorCode = "timeout"
 	ErrCodeOffline       ErrorCode = "offline"
+	ErrCodeCircuitOpen   ErrorCode = "circuit_open"
 )
 
 // Exit codes mapped from ErrorCode. Documented in docs/cli.md.
@@ -29,6 +32,7 @@ const (
 	ExitNotFound     = 3
 	ExitTimeout      = 4
 	ExitOffline      = 5
+	ExitCircuitOpen  = 6
 )
 
 // ExitCodeFor returns the documented exit code for a given ErrorCode.
@@ -42,6 +46,8 @@ func ExitCodeFor(code ErrorCode) int {
 		return ExitTimeout
 	case ErrCodeOffline:
 		return ExitOffline
+	case ErrCodeCircuitOpen:
+		return ExitCircuitOpen
 	case ErrCodeUpstreamError:
 		return ExitUpstream
 	default:
@@ -111,12 +117,21 @@ func WriteError(w io.Writer, code ErrorCode, err error) int {
 }
 
 // ClassifyClientError maps a transport/client error to an ErrorCode.
-// Callers pass an error from a fotmob.Client call and a flag indicating
-// whether the context deadline was exceeded.
+// Callers pass an error from an api.Client call and a flag indicating
+// whether the context deadline was exceeded. Requests refused by an open
+// circuit breaker are reported as circuit_open so callers can back off
+// instead of retrying immediately. Data the selected provider does not have
+// is not_found.
 func ClassifyClientError(err error, timedOut bool) ErrorCode {
 	if err == nil {
 		return ErrCodeUpstreamError
 	}
+	if errors.Is(err, fotmob.ErrCircuitOpen) {
+		return ErrCodeCircuitOpen
+	}
+	if errors.Is(err, api.ErrUnsupported) || errors.Is(err, footballdata.ErrNotFound) {
+		return ErrCodeNotFound
+	}
 	if timedOut {
 		return ErrCodeTimeout
 	}
@@ -164,6 +179,20 @@ func sliceLen(v any) int {
 		return len(s)
 	case []api.LeagueTableEntry:
 		return len(s)
+	case []api.WCGroup:
+		return len(s)
+	case []api.WCKnockoutRound:
+		return len(s)
+	case []api.WCTopScorer:
+		return len(s)
+	case []api.LeagueStatEntry:
+		return len(s)
+	case []cacheStat:
+		return len(s)
+	case []cacheChange:
+		return len(s)
+	case []doctorCheck:
+		return len(s)
 	case []any:
 		return len(s)
 	}
@@ -193,6 +222,34 @@ func nonNilSlice(v any) any {
 		if s == nil {
 			return []api.LeagueTableEntry{}
 		}
+	case []api.WCGroup:
+		if s == nil {
+			return []api.WCGroup{}
+		}
+	case []api.WCKnockoutRound:
+		if s == nil {
+			return []api.WCKnockoutRound{}
+		}
+	case []api.WCTopScorer:
+		if s == nil {
+			return []api.WCTopScorer{}
+		}
+	case []api.LeagueStatEntry:
+		if s == nil {
+			return []api.LeagueStatEntry{}
+		}
+	case []cacheStat:
+		if s == nil {
+			return []cacheStat{}
+		}
+	case []cacheChange:
+		if s == nil {
+			return []cacheChange{}
+		}
+	case []doctorCheck:
+		if s == nil {
+			return []doctorCheck{}
+		}
 	case []any:
 		if s == nil {
 			return []any{}
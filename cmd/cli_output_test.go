@@ -4,10 +4,12 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/fotmob"
 )
 
 func TestWriteJSON_EmptySlice(t *testing.T) {
@@ -158,6 +160,13 @@ func TestClassifyClientError(t *testing.T) {
 	if got := ClassifyClientError(errors.New("x"), false); got != ErrCodeUpstreamError {
 		t.Errorf("timedOut=false → %q, want %q", got, ErrCodeUpstreamError)
 	}
+	open := fmt.Errorf("league 47: %w", fotmob.ErrCircuitOpen)
+	if got := ClassifyClientError(open, false); got != ErrCodeCircuitOpen {
+		t.Errorf("circuit open → %q, want %q", got, ErrCodeCircuitOpen)
+	}
+	if ExitCodeFor(ErrCodeCircuitOpen) != ExitCircuitOpen {
+		t.Errorf("ExitCodeFor(circuit_open) = %d, want %d", ExitCodeFor(ErrCodeCircuitOpen), ExitCircuitOpen)
+	}
 }
 
 func TestPrettyToggle(t *testing.T) {
@@ -8,7 +8,11 @@ import (
 	"os"
 	"time"
 
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/cassette"
 	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/provider"
+	"github.com/0xjuanma/golazo/internal/ratelimit"
 )
 
 // Env vars recognized by the CLI subcommands.
@@ -50,14 +54,79 @@ func newStderrLogger(debug bool) *slog.Logger {
 	return slog.New(handler).With("source", "golazo")
 }
 
-// newHeadlessClient builds a fotmob.Client without the TUI's background
+// selectedProvider is the data provider for this process, set from
+// --provider or settings.yaml by configureProvider before a command runs.
+var selectedProvider = provider.Default
+
+// configureProvider resolves --provider (falling back to settings.yaml) into
+// selectedProvider.
+func configureProvider(flag string) error {
+	name, err := provider.Resolve(flag)
+	if err != nil {
+		return NewInvalidArg("--provider: %v", err)
+	}
+	selectedProvider = name
+	return nil
+}
+
+// newFotmobClient constructs the FotMob client, with its logger wired. Tests
+// swap it for a fotmobtest server's client.
+var newFotmobClient = func(debug bool) *fotmob.Client {
+	client := fotmob.NewClient()
+	client.SetLogger(newStderrLogger(debug))
+	return client
+}
+
+// newDataClient constructs the selected provider's client behind
+// newHeadlessClient. `golazo mcp` and `golazo serve` swap it for one
+// session-wide client so slugs, caches, the rate limiter and the concurrency
+// semaphore are shared across calls.
+var newDataClient = func(debug bool) api.Client {
+	if selectedProvider == provider.FotMob {
+		return newFotmobClient(debug)
+	}
+	client, err := provider.New(selectedProvider, newStderrLogger(debug))
+	if err != nil {
+		return newFotmobClient(debug)
+	}
+	return client
+}
+
+// useSharedClient makes every newHeadlessClient call in this process return
+// the same client until the returned restore func runs. Used by the
+// long-running front ends.
+func useSharedClient(debug bool) (restore func()) {
+	shared := newDataClient(debug)
+	prev := newDataClient
+	newDataClient = func(bool) api.Client { return shared }
+	return func() { newDataClient = prev }
+}
+
+// asFotmob returns client as a FotMob client, or nil when another provider is
+// selected. Page slug resolution and persistence, the doctor's page checks
+// and the request counters are FotMob-only.
+func asFotmob(client api.Client) *fotmob.Client {
+	fc, _ := client.(*fotmob.Client)
+	return fc
+}
+
+// savePageURLs persists FotMob page slugs so a follow-up `golazo match <id>`
+// resolves without re-scanning league pages. A no-op for other providers.
+func savePageURLs(client api.Client) error {
+	if fc := asFotmob(client); fc != nil {
+		return fc.SavePageURLs()
+	}
+	return nil
+}
+
+// newHeadlessClient builds the data client without the TUI's background
 // version-check goroutine. Honors GOLAZO_OFFLINE by returning ErrOffline
-// when the caller is not in mock mode.
+// when the caller is neither in mock mode nor replaying a cassette.
 //
 // Returns the client, a context bounded by opts.timeout (default 15s), and
 // the cancel function the caller MUST invoke.
-func newHeadlessClient(opts runtimeOpts) (*fotmob.Client, context.Context, context.CancelFunc, error) {
-	if offlineMode() && !opts.mock {
+func newHeadlessClient(opts runtimeOpts) (api.Client, context.Context, context.CancelFunc, error) {
+	if offlineMode() && !opts.mock && !cassette.Replaying() {
 		// Provide a no-op cancel so callers can defer unconditionally.
 		return nil, nil, func() {}, ErrOffline
 	}
@@ -72,10 +141,28 @@ func newHeadlessClient(opts runtimeOpts) (*fotmob.Client, context.Context, conte
 	// In mock mode we still return a client (callers branch on opts.mock and
 	// use data.Mock* sources), but we skip wiring an HTTP-bound logger when
 	// not needed.
-	client := fotmob.NewClient()
-	client.SetLogger(newStderrLogger(opts.debug))
+	client := newDataClient(opts.debug)
 
-	return client, ctx, cancel, nil
+	// Callers defer the cancel func, so it doubles as the end-of-command hook
+	// that reports how much the rate limiter held requests back and how many
+	// requests caches and coalescing saved.
+	logger := newStderrLogger(opts.debug)
+	done := func() {
+		cancel()
+		if rl, ok := client.(interface{ RateLimitStats() []ratelimit.Stats }); ok {
+			for _, s := range rl.RateLimitStats() {
+				logger.Debug(selectedProvider+" rate limiter", "key", s.Key, "requests", s.Requests,
+					"delayed", s.Delayed, "waited", s.TotalWait, "cancelled", s.Cancelled)
+			}
+		}
+		if fc := asFotmob(client); fc != nil {
+			for _, s := range fc.RequestStats() {
+				logger.Debug("fotmob requests", "kind", s.Kind, "cache_hits", s.CacheHits,
+					"fetches", s.Fetches, "shared", s.Shared)
+			}
+		}
+	}
+	return client, ctx, done, nil
 }
 
 // isTimeout reports whether ctx's deadline was exceeded.
@@ -5,6 +5,10 @@ import (
 	"errors"
 	"testing"
 	"time"
+
+	"github.com/0xjuanma/golazo/internal/cassette"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/fotmob/fotmobtest"
 )
 
 func TestNewHeadlessClient_OfflineReturnsError(t *testing.T) {
@@ -31,6 +35,20 @@ func TestNewHeadlessClient_OfflineButMockSucceeds(t *testing.T) {
 	}
 }
 
+func TestNewHeadlessClient_OfflineButReplaySucceeds(t *testing.T) {
+	t.Setenv(EnvOffline, "1")
+	if err := cassette.Configure(cassette.Replay, t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = cassette.Configure(cassette.Off, "") })
+
+	_, _, cancel, err := newHeadlessClient(runtimeOpts{timeout: time.Second})
+	defer cancel()
+	if err != nil {
+		t.Errorf("replay under offline should succeed, got err=%v", err)
+	}
+}
+
 func TestNewHeadlessClient_DefaultsToTimeout(t *testing.T) {
 	t.Setenv(EnvOffline, "")
 	_, ctx, cancel, err := newHeadlessClient(runtimeOpts{timeout: 0})
@@ -92,3 +110,16 @@ func TestNewStderrLogger_NoopWhenDisabled(t *testing.T) {
 	// is enforced by code review of the constructor.
 	logger.Debug("noop")
 }
+
+// useFakeFotmob points every headless client at a seeded fotmobtest server
+// for the rest of the test.
+func useFakeFotmob(t *testing.T) *fotmobtest.Server {
+	t.Helper()
+	srv := fotmobtest.New(t)
+	t.Setenv(EnvOffline, "")
+	t.Setenv(EnvAgent, "")
+	prev := newFotmobClient
+	newFotmobClient = func(bool) *fotmob.Client { return srv.Client() }
+	t.Cleanup(func() { newFotmobClient = prev })
+	return srv
+}
@@ -0,0 +1,273 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/cassette"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/notify"
+	"github.com/0xjuanma/golazo/internal/reddit"
+	"github.com/0xjuanma/golazo/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// Outcomes of a doctor check. warn marks a degraded but usable setup; skip
+// marks a network check that was not run: under --mock or GOLAZO_OFFLINE, or
+// because FotMob itself was unreachable.
+const (
+	DoctorPass = "pass"
+	DoctorWarn = "warn"
+	DoctorFail = "fail"
+	DoctorSkip = "skip"
+)
+
+// doctorCheck is one line of the `doctor` report.
+type doctorCheck struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Detail string `json:"detail"`
+}
+
+func passCheck(name, format string, args ...any) doctorCheck {
+	return doctorCheck{Name: name, Status: DoctorPass, Detail: fmt.Sprintf(format, args...)}
+}
+
+func warnCheck(name, format string, args ...any) doctorCheck {
+	return doctorCheck{Name: name, Status: DoctorWarn, Detail: fmt.Sprintf(format, args...)}
+}
+
+func failCheck(name, format string, args ...any) doctorCheck {
+	return doctorCheck{Name: name, Status: DoctorFail, Detail: fmt.Sprintf(format, args...)}
+}
+
+// checkDirWritable resolves a golazo directory and proves it writable by
+// creating and removing a temporary file in it.
+func checkDirWritable(name string, dir func() (string, error)) doctorCheck {
+	path, err := dir()
+	if err != nil {
+		return failCheck(name, "%v", err)
+	}
+	f, err := os.CreateTemp(path, ".doctor-*")
+	if err != nil {
+		return failCheck(name, "%s is not writable: %v", path, err)
+	}
+	_ = f.Close()
+	_ = os.Remove(f.Name())
+	return passCheck(name, "%s is writable", path)
+}
+
+// checkSettings parses settings.yaml strictly and flags league IDs that are
+// not in the supported catalog; the TUI silently skips those.
+func checkSettings() doctorCheck {
+	const name = "settings"
+	path, _ := data.SettingsPath()
+	settings, err := data.ReadSettings()
+	if err != nil {
+		return failCheck(name, "%v (the TUI falls back to the default leagues)", err)
+	}
+	if len(settings.SelectedLeagues) == 0 {
+		return passCheck(name, "no leagues selected in %s; using the defaults", path)
+	}
+
+	catalog := leagueCatalog()
+	var unknown []string
+	for _, id := range settings.SelectedLeagues {
+		if _, ok := catalog[id]; !ok {
+			unknown = append(unknown, strconv.Itoa(id))
+		}
+	}
+	if len(unknown) > 0 {
+		return warnCheck(name, "%s selects unknown league IDs: %s; see `golazo leagues --all`", path, strings.Join(unknown, ", "))
+	}
+	return passCheck(name, "%d leagues selected in %s", len(settings.SelectedLeagues), path)
+}
+
+func checkHyperlinks() doctorCheck {
+	const name = "hyperlinks"
+	if ui.SupportsHyperlinks() {
+		return passCheck(name, "terminal supports OSC 8 hyperlinks (TERM=%s, TERM_PROGRAM=%s)", os.Getenv("TERM"), os.Getenv("TERM_PROGRAM"))
+	}
+	return warnCheck(name, "OSC 8 hyperlinks not detected (TERM=%s, TERM_PROGRAM=%s); goal replay links are shown as plain URLs", os.Getenv("TERM"), os.Getenv("TERM_PROGRAM"))
+}
+
+func checkNotifications() doctorCheck {
+	const name = "notifications"
+	backend, err := notify.Available()
+	if err != nil {
+		return warnCheck(name, "%v; goal alerts fall back to the terminal bell", err)
+	}
+	return passCheck(name, "desktop notifications via %s", backend)
+}
+
+// localDoctorChecks are the checks that need no network.
+func localDoctorChecks() []doctorCheck {
+	return []doctorCheck{
+		checkDirWritable("config_dir", data.ConfigDir),
+		checkDirWritable("cache_dir", data.CacheDir),
+		checkSettings(),
+		checkHyperlinks(),
+		checkNotifications(),
+	}
+}
+
+// networkDoctorCheckNames are the network checks, in report order.
+var networkDoctorCheckNames = []string{"fotmob_api", "fotmob_league_page", "reddit_search"}
+
+// networkDoctorChecks probes FotMob and Reddit, one request each.
+func networkDoctorChecks(ctx context.Context, client *fotmob.Client) []doctorCheck {
+	out := make([]doctorCheck, 0, len(networkDoctorCheckNames))
+
+	start := time.Now()
+	status, err := client.Ping(ctx)
+	if err != nil {
+		out = append(out, failCheck("fotmob_api", "%v", err))
+		out = append(out, doctorCheck{Name: "fotmob_league_page", Status: DoctorSkip, Detail: "skipped: fotmob.com is unreachable"})
+	} else {
+		out = append(out, passCheck("fotmob_api", "fotmob.com answered HTTP %d in %s", status, time.Since(start).Round(time.Millisecond)))
+
+		leagueID := data.ActiveLeagueIDs()[0]
+		if n, err := client.CheckLeaguePage(ctx, leagueID); err != nil {
+			out = append(out, failCheck("fotmob_league_page", "league %d: %v; FotMob may have changed its page shape", leagueID, err))
+		} else {
+			out = append(out, passCheck("fotmob_league_page", "league %d page parsed, %d matches", leagueID, n))
+		}
+	}
+
+	status, err = reddit.NewPublicJSONFetcher().Ping(ctx)
+	switch {
+	case errors.Is(err, reddit.ErrBlocked):
+		out = append(out, failCheck("reddit_search", "blocked (HTTP 403); goal replay lookups pause for %s after each block, so replay links will be missing until Reddit lets this IP back in", reddit.CooldownPeriod))
+	case err != nil:
+		out = append(out, failCheck("reddit_search", "%v", err))
+	default:
+		out = append(out, passCheck("reddit_search", "search endpoint answered HTTP %d; not blocked, no cooldown", status))
+	}
+	return out
+}
+
+// doctorSummary is the one-line human summary, e.g.
+// "doctor: 6 passed, 1 warning, 1 failed (reddit_search)".
+func doctorSummary(checks []doctorCheck) string {
+	counts := map[string]int{}
+	var failed []string
+	for _, c := range checks {
+		counts[c.Status]++
+		if c.Status == DoctorFail {
+			failed = append(failed, c.Name)
+		}
+	}
+	parts := []string{fmt.Sprintf("%d passed", counts[DoctorPass])}
+	if n := counts[DoctorWarn]; n > 0 {
+		parts = append(parts, fmt.Sprintf("%d %s", n, plural(n, "warning", "warnings")))
+	}
+	if n := counts[DoctorFail]; n > 0 {
+		sort.Strings(failed)
+		parts = append(parts, fmt.Sprintf("%d failed (%s)", n, strings.Join(failed, ", ")))
+	}
+	if n := counts[DoctorSkip]; n > 0 {
+		parts = append(parts, fmt.Sprintf("%d skipped", n))
+	}
+	return "doctor: " + strings.Join(parts, ", ")
+}
+
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+}
+
+var doctorFlagSet cliFlags
+
+// runDoctor is the testable core of the `doctor` subcommand. Failing checks
+// are reported in the data, not as an error: the exit code is 0 whenever the
+// report itself was written. A summary line goes to stderr unless
+// GOLAZO_AGENT=1.
+func runDoctor(stdout, stderr io.Writer, flags cliFlags) int {
+	applyPretty(flags)
+
+	format, err := outputFormat(flags)
+	if err != nil {
+		return WriteError(stderr, ErrCodeInvalidArgs, err)
+	}
+
+	checks := localDoctorChecks()
+
+	skipReason := ""
+	switch {
+	case flags.mock:
+		skipReason = "skipped: --mock makes no network calls"
+	case offlineMode() && !cassette.Replaying():
+		skipReason = "skipped: " + EnvOffline + "=1"
+	}
+	if skipReason != "" {
+		for _, name := range networkDoctorCheckNames {
+			checks = append(checks, doctorCheck{Name: name, Status: DoctorSkip, Detail: skipReason})
+		}
+	} else {
+		client, ctx, cancel, err := newHeadlessClient(runtimeOpts{
+			debug:   flags.debug,
+			timeout: flags.timeout,
+		})
+		defer cancel()
+		if err != nil {
+			return WriteError(stderr, ErrCodeUpstreamError, err)
+		}
+		// The FotMob checks run whichever provider is selected.
+		fc := asFotmob(client)
+		if fc == nil {
+			fc = newFotmobClient(flags.debug)
+		}
+		checks = append(checks, networkDoctorChecks(ctx, fc)...)
+	}
+
+	if err := writeList(stdout, stderr, format, checks, nil); err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+	if !agentMode() {
+		fmt.Fprintln(stderr, doctorSummary(checks))
+	}
+	return ExitOK
+}
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check connectivity and the local environment",
+	Long: `Runs one check per dependency and reports pass, warn, fail or skip for each, plus a one-line summary on stderr:
+
+  config_dir, cache_dir  the golazo directories exist and are writable
+  settings               settings.yaml parses and selects only supported leagues
+  hyperlinks             the terminal renders OSC 8 links (goal replays)
+  notifications          a desktop notification backend is available
+  fotmob_api             fotmob.com is reachable
+  fotmob_league_page     a league page still embeds the data golazo parses
+  reddit_search          the r/soccer search endpoint answers and is not blocking this IP
+
+Network checks are skipped with --mock or GOLAZO_OFFLINE=1. Failing checks do not change the exit code; filter on status instead.
+
+Example output:
+  {"status":"ok","count":8,"data":[{"name":"config_dir","status":"pass","detail":"/home/me/.config/golazo is writable"}]}`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		code := runDoctor(os.Stdout, os.Stderr, doctorFlagSet)
+		if code != ExitOK {
+			os.Exit(code)
+		}
+	},
+}
+
+func init() {
+	addCommonCLIFlags(doctorCmd, &doctorFlagSet)
+	addFormatFlag(doctorCmd, &doctorFlagSet)
+	rootCmd.AddCommand(doctorCmd)
+}
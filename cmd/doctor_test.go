@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func runDoctorMock(t *testing.T, settingsYAML string) (map[string]doctorCheck, string) {
+	t.Helper()
+	tmp := t.TempDir()
+	t.Setenv("HOME", tmp)
+	t.Setenv("XDG_CONFIG_HOME", tmp)
+	t.Setenv("XDG_CACHE_HOME", filepath.Join(tmp, "cache"))
+	t.Setenv(EnvOffline, "")
+	t.Setenv(EnvAgent, "")
+	if settingsYAML != "" {
+		dir := filepath.Join(tmp, "golazo")
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "settings.yaml"), []byte(settingsYAML), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := runDoctor(&stdout, &stderr, cliFlags{mock: true, timeout: time.Second}); code != ExitOK {
+		t.Fatalf("exit = %d, stderr=%s", code, stderr.String())
+	}
+	var env struct {
+		Count int           `json:"count"`
+		Data  []doctorCheck `json:"data"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &env); err != nil {
+		t.Fatalf("unmarshal: %v (%s)", err, stdout.String())
+	}
+	checks := make(map[string]doctorCheck, len(env.Data))
+	for _, c := range env.Data {
+		checks[c.Name] = c
+	}
+	if env.Count != len(checks) || len(checks) != 5+len(networkDoctorCheckNames) {
+		t.Errorf("count = %d, checks = %d", env.Count, len(checks))
+	}
+	return checks, stderr.String()
+}
+
+func TestRunDoctor_MockSkipsNetwork(t *testing.T) {
+	checks, summary := runDoctorMock(t, "")
+
+	for _, name := range []string{"config_dir", "cache_dir", "settings"} {
+		if checks[name].Status != DoctorPass {
+			t.Errorf("%s = %+v, want pass", name, checks[name])
+		}
+	}
+	for _, name := range networkDoctorCheckNames {
+		if checks[name].Status != DoctorSkip {
+			t.Errorf("%s = %+v, want skip", name, checks[name])
+		}
+	}
+	if !strings.HasPrefix(summary, "doctor: ") || !strings.Contains(summary, "3 skipped") {
+		t.Errorf("summary = %q", summary)
+	}
+}
+
+func TestRunDoctor_Settings(t *testing.T) {
+	checks, _ := runDoctorMock(t, "selected_leagues: [47, 999999]\n")
+	if c := checks["settings"]; c.Status != DoctorWarn || !strings.Contains(c.Detail, "999999") {
+		t.Errorf("unknown league: settings = %+v, want warn naming 999999", c)
+	}
+
+	checks, _ = runDoctorMock(t, "selected_leagues: [47\n")
+	if c := checks["settings"]; c.Status != DoctorFail {
+		t.Errorf("malformed yaml: settings = %+v, want fail", c)
+	}
+}
+
+func TestDoctorSummary(t *testing.T) {
+	got := doctorSummary([]doctorCheck{
+		{Name: "config_dir", Status: DoctorPass},
+		{Name: "reddit_search", Status: DoctorFail},
+		{Name: "hyperlinks", Status: DoctorWarn},
+		{Name: "fotmob_api", Status: DoctorFail},
+	})
+	if want := "doctor: 1 passed, 1 warning, 2 failed (fotmob_api, reddit_search)"; got != want {
+		t.Errorf("summary = %q, want %q", got, want)
+	}
+}
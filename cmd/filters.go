@@ -0,0 +1,233 @@
+package cmd
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fold"
+	"github.com/spf13/cobra"
+)
+
+// matchFilter narrows a match listing by ID, league, team and status. Empty
+// fields match everything; a match must satisfy every non-empty field and
+// any one value within it.
+type matchFilter struct {
+	matchIDs  []int
+	leagueIDs []int
+	teams     []string // team IDs or name fragments; see teamMatches
+	statuses  []api.MatchStatus
+}
+
+func (f matchFilter) keep(m api.Match) bool {
+	if len(f.matchIDs) > 0 && !containsInt(f.matchIDs, m.ID) {
+		return false
+	}
+	if len(f.leagueIDs) > 0 && !containsInt(f.leagueIDs, m.League.ID) {
+		return false
+	}
+	if len(f.teams) > 0 && !f.keepTeams(m) {
+		return false
+	}
+	if len(f.statuses) > 0 && !containsStatus(f.statuses, m.Status) {
+		return false
+	}
+	return true
+}
+
+func (f matchFilter) keepTeams(m api.Match) bool {
+	for _, q := range f.teams {
+		if teamMatches(m.HomeTeam, q) || teamMatches(m.AwayTeam, q) {
+			return true
+		}
+	}
+	return false
+}
+
+// apply returns the matches f keeps, never nil.
+func (f matchFilter) apply(matches []api.Match) []api.Match {
+	out := make([]api.Match, 0, len(matches))
+	for _, m := range matches {
+		if f.keep(m) {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// singleTeam adapts a single optional --team value to matchFilter.teams.
+func singleTeam(q string) []string {
+	if q == "" {
+		return nil
+	}
+	return []string{q}
+}
+
+func containsInt(ids []int, id int) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
+
+func containsStatus(statuses []api.MatchStatus, s api.MatchStatus) bool {
+	for _, v := range statuses {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// foldName lower-cases s and removes diacritics for loose name comparison.
+func foldName(s string) string {
+	return strings.ToLower(strings.TrimSpace(fold.Diacritics(s)))
+}
+
+// teamMatches reports whether query names the team, either by numeric ID or
+// as a case- and diacritic-insensitive substring of its full or short name.
+func teamMatches(t api.Team, query string) bool {
+	if id, err := strconv.Atoi(query); err == nil {
+		return t.ID == id
+	}
+	q := foldName(query)
+	return strings.Contains(foldName(t.Name), q) || strings.Contains(foldName(t.ShortName), q)
+}
+
+// listFilterFlags holds the raw --league/--team/--status values of the
+// subcommands that support filtering (live, finished, ical).
+type listFilterFlags struct {
+	leagues  []string
+	teams    []string
+	statuses []string
+}
+
+// addFilterFlags registers --league, --team and --status on a list
+// subcommand. All three are repeatable and accept comma-separated values.
+func addFilterFlags(cmd *cobra.Command, f *cliFlags) {
+	addLeagueTeamFlags(cmd, f)
+	cmd.Flags().StringSliceVar(&f.filters.statuses, "status", nil, "Only matches with this status: "+strings.Join(filterStatusNames(), ", ")+" (repeatable)")
+}
+
+// addLeagueTeamFlags registers only --league and --team, for subcommands
+// where a status filter makes no sense (ical).
+func addLeagueTeamFlags(cmd *cobra.Command, f *cliFlags) {
+	cmd.Flags().StringSliceVar(&f.filters.leagues, "league", nil, "Only these leagues, by ID or name (repeatable; narrows the fetch)")
+	cmd.Flags().StringSliceVar(&f.filters.teams, "team", nil, "Only matches involving this team, by ID or name fragment (repeatable)")
+}
+
+// filterStatuses are the accepted --status values.
+var filterStatuses = []api.MatchStatus{
+	api.MatchStatusNotStarted,
+	api.MatchStatusLive,
+	api.MatchStatusFinished,
+	api.MatchStatusPostponed,
+	api.MatchStatusCancelled,
+}
+
+func filterStatusNames() []string {
+	names := make([]string, len(filterStatuses))
+	for i, s := range filterStatuses {
+		names[i] = string(s)
+	}
+	return names
+}
+
+// resolveFilter validates the raw filter flags. League names are resolved to
+// IDs against the supported-league catalog; the resulting leagueIDs double as
+// the fetch set, so callers only query the leagues asked for.
+func resolveFilter(f listFilterFlags) (matchFilter, error) {
+	var out matchFilter
+	for _, q := range f.leagues {
+		id, err := resolveLeagueID(q)
+		if err != nil {
+			return matchFilter{}, err
+		}
+		if !containsInt(out.leagueIDs, id) {
+			out.leagueIDs = append(out.leagueIDs, id)
+		}
+	}
+	for _, q := range f.teams {
+		if strings.TrimSpace(q) == "" {
+			return matchFilter{}, NewInvalidArg("--team must not be empty")
+		}
+		out.teams = append(out.teams, strings.TrimSpace(q))
+	}
+	for _, s := range f.statuses {
+		status := api.MatchStatus(strings.ToLower(strings.TrimSpace(s)))
+		if !containsStatus(filterStatuses, status) {
+			return matchFilter{}, NewInvalidArg("--status must be one of %s, got %q",
+				strings.Join(filterStatusNames(), ", "), s)
+		}
+		out.statuses = append(out.statuses, status)
+	}
+	return out, nil
+}
+
+// resolveLeagueID resolves a --league value: a positive integer is taken as a
+// FotMob league ID as-is; anything else must name exactly one supported
+// league. Exact names win over "<country> <name>" and those over substrings;
+// a tie among several leagues is broken in favor of the active selection,
+// and is otherwise an invalid argument listing the candidates.
+func resolveLeagueID(q string) (int, error) {
+	q = strings.TrimSpace(q)
+	if id, err := strconv.Atoi(q); err == nil {
+		if id <= 0 {
+			return 0, NewInvalidArg("--league ID must be positive, got %d", id)
+		}
+		return id, nil
+	}
+	if q == "" {
+		return 0, NewInvalidArg("--league must not be empty")
+	}
+
+	catalog := leagueCatalog()
+	ids := make([]int, 0, len(catalog))
+	for id := range catalog {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+
+	want := foldName(q)
+	matchers := []func(data.LeagueInfo) bool{
+		func(l data.LeagueInfo) bool { return foldName(l.Name) == want },
+		func(l data.LeagueInfo) bool { return foldName(l.Country+" "+l.Name) == want },
+		func(l data.LeagueInfo) bool { return strings.Contains(foldName(l.Name), want) },
+	}
+	for _, match := range matchers {
+		var hits []int
+		for _, id := range ids {
+			if match(catalog[id]) {
+				hits = append(hits, id)
+			}
+		}
+		if len(hits) == 0 {
+			continue
+		}
+		if len(hits) > 1 {
+			var active []int
+			for _, id := range hits {
+				if containsInt(data.ActiveLeagueIDs(), id) {
+					active = append(active, id)
+				}
+			}
+			if len(active) == 1 {
+				hits = active
+			}
+		}
+		if len(hits) == 1 {
+			return hits[0], nil
+		}
+		candidates := make([]string, len(hits))
+		for i, id := range hits {
+			l := catalog[id]
+			candidates[i] = l.Name + " (" + l.Country + ", " + strconv.Itoa(id) + ")"
+		}
+		return 0, NewInvalidArg("--league %q is ambiguous: %s; pass the ID instead", q, strings.Join(candidates, "; "))
+	}
+	return 0, NewInvalidArg("--league %q matches no supported league; see `golazo leagues --all`", q)
+}
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestMatchFilter(t *testing.T) {
+	m := api.Match{
+		ID:       30,
+		League:   api.League{ID: 47},
+		HomeTeam: api.Team{ID: 8455, Name: "Chelsea", ShortName: "Chelsea"},
+		AwayTeam: api.Team{ID: 9906, Name: "Atlético Madrid", ShortName: "Atlético"},
+		Status:   api.MatchStatusLive,
+	}
+	tests := []struct {
+		name   string
+		filter matchFilter
+		want   bool
+	}{
+		{"empty", matchFilter{}, true},
+		{"match hit", matchFilter{matchIDs: []int{1, 30}}, true},
+		{"match miss", matchFilter{matchIDs: []int{1}}, false},
+		{"league miss", matchFilter{leagueIDs: []int{87}}, false},
+		{"team name fragment", matchFilter{teams: []string{"chel"}}, true},
+		{"team folds diacritics", matchFilter{teams: []string{"ATLETICO"}}, true},
+		{"team id", matchFilter{teams: []string{"8455"}}, true},
+		{"any team", matchFilter{teams: []string{"arsenal", "madrid"}}, true},
+		{"team miss", matchFilter{teams: []string{"arsenal"}}, false},
+		{"status hit", matchFilter{statuses: []api.MatchStatus{api.MatchStatusFinished, api.MatchStatusLive}}, true},
+		{"status miss", matchFilter{statuses: []api.MatchStatus{api.MatchStatusFinished}}, false},
+	}
+	for _, tt := range tests {
+		if got := tt.filter.keep(m); got != tt.want {
+			t.Errorf("%s: keep = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestResolveLeagueID(t *testing.T) {
+	tmp := t.TempDir()
+	t.Setenv("HOME", tmp)
+	t.Setenv("XDG_CONFIG_HOME", tmp)
+
+	tests := []struct {
+		query   string
+		want    int
+		wantErr bool
+	}{
+		{"47", 47, false},
+		{"99999", 99999, false}, // unlisted IDs pass through to the API
+		{"premier league", 47, false},
+		{"england premier league", 47, false},
+		{"Ecuador Serie A", 246, false},
+		{"Bundesliga", 54, false},
+		{"champions league", 42, false}, // several match; only UEFA's is active by default
+		{"Serie A", 0, true},            // Italy and Ecuador, neither active
+		{"0", 0, true},
+		{"no such league", 0, true},
+		{"", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := resolveLeagueID(tt.query)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("resolveLeagueID(%q) err = %v, wantErr %v", tt.query, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("resolveLeagueID(%q) = %d, want %d", tt.query, got, tt.want)
+		}
+	}
+}
+
+func TestResolveFilter_RejectsUnknownStatus(t *testing.T) {
+	if _, err := resolveFilter(listFilterFlags{statuses: []string{"halftime"}}); err == nil {
+		t.Fatal("want error for unknown status")
+	}
+	f, err := resolveFilter(listFilterFlags{leagues: []string{"47", "Premier League"}, statuses: []string{"LIVE"}})
+	if err != nil {
+		t.Fatalf("resolveFilter: %v", err)
+	}
+	if len(f.leagueIDs) != 1 || f.leagueIDs[0] != 47 {
+		t.Errorf("leagueIDs = %v, want [47] (deduplicated)", f.leagueIDs)
+	}
+	if len(f.statuses) != 1 || f.statuses[0] != api.MatchStatusLive {
+		t.Errorf("statuses = %v, want [live]", f.statuses)
+	}
+}
+
+func TestRunLive_MockFilters(t *testing.T) {
+	t.Setenv(EnvOffline, "")
+	t.Setenv(EnvAgent, "")
+
+	tests := []struct {
+		name    string
+		filters listFilterFlags
+		wantIDs []int
+	}{
+		{"league by name", listFilterFlags{leagues: []string{"la liga"}}, []int{2002, 2005}},
+		{"team", listFilterFlags{teams: []string{"liverpool"}}, []int{2004}},
+		{"status", listFilterFlags{leagues: []string{"47"}, statuses: []string{"live"}}, []int{2001}},
+	}
+	for _, tt := range tests {
+		var stdout, stderr bytes.Buffer
+		code := runLive(&stdout, &stderr, cliFlags{mock: true, timeout: time.Second, filters: tt.filters})
+		if code != ExitOK {
+			t.Fatalf("%s: exit = %d, stderr=%s", tt.name, code, stderr.String())
+		}
+		var env struct {
+			Data []api.Match `json:"data"`
+		}
+		if err := json.Unmarshal(stdout.Bytes(), &env); err != nil {
+			t.Fatalf("%s: unmarshal: %v", tt.name, err)
+		}
+		var got []int
+		for _, m := range env.Data {
+			got = append(got, m.ID)
+		}
+		if fmt.Sprint(got) != fmt.Sprint(tt.wantIDs) {
+			t.Errorf("%s: ids = %v, want %v", tt.name, got, tt.wantIDs)
+		}
+	}
+}
+
+func TestRunFinished_InvalidLeagueIsInvalidArgs(t *testing.T) {
+	t.Setenv(EnvOffline, "")
+	t.Setenv(EnvAgent, "")
+	tmp := t.TempDir()
+	t.Setenv("HOME", tmp)
+	t.Setenv("XDG_CONFIG_HOME", tmp)
+
+	var stdout, stderr bytes.Buffer
+	code := runFinished(&stdout, &stderr, finishedFlags{
+		cliFlags: cliFlags{mock: true, timeout: time.Second, filters: listFilterFlags{leagues: []string{"Serie A"}}},
+		days:     1,
+	})
+	if code != ExitInvalidArgs {
+		t.Fatalf("exit = %d, want %d", code, ExitInvalidArgs)
+	}
+	if !strings.Contains(stderr.String(), "ambiguous") {
+		t.Errorf("stderr = %q, want ambiguity message", stderr.String())
+	}
+}
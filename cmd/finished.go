@@ -9,7 +9,6 @@ import (
 
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/data"
-	"github.com/0xjuanma/golazo/internal/fotmob"
 	"github.com/spf13/cobra"
 )
 
@@ -77,8 +76,15 @@ func collectFinished(ctx context.Context, fetch finishedDayFetcher, now time.Tim
 	return out, failedDates, nil
 }
 
-func defaultFinishedFetcher(c *fotmob.Client) finishedDayFetcher {
-	return c.MatchesByDateWithTabs
+// defaultFinishedFetcher queries the active leagues, or only leagueIDs when
+// set (--league).
+func defaultFinishedFetcher(c api.Client, leagueIDs []int) finishedDayFetcher {
+	if len(leagueIDs) == 0 {
+		return c.MatchesByDateWithTabs
+	}
+	return func(ctx context.Context, date time.Time, tabs []string) ([]api.Match, error) {
+		return c.MatchesByDateForLeagues(ctx, date, tabs, leagueIDs)
+	}
 }
 
 // finishedFlags extends the common flag set with --days and --include-upcoming.
@@ -94,6 +100,15 @@ var finishedFlagSet finishedFlags
 func runFinished(stdout, stderr io.Writer, flags finishedFlags) int {
 	applyPretty(flags.cliFlags)
 
+	format, err := outputFormat(flags.cliFlags)
+	if err != nil {
+		return WriteError(stderr, ErrCodeInvalidArgs, err)
+	}
+	filter, err := resolveFilter(flags.filters)
+	if err != nil {
+		return WriteError(stderr, ErrCodeInvalidArgs, err)
+	}
+
 	if flags.days < 1 || flags.days > MaxFinishedDays {
 		return WriteError(stderr, ErrCodeInvalidArgs,
 			NewInvalidArg("--days must be between 1 and %d, got %d", MaxFinishedDays, flags.days))
@@ -121,7 +136,7 @@ func runFinished(stdout, stderr io.Writer, flags finishedFlags) int {
 		// Mock data is single-day; serve it regardless of --days.
 		matches = data.MockFinishedMatches()
 	} else {
-		matches, failedDates, err = collectFinished(ctx, defaultFinishedFetcher(client), time.Now(), flags.days, flags.includeUpcoming)
+		matches, failedDates, err = collectFinished(ctx, defaultFinishedFetcher(client, filter.leagueIDs), time.Now(), flags.days, flags.includeUpcoming)
 		if err != nil {
 			return WriteError(stderr, ClassifyClientError(err, isTimeout(ctx)), err)
 		}
@@ -132,18 +147,16 @@ func runFinished(stdout, stderr io.Writer, flags finishedFlags) int {
 			return WriteError(stderr, ErrCodeTimeout,
 				fmt.Errorf("finished matches fetch timed out after %s", flags.timeout))
 		}
+		// Best-effort: persist page slugs so a follow-up `golazo match <id>`
+		// resolves without re-scanning league pages.
+		_ = savePageURLs(client)
 	}
 
+	matches = filter.apply(matches)
 	SortMatches(matches)
 
-	var writeErr error
-	if len(failedDates) > 0 {
-		writeErr = WriteDegraded(stdout, matches, failedDates)
-	} else {
-		writeErr = WriteJSON(stdout, matches)
-	}
-	if writeErr != nil {
-		return WriteError(stderr, ErrCodeUpstreamError, writeErr)
+	if err := writeList(stdout, stderr, format, matches, failedDates); err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
 	}
 	return ExitOK
 }
@@ -153,6 +166,8 @@ var finishedCmd = &cobra.Command{
 	Short:         "List finished matches over a day window as JSON",
 	Long: `Fetches finished matches for the last --days days (default 1 = today) across active leagues. Use --include-upcoming to also include today's not-yet-started matches. Partial failures surface as degraded:true with failed_dates listed.
 
+--league narrows the fetch to the given leagues (IDs or names); --team and --status filter the result.
+
 Example output:
   {"status":"ok","count":2,"data":[{"id":4506420,"league":{"id":47,"name":"Premier League","country":"England"},"home_team":{"name":"Liverpool","short_name":"Liverpool"},"away_team":{"name":"Arsenal","short_name":"Arsenal"},"status":"finished","home_score":3,"away_score":1,"match_time":"2026-06-12T15:00:00Z"}]}
 
@@ -170,6 +185,8 @@ Degraded example (one date failed but others succeeded):
 
 func init() {
 	addCommonCLIFlags(finishedCmd, &finishedFlagSet.cliFlags)
+	addFormatFlag(finishedCmd, &finishedFlagSet.cliFlags)
+	addFilterFlags(finishedCmd, &finishedFlagSet.cliFlags)
 	finishedCmd.Flags().IntVar(&finishedFlagSet.days, "days", 1, "Number of days to look back (1..7)")
 	finishedCmd.Flags().BoolVar(&finishedFlagSet.includeUpcoming, "include-upcoming", false, "Also include today's not-yet-started matches in the result")
 	rootCmd.AddCommand(finishedCmd)
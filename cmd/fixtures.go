@@ -0,0 +1,162 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/spf13/cobra"
+)
+
+// MaxFixturesDaysAhead is the maximum supported look-ahead window for `golazo fixtures`.
+const MaxFixturesDaysAhead = 14
+
+// collectFixtures iterates today plus the next `daysAhead` calendar days,
+// calling the per-day fetcher with the "fixtures" tab only. It mirrors
+// collectFinished: results are deduplicated by Match.ID, failing dates are
+// reported for the degraded envelope, and an error is returned iff ALL days
+// failed.
+//
+// Only not-yet-started matches are kept; live matches also come back on the
+// fixtures tab but belong to `golazo live`.
+func collectFixtures(ctx context.Context, fetch finishedDayFetcher, now time.Time, daysAhead int) ([]api.Match, []string, error) {
+	dedup := make(map[int]api.Match, daysAhead*10)
+	var failedDates []string
+	successCount := 0
+	var lastErr error
+
+	for i := 0; i <= daysAhead; i++ {
+		date := now.AddDate(0, 0, i).UTC()
+		dateStr := date.Format("2006-01-02")
+
+		matches, err := fetch(ctx, date, []string{"fixtures"})
+		if err != nil {
+			failedDates = append(failedDates, dateStr)
+			lastErr = err
+			continue
+		}
+		successCount++
+		for _, m := range matches {
+			if m.Status == api.MatchStatusNotStarted {
+				dedup[m.ID] = m
+			}
+		}
+	}
+
+	if successCount == 0 {
+		return nil, failedDates, lastErr
+	}
+
+	out := make([]api.Match, 0, len(dedup))
+	for _, m := range dedup {
+		out = append(out, m)
+	}
+	return out, failedDates, nil
+}
+
+// mockFixtures returns the bundled upcoming fixtures that kick off within the
+// look-ahead window.
+func mockFixtures(now time.Time, daysAhead int) []api.Match {
+	end := now.AddDate(0, 0, daysAhead+1)
+	var out []api.Match
+	for _, m := range data.MockUpcomingMatches() {
+		if m.MatchTime != nil && m.MatchTime.Before(end) {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// fixturesFlags extends the common flag set with --days-ahead.
+type fixturesFlags struct {
+	cliFlags
+	daysAhead int
+}
+
+var fixturesFlagSet fixturesFlags
+
+// runFixtures is the testable core of the `fixtures` subcommand.
+func runFixtures(stdout, stderr io.Writer, flags fixturesFlags) int {
+	applyPretty(flags.cliFlags)
+
+	format, err := outputFormat(flags.cliFlags)
+	if err != nil {
+		return WriteError(stderr, ErrCodeInvalidArgs, err)
+	}
+
+	if flags.daysAhead < 1 || flags.daysAhead > MaxFixturesDaysAhead {
+		return WriteError(stderr, ErrCodeInvalidArgs,
+			NewInvalidArg("--days-ahead must be between 1 and %d, got %d", MaxFixturesDaysAhead, flags.daysAhead))
+	}
+
+	client, ctx, cancel, err := newHeadlessClient(runtimeOpts{
+		mock:    flags.mock,
+		debug:   flags.debug,
+		timeout: flags.timeout,
+	})
+	defer cancel()
+	if err == ErrOffline {
+		return WriteError(stderr, ErrCodeOffline, err)
+	}
+	if err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+
+	var (
+		matches     []api.Match
+		failedDates []string
+	)
+
+	if flags.mock {
+		matches = mockFixtures(time.Now(), flags.daysAhead)
+	} else {
+		matches, failedDates, err = collectFixtures(ctx, defaultFinishedFetcher(client, nil), time.Now(), flags.daysAhead)
+		if err != nil {
+			return WriteError(stderr, ClassifyClientError(err, isTimeout(ctx)), err)
+		}
+		// Same silent-timeout guard as `finished`: per-league failures are
+		// swallowed, so an expired context can look like an empty window.
+		if isTimeout(ctx) {
+			return WriteError(stderr, ErrCodeTimeout,
+				fmt.Errorf("fixtures fetch timed out after %s", flags.timeout))
+		}
+		// Best-effort: persist page slugs so a follow-up `golazo match <id>`
+		// resolves without re-scanning league pages.
+		_ = savePageURLs(client)
+	}
+
+	SortMatches(matches)
+
+	if err := writeList(stdout, stderr, format, matches, failedDates); err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+	return ExitOK
+}
+
+var fixturesCmd = &cobra.Command{
+	Use:   "fixtures",
+	Short: "List upcoming matches over the next N days as JSON",
+	Long: `Fetches not-yet-started matches from today through the next --days-ahead days (default 7, max 14) across active leagues. League/date pairs already known to be empty are skipped. Partial failures surface as degraded:true with failed_dates listed.
+
+Example output:
+  {"status":"ok","count":1,"data":[{"id":4506512,"league":{"id":47,"name":"Premier League","country":"England"},"home_team":{"id":8650,"name":"Liverpool","short_name":"Liverpool"},"away_team":{"id":8455,"name":"Chelsea","short_name":"Chelsea"},"status":"not_started","match_time":"2026-06-14T16:30:00Z","round":"Matchday 19"}]}`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		code := runFixtures(os.Stdout, os.Stderr, fixturesFlagSet)
+		if code != ExitOK {
+			os.Exit(code)
+		}
+	},
+}
+
+func init() {
+	addCommonCLIFlags(fixturesCmd, &fixturesFlagSet.cliFlags)
+	addFormatFlag(fixturesCmd, &fixturesFlagSet.cliFlags)
+	fixturesCmd.Flags().IntVar(&fixturesFlagSet.daysAhead, "days-ahead", 7, "Number of days to look ahead (1..14)")
+	rootCmd.AddCommand(fixturesCmd)
+}
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func TestRunFixtures_InvalidDaysAhead(t *testing.T) {
+	cases := []int{0, -1, 15, 100}
+	for _, days := range cases {
+		t.Run("", func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := runFixtures(&stdout, &stderr, fixturesFlags{cliFlags: cliFlags{mock: true, timeout: time.Second}, daysAhead: days})
+			if code != ExitInvalidArgs {
+				t.Errorf("days-ahead=%d: exit = %d, want %d", days, code, ExitInvalidArgs)
+			}
+			if stdout.Len() != 0 {
+				t.Errorf("stdout should be empty on invalid args, got: %s", stdout.String())
+			}
+		})
+	}
+}
+
+func TestRunFixtures_MockWindow(t *testing.T) {
+	t.Setenv(EnvOffline, "")
+	t.Setenv(EnvAgent, "")
+
+	count := func(days int) int {
+		var stdout, stderr bytes.Buffer
+		code := runFixtures(&stdout, &stderr, fixturesFlags{cliFlags: cliFlags{mock: true, timeout: time.Second}, daysAhead: days})
+		if code != ExitOK {
+			t.Fatalf("exit = %d, stderr=%s", code, stderr.String())
+		}
+		var env struct {
+			Count int         `json:"count"`
+			Data  []api.Match `json:"data"`
+		}
+		if err := json.Unmarshal(stdout.Bytes(), &env); err != nil {
+			t.Fatalf("unmarshal: %v\nraw: %s", err, stdout.String())
+		}
+		for _, m := range env.Data {
+			if m.Status != api.MatchStatusNotStarted {
+				t.Errorf("non-upcoming match leaked: %+v", m)
+			}
+		}
+		return env.Count
+	}
+
+	short, long := count(1), count(MaxFixturesDaysAhead)
+	if short >= long {
+		t.Errorf("1-day window returned %d matches, 14-day window %d; want fewer for the shorter window", short, long)
+	}
+}
+
+func TestCollectFixtures_WalksForwardWithFixturesTab(t *testing.T) {
+	now := time.Date(2026, 6, 12, 12, 0, 0, 0, time.UTC)
+	var gotDates []string
+	fetch := func(ctx context.Context, date time.Time, tabs []string) ([]api.Match, error) {
+		gotDates = append(gotDates, date.Format("2006-01-02"))
+		if len(tabs) != 1 || tabs[0] != "fixtures" {
+			t.Errorf("tabs = %v, want [fixtures]", tabs)
+		}
+		return []api.Match{
+			{ID: 100, Status: api.MatchStatusNotStarted},
+			{ID: 200, Status: api.MatchStatusLive}, // must be filtered
+		}, nil
+	}
+
+	matches, failed, err := collectFixtures(context.Background(), fetch, now, 3)
+	if err != nil {
+		t.Fatalf("err = %v", err)
+	}
+	if len(failed) != 0 {
+		t.Errorf("failedDates = %v, want empty", failed)
+	}
+	wantDates := []string{"2026-06-12", "2026-06-13", "2026-06-14", "2026-06-15"}
+	if len(gotDates) != len(wantDates) {
+		t.Fatalf("fetched dates = %v, want %v", gotDates, wantDates)
+	}
+	for i := range wantDates {
+		if gotDates[i] != wantDates[i] {
+			t.Errorf("date[%d] = %s, want %s", i, gotDates[i], wantDates[i])
+		}
+	}
+	if len(matches) != 1 || matches[0].ID != 100 {
+		t.Errorf("matches = %+v, want only ID 100 (deduped, live dropped)", matches)
+	}
+}
+
+func TestCollectFixtures_PartialFailureFlagsDegraded(t *testing.T) {
+	now := time.Date(2026, 6, 12, 12, 0, 0, 0, time.UTC)
+	fetch := func(ctx context.Context, date time.Time, tabs []string) ([]api.Match, error) {
+		if date.Day() == 13 {
+			return nil, errors.New("upstream blew up")
+		}
+		return []api.Match{{ID: date.Day(), Status: api.MatchStatusNotStarted}}, nil
+	}
+
+	matches, failed, err := collectFixtures(context.Background(), fetch, now, 2)
+	if err != nil {
+		t.Fatalf("partial-success should not return err, got %v", err)
+	}
+	if len(failed) != 1 || failed[0] != "2026-06-13" {
+		t.Errorf("failedDates = %v, want [2026-06-13]", failed)
+	}
+	if len(matches) != 2 {
+		t.Errorf("matches len = %d, want 2", len(matches))
+	}
+}
+
+func TestCollectFixtures_AllFailureReturnsError(t *testing.T) {
+	now := time.Date(2026, 6, 12, 12, 0, 0, 0, time.UTC)
+	fetch := func(ctx context.Context, date time.Time, tabs []string) ([]api.Match, error) {
+		return nil, errors.New("nope")
+	}
+
+	matches, failed, err := collectFixtures(context.Background(), fetch, now, 1)
+	if err == nil {
+		t.Fatalf("expected err when all days fail")
+	}
+	if matches != nil {
+		t.Errorf("matches not nil on total failure: %v", matches)
+	}
+	if len(failed) != 2 {
+		t.Errorf("failedDates = %v, want 2", failed)
+	}
+}
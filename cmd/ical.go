@@ -0,0 +1,311 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/spf13/cobra"
+)
+
+// icalMatchDuration is the event length: 90 minutes, half-time and stoppage.
+const icalMatchDuration = 2 * time.Hour
+
+// icalTimeLayout is the RFC 5545 UTC DATE-TIME form.
+const icalTimeLayout = "20060102T150405Z"
+
+// collectCalendar fetches every match from `pastDays` days ago through
+// `daysAhead` days from today. Past days query results, future days fixtures
+// and today both, so a match stays in the feed while it is being played.
+// Dedup, failed dates and the all-failed error follow collectFinished.
+func collectCalendar(ctx context.Context, fetch finishedDayFetcher, now time.Time, pastDays, daysAhead int) ([]api.Match, []string, error) {
+	dedup := make(map[int]api.Match, (pastDays+daysAhead+1)*10)
+	var failedDates []string
+	successCount := 0
+	var lastErr error
+
+	for i := -pastDays; i <= daysAhead; i++ {
+		date := now.AddDate(0, 0, i).UTC()
+		dateStr := date.Format("2006-01-02")
+
+		var tabs []string
+		switch {
+		case i < 0:
+			tabs = []string{"results"}
+		case i == 0:
+			tabs = []string{"fixtures", "results"}
+		default:
+			tabs = []string{"fixtures"}
+		}
+
+		matches, err := fetch(ctx, date, tabs)
+		if err != nil {
+			failedDates = append(failedDates, dateStr)
+			lastErr = err
+			continue
+		}
+		successCount++
+		for _, m := range matches {
+			dedup[m.ID] = m
+		}
+	}
+
+	if successCount == 0 {
+		return nil, failedDates, lastErr
+	}
+
+	out := make([]api.Match, 0, len(dedup))
+	for _, m := range dedup {
+		out = append(out, m)
+	}
+	return out, failedDates, nil
+}
+
+// mockCalendar returns the bundled matches: finished ones from today and the
+// previous pastDays days (by UTC date, like collectCalendar), live ones and
+// upcoming fixtures inside the look-ahead window.
+func mockCalendar(now time.Time, pastDays, daysAhead int) []api.Match {
+	utc := now.UTC()
+	since := time.Date(utc.Year(), utc.Month(), utc.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -pastDays)
+
+	dedup := make(map[int]api.Match)
+	for _, m := range data.MockFinishedMatches() {
+		if m.MatchTime != nil && m.MatchTime.Before(since) {
+			continue
+		}
+		dedup[m.ID] = m
+	}
+	for _, m := range append(data.MockLiveMatches(), mockFixtures(now, daysAhead)...) {
+		dedup[m.ID] = m
+	}
+	out := make([]api.Match, 0, len(dedup))
+	for _, m := range dedup {
+		out = append(out, m)
+	}
+	return out
+}
+
+// writeICal writes matches as an RFC 5545 VCALENDAR. Matches without a
+// kickoff time are skipped. now stamps DTSTAMP.
+func writeICal(w io.Writer, matches []api.Match, now time.Time) error {
+	bw := bufio.NewWriter(w)
+	line := func(name, value string) {
+		writeICalLine(bw, name+":"+value)
+	}
+
+	line("BEGIN", "VCALENDAR")
+	line("VERSION", "2.0")
+	line("PRODID", "-//golazo//golazo "+Version+"//EN")
+	line("CALSCALE", "GREGORIAN")
+	line("METHOD", "PUBLISH")
+	line("X-WR-CALNAME", "Golazo")
+	stamp := now.UTC().Format(icalTimeLayout)
+	for _, m := range matches {
+		if m.MatchTime == nil {
+			continue
+		}
+		start := m.MatchTime.UTC()
+		line("BEGIN", "VEVENT")
+		line("UID", "match-"+strconv.Itoa(m.ID)+"@golazo")
+		line("DTSTAMP", stamp)
+		line("DTSTART", start.Format(icalTimeLayout))
+		line("DTEND", start.Add(icalMatchDuration).Format(icalTimeLayout))
+		line("SUMMARY", icalEscape(icalSummary(m)))
+		if desc := icalDescription(m); desc != "" {
+			line("DESCRIPTION", icalEscape(desc))
+		}
+		if url := matchPageURL(m); url != "" {
+			line("URL", url)
+		}
+		line("STATUS", icalStatus(m.Status))
+		line("END", "VEVENT")
+	}
+	line("END", "VCALENDAR")
+	return bw.Flush()
+}
+
+// icalSummary is "Home vs Away (League)", or "Home 2-1 Away (League)" once
+// the match has finished.
+func icalSummary(m api.Match) string {
+	middle := "vs"
+	if m.Status == api.MatchStatusFinished && m.HomeScore != nil && m.AwayScore != nil {
+		middle = formatScore(m)
+	}
+	summary := m.HomeTeam.Name + " " + middle + " " + m.AwayTeam.Name
+	if m.League.Name != "" {
+		summary += " (" + m.League.Name + ")"
+	}
+	return summary
+}
+
+func icalDescription(m api.Match) string {
+	var parts []string
+	if m.Round != "" {
+		parts = append(parts, m.Round)
+	}
+	if url := matchPageURL(m); url != "" {
+		parts = append(parts, url)
+	}
+	return strings.Join(parts, "\n")
+}
+
+// icalStatus maps a match status onto the VEVENT STATUS property.
+func icalStatus(s api.MatchStatus) string {
+	switch s {
+	case api.MatchStatusCancelled:
+		return "CANCELLED"
+	case api.MatchStatusPostponed:
+		return "TENTATIVE"
+	default:
+		return "CONFIRMED"
+	}
+}
+
+// matchPageURL returns the FotMob match page for m, or "" when its slug is
+// unknown.
+func matchPageURL(m api.Match) string {
+	if m.PageURL == "" {
+		return ""
+	}
+	if strings.HasPrefix(m.PageURL, "http") {
+		return m.PageURL
+	}
+	return "https://www.fotmob.com" + m.PageURL
+}
+
+// icalEscape escapes a TEXT value (RFC 5545 §3.3.11).
+func icalEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
+
+// writeICalLine writes one content line, CRLF-terminated and folded at 75
+// octets without splitting a UTF-8 sequence (RFC 5545 §3.1).
+func writeICalLine(w *bufio.Writer, s string) {
+	const limit = 75
+	for first := true; ; first = false {
+		n := limit
+		if !first {
+			n-- // continuation lines start with a space
+			w.WriteByte(' ')
+		}
+		if len(s) <= n {
+			w.WriteString(s)
+			w.WriteString("\r\n")
+			return
+		}
+		cut := n
+		for cut > 0 && !utf8.RuneStart(s[cut]) {
+			cut--
+		}
+		w.WriteString(s[:cut])
+		w.WriteString("\r\n")
+		s = s[cut:]
+	}
+}
+
+// icalFlags is the `ical` flag set. It has no --pretty or --format: the
+// output is always iCalendar.
+type icalFlags struct {
+	cliFlags
+	daysAhead int
+	pastDays  int
+}
+
+var icalFlagSet icalFlags
+
+// runICal is the testable core of the `ical` subcommand. The calendar goes
+// to stdout; errors are the usual JSON envelope on stderr.
+func runICal(stdout, stderr io.Writer, flags icalFlags) int {
+	if flags.daysAhead < 0 || flags.daysAhead > MaxFixturesDaysAhead {
+		return WriteError(stderr, ErrCodeInvalidArgs,
+			NewInvalidArg("--days-ahead must be between 0 and %d, got %d", MaxFixturesDaysAhead, flags.daysAhead))
+	}
+	if flags.pastDays < 0 || flags.pastDays > MaxFinishedDays {
+		return WriteError(stderr, ErrCodeInvalidArgs,
+			NewInvalidArg("--past-days must be between 0 and %d, got %d", MaxFinishedDays, flags.pastDays))
+	}
+	filter, err := resolveFilter(flags.filters)
+	if err != nil {
+		return WriteError(stderr, ErrCodeInvalidArgs, err)
+	}
+
+	client, ctx, cancel, err := newHeadlessClient(runtimeOpts{
+		mock:    flags.mock,
+		debug:   flags.debug,
+		timeout: flags.timeout,
+	})
+	defer cancel()
+	if err == ErrOffline {
+		return WriteError(stderr, ErrCodeOffline, err)
+	}
+	if err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+
+	now := time.Now()
+	var (
+		matches     []api.Match
+		failedDates []string
+	)
+	if flags.mock {
+		matches = mockCalendar(now, flags.pastDays, flags.daysAhead)
+	} else {
+		matches, failedDates, err = collectCalendar(ctx, defaultFinishedFetcher(client, filter.leagueIDs), now, flags.pastDays, flags.daysAhead)
+		if err != nil {
+			return WriteError(stderr, ClassifyClientError(err, isTimeout(ctx)), err)
+		}
+		if isTimeout(ctx) {
+			return WriteError(stderr, ErrCodeTimeout,
+				fmt.Errorf("calendar fetch timed out after %s", flags.timeout))
+		}
+		_ = savePageURLs(client)
+	}
+
+	matches = filter.apply(matches)
+	SortMatches(matches)
+
+	if len(failedDates) > 0 {
+		fmt.Fprintf(stderr, "warning: partial results, failed dates: %s\n", strings.Join(failedDates, ", "))
+	}
+	if err := writeICal(stdout, matches, now); err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+	return ExitOK
+}
+
+var icalCmd = &cobra.Command{
+	Use:   "ical",
+	Short: "Export fixtures as an iCalendar (.ics) feed",
+	Long: `Writes an RFC 5545 calendar of matches from today through the next --days-ahead days (default 7, max 14) to stdout, for import into Google Calendar, Thunderbird or any other calendar app. --past-days N (max 7) adds finished matches from the last N days with their final score.
+
+Each event has a stable UID (match-<id>@golazo), so re-importing updates events instead of duplicating them. Filter with --league and --team. Partial failures are reported as a warning on stderr.
+
+Example:
+  golazo ical --team arsenal --days-ahead 14 > arsenal.ics`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		code := runICal(os.Stdout, os.Stderr, icalFlagSet)
+		if code != ExitOK {
+			os.Exit(code)
+		}
+	},
+}
+
+func init() {
+	icalCmd.Flags().BoolVar(&icalFlagSet.mock, "mock", false, "Use mock data instead of real API")
+	icalCmd.Flags().BoolVar(&icalFlagSet.debug, "debug", false, "Emit debug logs to stderr")
+	icalCmd.Flags().DurationVar(&icalFlagSet.timeout, "timeout", 15*time.Second, "Overall request timeout")
+	icalCmd.Flags().IntVar(&icalFlagSet.daysAhead, "days-ahead", 7, "Number of days to look ahead (0..14)")
+	icalCmd.Flags().IntVar(&icalFlagSet.pastDays, "past-days", 0, "Also include finished matches from the last N days (0..7)")
+	addLeagueTeamFlags(icalCmd, &icalFlagSet.cliFlags)
+	rootCmd.AddCommand(icalCmd)
+}
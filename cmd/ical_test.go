@@ -0,0 +1,202 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+func TestWriteICal_Event(t *testing.T) {
+	kickoff := time.Date(2026, 6, 12, 19, 0, 0, 0, time.UTC)
+	now := time.Date(2026, 6, 10, 8, 0, 0, 0, time.UTC)
+	matches := []api.Match{
+		{
+			ID:        4506424,
+			League:    api.League{Name: "Premier League"},
+			HomeTeam:  api.Team{Name: "Chelsea"},
+			AwayTeam:  api.Team{Name: "Tottenham"},
+			Status:    api.MatchStatusFinished,
+			HomeScore: intPtr(2),
+			AwayScore: intPtr(1),
+			MatchTime: &kickoff,
+			Round:     "Matchday 17",
+			PageURL:   "/matches/chelsea-vs-tottenham/abc123",
+		},
+		{ID: 1, Status: api.MatchStatusNotStarted}, // no kickoff: skipped
+	}
+
+	var out bytes.Buffer
+	if err := writeICal(&out, matches, now); err != nil {
+		t.Fatalf("writeICal: %v", err)
+	}
+	got := out.String()
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\n",
+		"UID:match-4506424@golazo\r\n",
+		"DTSTAMP:20260610T080000Z\r\n",
+		"DTSTART:20260612T190000Z\r\n",
+		"DTEND:20260612T210000Z\r\n",
+		"SUMMARY:Chelsea 2-1 Tottenham (Premier League)\r\n",
+		"URL:https://www.fotmob.com/matches/chelsea-vs-tottenham/abc123\r\n",
+		"STATUS:CONFIRMED\r\n",
+		"END:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("calendar missing %q:\n%s", want, got)
+		}
+	}
+	if n := strings.Count(got, "BEGIN:VEVENT"); n != 1 {
+		t.Errorf("VEVENT count = %d, want 1", n)
+	}
+}
+
+func TestICalSummary_Upcoming(t *testing.T) {
+	m := api.Match{
+		League:   api.League{Name: "La Liga"},
+		HomeTeam: api.Team{Name: "Real Madrid"},
+		AwayTeam: api.Team{Name: "Atlético Madrid"},
+		Status:   api.MatchStatusNotStarted,
+	}
+	if got, want := icalSummary(m), "Real Madrid vs Atlético Madrid (La Liga)"; got != want {
+		t.Errorf("summary = %q, want %q", got, want)
+	}
+	if got, want := icalEscape("a,b;c\\d\ne"), `a\,b\;c\\d\ne`; got != want {
+		t.Errorf("escape = %q, want %q", got, want)
+	}
+}
+
+func TestWriteICalLine_FoldsAt75Octets(t *testing.T) {
+	var out bytes.Buffer
+	bw := bufio.NewWriter(&out)
+	long := "SUMMARY:" + strings.Repeat("é", 80) // 2 octets per rune
+	writeICalLine(bw, long)
+	bw.Flush()
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\r\n"), "\r\n")
+	if len(lines) < 2 {
+		t.Fatalf("expected folding, got %q", out.String())
+	}
+	var unfolded strings.Builder
+	for i, l := range lines {
+		if len(l) > 75 {
+			t.Errorf("line %d is %d octets", i, len(l))
+		}
+		if i > 0 {
+			if !strings.HasPrefix(l, " ") {
+				t.Errorf("continuation line %d lacks leading space", i)
+			}
+			l = l[1:]
+		}
+		unfolded.WriteString(l)
+	}
+	if unfolded.String() != long {
+		t.Errorf("unfolded line differs from input")
+	}
+}
+
+func TestCollectCalendar_TabsPerDay(t *testing.T) {
+	now := time.Date(2026, 6, 12, 12, 0, 0, 0, time.UTC)
+	tabsByDate := map[string]string{}
+	fetch := func(ctx context.Context, date time.Time, tabs []string) ([]api.Match, error) {
+		day := date.Format("2006-01-02")
+		tabsByDate[day] = strings.Join(tabs, "+")
+		if day == "2026-06-13" {
+			return nil, errors.New("boom")
+		}
+		return []api.Match{{ID: 7}}, nil
+	}
+
+	got, failed, err := collectCalendar(context.Background(), fetch, now, 1, 2)
+	if err != nil {
+		t.Fatalf("collectCalendar: %v", err)
+	}
+	want := map[string]string{
+		"2026-06-11": "results",
+		"2026-06-12": "fixtures+results",
+		"2026-06-13": "fixtures",
+		"2026-06-14": "fixtures",
+	}
+	for day, tabs := range want {
+		if tabsByDate[day] != tabs {
+			t.Errorf("%s tabs = %q, want %q", day, tabsByDate[day], tabs)
+		}
+	}
+	if len(got) != 1 {
+		t.Errorf("matches = %d, want 1 (deduplicated)", len(got))
+	}
+	if len(failed) != 1 || failed[0] != "2026-06-13" {
+		t.Errorf("failed = %v, want [2026-06-13]", failed)
+	}
+}
+
+func TestRunICal_MockAndInvalidArgs(t *testing.T) {
+	t.Setenv(EnvOffline, "")
+
+	var stdout, stderr bytes.Buffer
+	code := runICal(&stdout, &stderr, icalFlags{
+		cliFlags:  cliFlags{mock: true, timeout: time.Second, filters: listFilterFlags{teams: []string{"chelsea"}}},
+		daysAhead: 7,
+	})
+	if code != ExitOK {
+		t.Fatalf("exit = %d, stderr=%s", code, stderr.String())
+	}
+	if !strings.HasPrefix(stdout.String(), "BEGIN:VCALENDAR\r\n") || !strings.Contains(stdout.String(), "Chelsea") {
+		t.Errorf("calendar = %q", stdout.String())
+	}
+	for _, block := range strings.Split(stdout.String(), "BEGIN:VEVENT")[1:] {
+		if !strings.Contains(block, "Chelsea") {
+			t.Errorf("team filter leaked an event:\n%s", block)
+		}
+	}
+
+	stdout.Reset()
+	stderr.Reset()
+	if code := runICal(&stdout, &stderr, icalFlags{cliFlags: cliFlags{mock: true}, daysAhead: 15}); code != ExitInvalidArgs {
+		t.Errorf("exit = %d, want %d", code, ExitInvalidArgs)
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("stdout should be empty on invalid args, got: %s", stdout.String())
+	}
+}
+
+func TestRunICal_MockHonoursPastDays(t *testing.T) {
+	t.Setenv(EnvOffline, "")
+
+	calendar := func(pastDays int) string {
+		t.Helper()
+		var stdout, stderr bytes.Buffer
+		code := runICal(&stdout, &stderr, icalFlags{cliFlags: cliFlags{mock: true, timeout: time.Second}, pastDays: pastDays})
+		if code != ExitOK {
+			t.Fatalf("exit = %d, stderr=%s", code, stderr.String())
+		}
+		return stdout.String()
+	}
+
+	now := time.Now().UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	todayOnly, week := calendar(0), calendar(MaxFinishedDays)
+	older := 0
+	for _, m := range data.MockFinishedMatches() {
+		uid := "UID:match-" + strconv.Itoa(m.ID) + "@golazo"
+		if !strings.Contains(week, uid) {
+			t.Errorf("--past-days %d is missing finished match %d", MaxFinishedDays, m.ID)
+		}
+		if m.MatchTime.Before(today) {
+			older++
+			if strings.Contains(todayOnly, uid) {
+				t.Errorf("--past-days 0 exported match %d from %s", m.ID, m.MatchTime.Format(time.DateOnly))
+			}
+		}
+	}
+	if older == 0 {
+		t.Fatal("mock data has no finished matches before today")
+	}
+}
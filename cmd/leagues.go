@@ -18,17 +18,22 @@ type leaguesFlags struct {
 
 var leaguesFlagSet leaguesFlags
 
-// resolveLeagues returns league metadata for either the active set (default)
-// or every supported league (--all). Output is sorted by ID for determinism.
-// Pure in-memory read of data.AllSupportedLeagues; no API call.
-func resolveLeagues(all bool) []api.League {
-	// Build an ID → LeagueInfo lookup over the full catalog.
+// leagueCatalog builds an ID → LeagueInfo lookup over every supported league.
+func leagueCatalog() map[int]data.LeagueInfo {
 	catalog := make(map[int]data.LeagueInfo, 200)
 	for _, regionLeagues := range data.AllSupportedLeagues {
 		for _, info := range regionLeagues {
 			catalog[info.ID] = info
 		}
 	}
+	return catalog
+}
+
+// resolveLeagues returns league metadata for either the active set (default)
+// or every supported league (--all). Output is sorted by ID for determinism.
+// Pure in-memory read of data.AllSupportedLeagues; no API call.
+func resolveLeagues(all bool) []api.League {
+	catalog := leagueCatalog()
 
 	var ids []int
 	if all {
@@ -62,8 +67,13 @@ func resolveLeagues(all bool) []api.League {
 // runLeagues is the testable core of the `leagues` subcommand.
 func runLeagues(stdout, stderr io.Writer, flags leaguesFlags) int {
 	applyPretty(flags.cliFlags)
+
+	format, err := outputFormat(flags.cliFlags)
+	if err != nil {
+		return WriteError(stderr, ErrCodeInvalidArgs, err)
+	}
 	leagues := resolveLeagues(flags.all)
-	if err := WriteJSON(stdout, leagues); err != nil {
+	if err := writeList(stdout, stderr, format, leagues, nil); err != nil {
 		return WriteError(stderr, ErrCodeUpstreamError, err)
 	}
 	return ExitOK
@@ -88,6 +98,7 @@ Example output:
 
 func init() {
 	addPrettyOnlyFlag(leaguesCmd, &leaguesFlagSet.cliFlags)
+	addFormatFlag(leaguesCmd, &leaguesFlagSet.cliFlags)
 	leaguesCmd.Flags().BoolVar(&leaguesFlagSet.all, "all", false, "List every supported league, not just the active selection")
 	rootCmd.AddCommand(leaguesCmd)
 }
@@ -9,7 +9,6 @@ import (
 
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/data"
-	"github.com/0xjuanma/golazo/internal/fotmob"
 	"github.com/spf13/cobra"
 )
 
@@ -20,6 +19,8 @@ type cliFlags struct {
 	debug   bool
 	timeout time.Duration
 	pretty  bool
+	format  string          // list subcommands only; see addFormatFlag
+	filters listFilterFlags // live, finished, ical only; see addFilterFlags
 }
 
 // addCommonCLIFlags registers --mock, --debug, --timeout, --pretty on a subcmd.
@@ -39,12 +40,13 @@ func addPrettyOnlyFlag(cmd *cobra.Command, f *cliFlags) {
 
 // applyPretty syncs the package-level Pretty toggle. Subcommands call this
 // from RunE before emitting output. GOLAZO_AGENT forces compact regardless.
+// Pretty is only assigned when it changes, so concurrent `serve` handlers
+// (which all want compact output) never write it.
 func applyPretty(f cliFlags) {
-	if agentMode() {
-		Pretty = false
-		return
+	want := f.pretty && !agentMode()
+	if Pretty != want {
+		Pretty = want
 	}
-	Pretty = f.pretty
 }
 
 // liveFetcher abstracts the live-matches data source so runLive can be tested
@@ -52,8 +54,14 @@ func applyPretty(f cliFlags) {
 // fotmob.Client; mock-mode callers bypass it entirely.
 type liveFetcher func(ctx context.Context) ([]api.Match, error)
 
-func defaultLiveFetcher(c *fotmob.Client) liveFetcher {
+// defaultLiveFetcher queries the active leagues, or only leagueIDs when set
+// (--league), which may lie outside the active selection.
+func defaultLiveFetcher(c api.Client, leagueIDs []int) liveFetcher {
 	return func(ctx context.Context) ([]api.Match, error) {
+		if len(leagueIDs) > 0 {
+			live, _, err := c.LiveAndUpcomingForLeagues(ctx, leagueIDs)
+			return live, err
+		}
 		live, _, err := c.LiveAndUpcoming(ctx)
 		return live, err
 	}
@@ -64,6 +72,15 @@ func defaultLiveFetcher(c *fotmob.Client) liveFetcher {
 func runLive(stdout, stderr io.Writer, flags cliFlags) int {
 	applyPretty(flags)
 
+	format, err := outputFormat(flags)
+	if err != nil {
+		return WriteError(stderr, ErrCodeInvalidArgs, err)
+	}
+	filter, err := resolveFilter(flags.filters)
+	if err != nil {
+		return WriteError(stderr, ErrCodeInvalidArgs, err)
+	}
+
 	client, ctx, cancel, err := newHeadlessClient(runtimeOpts{
 		mock:    flags.mock,
 		debug:   flags.debug,
@@ -81,7 +98,7 @@ func runLive(stdout, stderr io.Writer, flags cliFlags) int {
 	if flags.mock {
 		matches = data.MockLiveMatches()
 	} else {
-		matches, err = defaultLiveFetcher(client)(ctx)
+		matches, err = defaultLiveFetcher(client, filter.leagueIDs)(ctx)
 		if err != nil {
 			return WriteError(stderr, ClassifyClientError(err, isTimeout(ctx)), err)
 		}
@@ -93,10 +110,14 @@ func runLive(stdout, stderr io.Writer, flags cliFlags) int {
 			return WriteError(stderr, ErrCodeTimeout,
 				fmt.Errorf("live matches fetch timed out after %s", flags.timeout))
 		}
+		// Best-effort: persist page slugs so a follow-up `golazo match <id>`
+		// resolves without re-scanning league pages.
+		_ = savePageURLs(client)
 	}
 
+	matches = filter.apply(matches)
 	SortMatches(matches)
-	if err := WriteJSON(stdout, matches); err != nil {
+	if err := writeList(stdout, stderr, format, matches, nil); err != nil {
 		return WriteError(stderr, ErrCodeUpstreamError, err)
 	}
 	return ExitOK
@@ -109,6 +130,8 @@ var liveCmd = &cobra.Command{
 	Short:         "List live matches as JSON",
 	Long: `Fetches today's live matches for the active leagues and prints a JSON envelope to stdout.
 
+--league narrows the fetch to the given leagues (IDs or names, including leagues outside the active selection); --team and --status filter the result.
+
 Example output:
   {"status":"ok","count":1,"data":[{"id":4506424,"league":{"id":47,"name":"Premier League","country":"England"},"home_team":{"id":8455,"name":"Chelsea","short_name":"Chelsea"},"away_team":{"id":6,"name":"Tottenham","short_name":"Spurs"},"status":"live","home_score":2,"away_score":1,"match_time":"2026-06-12T19:00:00Z","live_time":"67'","round":"Matchday 17"}]}`,
 	SilenceUsage:  true,
@@ -123,5 +146,7 @@ Example output:
 
 func init() {
 	addCommonCLIFlags(liveCmd, &liveFlags)
+	addFormatFlag(liveCmd, &liveFlags)
+	addFilterFlags(liveCmd, &liveFlags)
 	rootCmd.AddCommand(liveCmd)
 }
@@ -2,10 +2,12 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/data"
@@ -15,16 +17,57 @@ import (
 
 type matchDetailsFetcher func(ctx context.Context, matchID int) (*api.MatchDetails, error)
 
-func defaultMatchDetailsFetcher(c *fotmob.Client) matchDetailsFetcher {
+func defaultMatchDetailsFetcher(c api.Client) matchDetailsFetcher {
 	return c.MatchDetails
 }
 
-var matchFlagSet cliFlags
+// matchFlags extends the common flag set with slug-resolution hints,
+// --commentary and --h2h.
+type matchFlags struct {
+	cliFlags
+	date       string   // YYYY-MM-DD kickoff date hint
+	leagues    []string // league ID or name hints; default is the active leagues
+	pageURL    string   // explicit FotMob page slug or URL, skips resolution
+	commentary bool     // also fetch the live commentary
+	h2h        bool     // include the head-to-head record
+}
+
+// matchWithCommentary is the `match --commentary` payload: the details plus
+// the commentary in match order.
+type matchWithCommentary struct {
+	api.MatchDetails
+	Commentary []api.CommentaryEntry `json:"commentary"`
+}
+
+var matchFlagSet matchFlags
+
+// resolveMatchSlug makes sure the client knows the page slug for matchID.
+// FotMob only serves match details from the match page, whose slug appears in
+// the day/league listings. A --date hint fetches that day's listing first;
+// otherwise (or if that misses) the hinted or active league pages are scanned
+// for the ID. Slugs found along the way are persisted by the caller.
+func resolveMatchSlug(ctx context.Context, c *fotmob.Client, matchID int, date time.Time, leagueIDs []int) error {
+	if !date.IsZero() {
+		if len(leagueIDs) > 0 {
+			for _, leagueID := range leagueIDs {
+				_, _ = c.MatchesForLeagueAndDate(ctx, leagueID, date, "fixtures")
+				_, _ = c.MatchesForLeagueAndDate(ctx, leagueID, date, "results")
+			}
+		} else {
+			_, _ = c.MatchesByDate(ctx, date)
+		}
+	}
+	if len(leagueIDs) == 0 {
+		leagueIDs = fotmob.ActiveLeagues()
+	}
+	_, err := c.ResolvePageURL(ctx, matchID, leagueIDs)
+	return err
+}
 
 // runMatch is the testable core of the `match` subcommand.
 // args is the positional arg slice from cobra (we expect exactly one ID).
-func runMatch(stdout, stderr io.Writer, flags cliFlags, args []string) int {
-	applyPretty(flags)
+func runMatch(stdout, stderr io.Writer, flags matchFlags, args []string) int {
+	applyPretty(flags.cliFlags)
 
 	if len(args) != 1 {
 		return WriteError(stderr, ErrCodeInvalidArgs,
@@ -36,6 +79,33 @@ func runMatch(stdout, stderr io.Writer, flags cliFlags, args []string) int {
 			NewInvalidArg("match id must be a positive integer, got %q", args[0]))
 	}
 
+	var date time.Time
+	if flags.date != "" {
+		date, err = time.Parse("2006-01-02", flags.date)
+		if err != nil {
+			return WriteError(stderr, ErrCodeInvalidArgs,
+				NewInvalidArg("--date must be YYYY-MM-DD, got %q", flags.date))
+		}
+	}
+	var leagueIDs []int
+	for _, q := range flags.leagues {
+		id, err := resolveLeagueID(q)
+		if err != nil {
+			return WriteError(stderr, ErrCodeInvalidArgs, err)
+		}
+		if !containsInt(leagueIDs, id) {
+			leagueIDs = append(leagueIDs, id)
+		}
+	}
+	pageSlug := ""
+	if flags.pageURL != "" {
+		pageSlug = fotmob.NormalizePageURL(flags.pageURL)
+		if pageSlug == "" {
+			return WriteError(stderr, ErrCodeInvalidArgs,
+				NewInvalidArg("--page-url must be a FotMob match page (/matches/...), got %q", flags.pageURL))
+		}
+	}
+
 	client, ctx, cancel, err := newHeadlessClient(runtimeOpts{
 		mock:    flags.mock,
 		debug:   flags.debug,
@@ -49,13 +119,29 @@ func runMatch(stdout, stderr io.Writer, flags cliFlags, args []string) int {
 		return WriteError(stderr, ErrCodeUpstreamError, err)
 	}
 
-	var (
-		details *api.MatchDetails
-	)
+	if flags.h2h && !flags.mock && asFotmob(client) == nil {
+		return WriteError(stderr, ErrCodeNotFound, fmt.Errorf("head to head: %w", api.ErrUnsupported))
+	}
+
+	var details *api.MatchDetails
 	if flags.mock {
 		details, err = data.MockMatchDetails(id)
 	} else {
+		// Only FotMob needs a page slug; other providers fetch by ID.
+		if fc := asFotmob(client); fc != nil {
+			if pageSlug != "" {
+				fc.StorePageURL(id, pageSlug)
+			} else if err := resolveMatchSlug(ctx, fc, id, date, leagueIDs); err != nil {
+				if errors.Is(err, fotmob.ErrPageURLNotFound) && !isTimeout(ctx) {
+					return WriteError(stderr, ErrCodeNotFound,
+						fmt.Errorf("%w; pass --league, --date or --page-url to widen the lookup", err))
+				}
+				return WriteError(stderr, ClassifyClientError(err, isTimeout(ctx)), err)
+			}
+		}
 		details, err = defaultMatchDetailsFetcher(client)(ctx, id)
+		// Best-effort: persist every slug seen so later calls skip the lookup.
+		_ = savePageURLs(client)
 	}
 	if err != nil {
 		return WriteError(stderr, ClassifyClientError(err, isTimeout(ctx)), err)
@@ -63,6 +149,30 @@ func runMatch(stdout, stderr io.Writer, flags cliFlags, args []string) int {
 	if details == nil {
 		return WriteError(stderr, ErrCodeNotFound, fmt.Errorf("no match found for id %d", id))
 	}
+	// Work on a copy: the client may hand out its cached details (serve, mcp).
+	shown := *details
+	details = &shown
+	if !flags.h2h {
+		details.HeadToHead = nil
+	} else if details.HeadToHead == nil {
+		// Keep the key present: the page had no previous meetings.
+		details.HeadToHead = &api.HeadToHead{Matches: []api.Match{}}
+	}
+
+	if flags.commentary {
+		out := matchWithCommentary{MatchDetails: *details, Commentary: []api.CommentaryEntry{}}
+		if !flags.mock {
+			entries, err := client.Commentary(ctx, id, "")
+			if err != nil {
+				return WriteError(stderr, ClassifyClientError(err, isTimeout(ctx)), fmt.Errorf("commentary: %w", err))
+			}
+			out.Commentary = append(out.Commentary, entries...)
+		}
+		if err := WriteJSON(stdout, []matchWithCommentary{out}); err != nil {
+			return WriteError(stderr, ErrCodeUpstreamError, err)
+		}
+		return ExitOK
+	}
 
 	if err := WriteJSON(stdout, []api.MatchDetails{*details}); err != nil {
 		return WriteError(stderr, ErrCodeUpstreamError, err)
@@ -72,16 +182,23 @@ func runMatch(stdout, stderr io.Writer, flags cliFlags, args []string) int {
 
 var matchCmd = &cobra.Command{
 	Use:           "match <id>",
-	Short:         "Get match details as JSON (best-effort; see notes)",
+	Short:         "Get match details as JSON",
 	Long: `Fetches detailed information (events, lineups, stats, formations) for a single match by ID.
 
-LIMITATION: This subcommand is BEST-EFFORT only. FotMob's match-details endpoint is gated behind Cloudflare and requires a page slug that this CLI cannot reliably obtain in a one-shot invocation. Cold calls with arbitrary IDs typically return upstream_error (HTTP 404), even for valid IDs returned by 'live' or 'finished' in a separate process.
+FotMob serves match details from the match page, which needs the page slug listed alongside the match in league/day listings. The CLI resolves it automatically:
+  - slugs seen by earlier 'live', 'finished', 'fixtures' or 'match' calls are persisted in the cache directory
+  - otherwise the active league pages are scanned for the ID
+  - --league <id|name> (repeatable) and --date YYYY-MM-DD narrow or redirect that lookup
+  - --page-url accepts the match's page_url (or a full fotmob.com URL) and skips the lookup
+
+IDs that cannot be found in any scanned league return not_found.
+
+--commentary adds a "commentary" array with the minute-by-minute live ticker in match order (empty with --mock or when the match has none).
 
-Reliable usage:
-  - With --mock: works against bundled mock IDs (e.g. 2001, 2002)
-  - From inside the TUI: 'golazo' (interactive) reliably loads match details
+--h2h adds a "head_to_head" object: the home team's wins, draws and the away team's wins against each other, and the recent meetings, most recent first. Other data providers return not_found.
 
-Not recommended for production agent pipelines. Agents that need event-level data should rely on the 'live' and 'finished' subcommands, which return match metadata, scores, status, and round info without this constraint.
+Example:
+  golazo live | jq -r '.data[0].id' | xargs golazo match
 
 Example (mock):
   golazo match 2001 --mock
@@ -100,6 +217,11 @@ Example output (truncated):
 }
 
 func init() {
-	addCommonCLIFlags(matchCmd, &matchFlagSet)
+	addCommonCLIFlags(matchCmd, &matchFlagSet.cliFlags)
+	matchCmd.Flags().StringVar(&matchFlagSet.date, "date", "", "Kickoff date hint (YYYY-MM-DD) used to find the match page")
+	matchCmd.Flags().StringSliceVar(&matchFlagSet.leagues, "league", nil, "League ID or name hint used to find the match page (repeatable)")
+	matchCmd.Flags().StringVar(&matchFlagSet.pageURL, "page-url", "", "FotMob match page slug or URL (the page_url field); skips the lookup")
+	matchCmd.Flags().BoolVar(&matchFlagSet.commentary, "commentary", false, "Include the live commentary in match order")
+	matchCmd.Flags().BoolVar(&matchFlagSet.h2h, "h2h", false, "Include the head-to-head record and recent meetings of the two teams")
 	rootCmd.AddCommand(matchCmd)
 }
@@ -3,15 +3,17 @@ package cmd
 import (
 	"bytes"
 	"encoding/json"
+	"strconv"
 	"testing"
 	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/fotmob/fotmobtest"
 )
 
 func TestRunMatch_MissingArg(t *testing.T) {
 	var stdout, stderr bytes.Buffer
-	code := runMatch(&stdout, &stderr, cliFlags{mock: true, timeout: time.Second}, nil)
+	code := runMatch(&stdout, &stderr, matchFlags{cliFlags: cliFlags{mock: true, timeout: time.Second}}, nil)
 	if code != ExitInvalidArgs {
 		t.Errorf("exit = %d, want %d", code, ExitInvalidArgs)
 	}
@@ -29,7 +31,7 @@ func TestRunMatch_InvalidID(t *testing.T) {
 	for _, arg := range cases {
 		t.Run(arg, func(t *testing.T) {
 			var stdout, stderr bytes.Buffer
-			code := runMatch(&stdout, &stderr, cliFlags{mock: true, timeout: time.Second}, []string{arg})
+			code := runMatch(&stdout, &stderr, matchFlags{cliFlags: cliFlags{mock: true, timeout: time.Second}}, []string{arg})
 			if code != ExitInvalidArgs {
 				t.Errorf("arg=%q exit = %d, want %d", arg, code, ExitInvalidArgs)
 			}
@@ -39,7 +41,7 @@ func TestRunMatch_InvalidID(t *testing.T) {
 
 func TestRunMatch_MockUnknownIDReturnsNotFound(t *testing.T) {
 	var stdout, stderr bytes.Buffer
-	code := runMatch(&stdout, &stderr, cliFlags{mock: true, timeout: time.Second}, []string{"99999999"})
+	code := runMatch(&stdout, &stderr, matchFlags{cliFlags: cliFlags{mock: true, timeout: time.Second}}, []string{"99999999"})
 	if code != ExitNotFound {
 		t.Errorf("exit = %d, want %d", code, ExitNotFound)
 	}
@@ -55,7 +57,7 @@ func TestRunMatch_MockUnknownIDReturnsNotFound(t *testing.T) {
 func TestRunMatch_MockKnownIDReturnsDetails(t *testing.T) {
 	// 2001 is the first mock live match ID (see internal/data/mock_live_matches.go).
 	var stdout, stderr bytes.Buffer
-	code := runMatch(&stdout, &stderr, cliFlags{mock: true, timeout: time.Second}, []string{"2001"})
+	code := runMatch(&stdout, &stderr, matchFlags{cliFlags: cliFlags{mock: true, timeout: time.Second}}, []string{"2001"})
 	if code != ExitOK {
 		t.Fatalf("exit = %d, want %d. stderr=%s", code, ExitOK, stderr.String())
 	}
@@ -74,3 +76,129 @@ func TestRunMatch_MockKnownIDReturnsDetails(t *testing.T) {
 		t.Errorf("data[0].ID = %d, want 2001", env.Data[0].ID)
 	}
 }
+
+func TestRunMatch_ResolvesSlugFromLeaguePage(t *testing.T) {
+	srv := useFakeFotmob(t)
+
+	flags := matchFlags{cliFlags: cliFlags{timeout: 5 * time.Second}, leagues: []string{strconv.Itoa(fotmobtest.SeedLeagueID)}}
+	var stdout, stderr bytes.Buffer
+	if code := runMatch(&stdout, &stderr, flags, []string{strconv.Itoa(fotmobtest.SeedLiveMatchID)}); code != ExitOK {
+		t.Fatalf("exit = %d, stderr=%s", code, stderr.String())
+	}
+	var env struct {
+		Data []api.MatchDetails `json:"data"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &env); err != nil {
+		t.Fatalf("unmarshal: %v\nraw: %s", err, stdout.String())
+	}
+	if len(env.Data) != 1 || env.Data[0].ID != fotmobtest.SeedLiveMatchID || len(env.Data[0].Events) == 0 {
+		t.Fatalf("details = %+v", env.Data)
+	}
+	if srv.Requests(fotmobtest.SeedLiveMatchSlug) != 1 {
+		t.Error("match page was not fetched")
+	}
+
+	stdout.Reset()
+	if code := runMatch(&stdout, &stderr, flags, []string{"999"}); code != ExitNotFound {
+		t.Errorf("unknown match: exit = %d, want %d", code, ExitNotFound)
+	}
+}
+
+func TestRunMatch_Commentary(t *testing.T) {
+	srv := useFakeFotmob(t)
+	srv.SetCommentary(fotmobtest.SeedLiveMatchID, map[string]any{"events": []map[string]any{
+		{"elapsed": 30, "type": "goal", "text": "Goal!", "isHomeTeam": true},
+		{"elapsed": 1, "type": "comment", "text": "Kick-off."},
+	}})
+
+	flags := matchFlags{cliFlags: cliFlags{timeout: 5 * time.Second}, leagues: []string{strconv.Itoa(fotmobtest.SeedLeagueID)}, commentary: true}
+	var stdout, stderr bytes.Buffer
+	if code := runMatch(&stdout, &stderr, flags, []string{strconv.Itoa(fotmobtest.SeedLiveMatchID)}); code != ExitOK {
+		t.Fatalf("exit = %d, stderr=%s", code, stderr.String())
+	}
+	var env struct {
+		Data []struct {
+			ID         int                   `json:"id"`
+			Events     []api.MatchEvent      `json:"events"`
+			Commentary []api.CommentaryEntry `json:"commentary"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &env); err != nil {
+		t.Fatalf("unmarshal: %v\nraw: %s", err, stdout.String())
+	}
+	if len(env.Data) != 1 || env.Data[0].ID != fotmobtest.SeedLiveMatchID || len(env.Data[0].Events) == 0 {
+		t.Fatalf("details = %+v", env.Data)
+	}
+	if c := env.Data[0].Commentary; len(c) != 2 || c[0].Text != "Kick-off." || c[1].DisplayMinute != "30'" {
+		t.Errorf("commentary = %+v", c)
+	}
+
+	stdout.Reset()
+	mock := matchFlags{cliFlags: cliFlags{mock: true, timeout: time.Second}, commentary: true}
+	if code := runMatch(&stdout, &stderr, mock, []string{"2001"}); code != ExitOK {
+		t.Fatalf("mock: exit = %d, stderr=%s", code, stderr.String())
+	}
+	if !bytes.Contains(stdout.Bytes(), []byte(`"commentary":[]`)) {
+		t.Errorf("mock: want an empty commentary array, got %s", stdout.String())
+	}
+}
+
+func TestRunMatch_HeadToHead(t *testing.T) {
+	useFakeFotmob(t)
+	flags := matchFlags{cliFlags: cliFlags{timeout: 5 * time.Second}, leagues: []string{strconv.Itoa(fotmobtest.SeedLeagueID)}}
+	id := strconv.Itoa(fotmobtest.SeedLiveMatchID)
+
+	var stdout, stderr bytes.Buffer
+	if code := runMatch(&stdout, &stderr, flags, []string{id}); code != ExitOK {
+		t.Fatalf("exit = %d, stderr=%s", code, stderr.String())
+	}
+	if bytes.Contains(stdout.Bytes(), []byte(`"head_to_head"`)) {
+		t.Errorf("head_to_head without --h2h: %s", stdout.String())
+	}
+
+	stdout.Reset()
+	flags.h2h = true
+	if code := runMatch(&stdout, &stderr, flags, []string{id}); code != ExitOK {
+		t.Fatalf("--h2h: exit = %d, stderr=%s", code, stderr.String())
+	}
+	var env struct {
+		Data []api.MatchDetails `json:"data"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &env); err != nil {
+		t.Fatalf("unmarshal: %v\nraw: %s", err, stdout.String())
+	}
+	if h := env.Data[0].HeadToHead; h == nil || h.HomeWins != 3 || h.Draws != 1 || h.AwayWins != 2 || len(h.Matches) != 2 {
+		t.Errorf("head_to_head = %+v", h)
+	}
+
+	stdout.Reset()
+	mock := matchFlags{cliFlags: cliFlags{mock: true, timeout: time.Second}, h2h: true}
+	if code := runMatch(&stdout, &stderr, mock, []string{"2001"}); code != ExitOK {
+		t.Fatalf("mock: exit = %d, stderr=%s", code, stderr.String())
+	}
+	if !bytes.Contains(stdout.Bytes(), []byte(`"head_to_head":{`)) {
+		t.Errorf("mock: want head_to_head, got %s", stdout.String())
+	}
+}
+
+func TestRunMatch_InvalidHints(t *testing.T) {
+	cases := []struct {
+		name  string
+		flags matchFlags
+	}{
+		{"bad date", matchFlags{date: "12/06/2026"}},
+		{"bad league", matchFlags{leagues: []string{"47", "0"}}},
+		{"unknown league name", matchFlags{leagues: []string{"no such league"}}},
+		{"bad page url", matchFlags{pageURL: "https://example.com/foo"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.flags.cliFlags = cliFlags{mock: true, timeout: time.Second}
+			var stdout, stderr bytes.Buffer
+			code := runMatch(&stdout, &stderr, tc.flags, []string{"2001"})
+			if code != ExitInvalidArgs {
+				t.Errorf("exit = %d, want %d (stderr=%s)", code, ExitInvalidArgs, stderr.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,291 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// MCPProtocolVersion is the Model Context Protocol revision `golazo mcp` implements.
+const MCPProtocolVersion = "2025-06-18"
+
+// JSON-RPC 2.0 error codes used by the server.
+const (
+	rpcParseError     = -32700
+	rpcInvalidRequest = -32600
+	rpcMethodNotFound = -32601
+	rpcInvalidParams  = -32602
+)
+
+type rpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+// mcpTool is a tools/list entry.
+type mcpTool struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	InputSchema map[string]any `json:"inputSchema"`
+}
+
+type mcpContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// mcpToolResult is a tools/call result. The text content and the structured
+// content both carry the same JSON envelope the CLI prints, so error
+// envelopes keep their ErrorCode.
+type mcpToolResult struct {
+	Content           []mcpContent    `json:"content"`
+	StructuredContent json.RawMessage `json:"structuredContent,omitempty"`
+	IsError           bool            `json:"isError"`
+}
+
+// mcpSchemaType maps a capabilityFlag type onto a JSON Schema fragment.
+func mcpSchemaType(flagType string) map[string]any {
+	switch flagType {
+	case "bool":
+		return map[string]any{"type": "boolean"}
+	case "int":
+		return map[string]any{"type": "integer"}
+	case "[]int":
+		return map[string]any{"type": "array", "items": map[string]any{"type": "integer"}}
+	case "[]string":
+		return map[string]any{"type": "array", "items": map[string]any{"type": "string"}}
+	default: // string, duration
+		return map[string]any{"type": "string"}
+	}
+}
+
+// mcpInputSchema builds a tool's JSON Schema from its capabilities entry:
+// the positional arg becomes a required integer, every non-session flag an
+// optional property.
+func mcpInputSchema(cmd capabilityCommand) map[string]any {
+	props := map[string]any{}
+	required := []string{}
+	if cmd.Args != "" {
+		name := toolArgName(cmd.Args)
+		props[name] = map[string]any{"type": "integer", "description": "Positional " + cmd.Args + " argument"}
+		required = append(required, name)
+	}
+	for _, f := range cmd.Flags {
+		if toolSessionFlags[f.Name] {
+			continue
+		}
+		prop := mcpSchemaType(f.Type)
+		prop["description"] = f.Description
+		if f.Default != nil {
+			prop["default"] = f.Default
+		}
+		props[toolArgName(f.Name)] = prop
+	}
+	return map[string]any{
+		"type":                 "object",
+		"properties":           props,
+		"required":             required,
+		"additionalProperties": false,
+	}
+}
+
+// mcpTools returns the tools/list payload derived from buildCapabilities, so
+// the MCP surface stays in lockstep with the CLI contract.
+func mcpTools() []mcpTool {
+	byName := toolCommands()
+	tools := make([]mcpTool, 0, len(toolNames))
+	for _, name := range toolNames {
+		cmd, ok := byName[name]
+		if !ok {
+			continue
+		}
+		tools = append(tools, mcpTool{
+			Name:        name,
+			Description: cmd.Description,
+			InputSchema: mcpInputSchema(cmd),
+		})
+	}
+	return tools
+}
+
+// mcpServer serves tools/call requests by driving the same run* cores as the
+// CLI, capturing their envelopes instead of printing them.
+type mcpServer struct {
+	session cliFlags
+	tools   []mcpTool
+	byName  map[string]capabilityCommand
+}
+
+func newMCPServer(session cliFlags) *mcpServer {
+	return &mcpServer{session: session, tools: mcpTools(), byName: toolCommands()}
+}
+
+// callTool runs one tool and wraps its envelope as a tool result.
+func (s *mcpServer) callTool(cmd capabilityCommand, raw map[string]any) mcpToolResult {
+	var (
+		code     int
+		envelope []byte
+	)
+	args, err := jsonToolArgs(cmd, raw)
+	if err != nil {
+		var buf bytes.Buffer
+		code = WriteError(&buf, ErrCodeInvalidArgs, err)
+		envelope = bytes.TrimSpace(buf.Bytes())
+	} else {
+		code, envelope = runTool(s.session, cmd.Name, args)
+	}
+	return mcpToolResult{
+		Content:           []mcpContent{{Type: "text", Text: string(envelope)}},
+		StructuredContent: json.RawMessage(envelope),
+		IsError:           code != ExitOK,
+	}
+}
+
+// handle dispatches one JSON-RPC request. It returns nil for notifications.
+func (s *mcpServer) handle(req rpcRequest) *rpcResponse {
+	if len(req.ID) == 0 {
+		// Notifications (notifications/initialized, cancellations) need no reply.
+		return nil
+	}
+	resp := &rpcResponse{JSONRPC: "2.0", ID: req.ID}
+
+	switch req.Method {
+	case "initialize":
+		resp.Result = map[string]any{
+			"protocolVersion": MCPProtocolVersion,
+			"capabilities":    map[string]any{"tools": map[string]any{"listChanged": false}},
+			"serverInfo":      map[string]any{"name": "golazo", "version": Version},
+			"instructions":    "Football (soccer) match data. Tool results are the golazo CLI JSON envelope; see https://github.com/0xjuanma/golazo/blob/main/docs/CLI.md",
+		}
+	case "ping":
+		resp.Result = map[string]any{}
+	case "tools/list":
+		resp.Result = map[string]any{"tools": s.tools}
+	case "tools/call":
+		var params struct {
+			Name      string         `json:"name"`
+			Arguments map[string]any `json:"arguments"`
+		}
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			resp.Error = &rpcError{Code: rpcInvalidParams, Message: "invalid tools/call params: " + err.Error()}
+			break
+		}
+		cmd, ok := s.byName[params.Name]
+		if !ok {
+			resp.Error = &rpcError{Code: rpcInvalidParams, Message: fmt.Sprintf("unknown tool %q", params.Name)}
+			break
+		}
+		resp.Result = s.callTool(cmd, params.Arguments)
+	default:
+		resp.Error = &rpcError{Code: rpcMethodNotFound, Message: fmt.Sprintf("method %q not found", req.Method)}
+	}
+	return resp
+}
+
+// serve reads newline-delimited JSON-RPC messages from in until EOF and
+// writes one response line per request to out.
+func (s *mcpServer) serve(in io.Reader, out io.Writer) error {
+	reader := bufio.NewReader(in)
+	enc := json.NewEncoder(out)
+	for {
+		line, readErr := reader.ReadBytes('\n')
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 {
+			var resp *rpcResponse
+			var req rpcRequest
+			if err := json.Unmarshal(line, &req); err != nil {
+				resp = &rpcResponse{JSONRPC: "2.0", ID: json.RawMessage("null"),
+					Error: &rpcError{Code: rpcParseError, Message: "parse error: " + err.Error()}}
+			} else if req.JSONRPC != "2.0" || req.Method == "" {
+				resp = &rpcResponse{JSONRPC: "2.0", ID: req.ID,
+					Error: &rpcError{Code: rpcInvalidRequest, Message: "invalid JSON-RPC 2.0 request"}}
+				if len(resp.ID) == 0 {
+					resp.ID = json.RawMessage("null")
+				}
+			} else {
+				resp = s.handle(req)
+			}
+			if resp != nil {
+				if err := enc.Encode(resp); err != nil {
+					return err
+				}
+			}
+		}
+		if readErr != nil {
+			if errors.Is(readErr, io.EOF) {
+				return nil
+			}
+			return readErr
+		}
+	}
+}
+
+var mcpFlagSet cliFlags
+
+// runMCP is the testable core of the `mcp` subcommand. It serves until stdin
+// closes. One fotmob.Client backs every tool call, so page slugs seen by
+// live/finished/fixtures are immediately usable by match.
+func runMCP(stdin io.Reader, stdout, stderr io.Writer, flags cliFlags) int {
+	if flags.timeout <= 0 {
+		return WriteError(stderr, ErrCodeInvalidArgs,
+			NewInvalidArg("--timeout must be positive, got %s", flags.timeout))
+	}
+
+	restore := useSharedClient(flags.debug)
+	defer restore()
+
+	if err := newMCPServer(flags).serve(stdin, stdout); err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+	return ExitOK
+}
+
+var mcpCmd = &cobra.Command{
+	Use:   "mcp",
+	Short: "Serve the CLI as Model Context Protocol tools over stdio",
+	Long: `Speaks the Model Context Protocol (JSON-RPC 2.0, newline-delimited) on stdin/stdout until stdin closes.
+
+Tools: live, finished, fixtures, match, standings, leagues. Input schemas are generated from the same metadata as ` + "`golazo capabilities`" + `, with flags as snake_case arguments (e.g. days_ahead). Each tool result carries the CLI's JSON envelope; failures set isError and keep the error code.
+
+--mock, --debug and --timeout apply to every tool call in the session.
+
+Example client config:
+  {"mcpServers":{"golazo":{"command":"golazo","args":["mcp"]}}}`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		code := runMCP(os.Stdin, os.Stdout, os.Stderr, mcpFlagSet)
+		if code != ExitOK {
+			os.Exit(code)
+		}
+	},
+}
+
+func init() {
+	// No --pretty: the protocol framing requires one compact message per line.
+	mcpCmd.Flags().BoolVar(&mcpFlagSet.mock, "mock", false, "Use mock data instead of real API")
+	mcpCmd.Flags().BoolVar(&mcpFlagSet.debug, "debug", false, "Emit debug logs to stderr")
+	mcpCmd.Flags().DurationVar(&mcpFlagSet.timeout, "timeout", 15*time.Second, "Per-tool-call request timeout")
+	rootCmd.AddCommand(mcpCmd)
+}
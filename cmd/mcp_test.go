@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+// mcpExchange feeds newline-delimited requests to a mock-mode server and
+// returns the decoded responses in order.
+func mcpExchange(t *testing.T, requests ...string) []map[string]any {
+	t.Helper()
+	t.Setenv(EnvOffline, "")
+	t.Setenv(EnvAgent, "")
+
+	var stdout, stderr bytes.Buffer
+	in := strings.NewReader(strings.Join(requests, "\n") + "\n")
+	if code := runMCP(in, &stdout, &stderr, cliFlags{mock: true, timeout: time.Second}); code != ExitOK {
+		t.Fatalf("exit = %d, stderr=%s", code, stderr.String())
+	}
+
+	var out []map[string]any
+	scanner := bufio.NewScanner(&stdout)
+	scanner.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		var resp map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &resp); err != nil {
+			t.Fatalf("response is not JSON: %v\n%s", err, scanner.Text())
+		}
+		out = append(out, resp)
+	}
+	return out
+}
+
+func TestMCP_InitializeAndNotifications(t *testing.T) {
+	resps := mcpExchange(t,
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2025-06-18","capabilities":{},"clientInfo":{"name":"t","version":"0"}}}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"ping"}`,
+	)
+	if len(resps) != 2 {
+		t.Fatalf("got %d responses, want 2 (notification must not be answered)", len(resps))
+	}
+	result := resps[0]["result"].(map[string]any)
+	if result["protocolVersion"] != MCPProtocolVersion {
+		t.Errorf("protocolVersion = %v", result["protocolVersion"])
+	}
+	if _, ok := result["capabilities"].(map[string]any)["tools"]; !ok {
+		t.Errorf("initialize result does not advertise tools: %v", result)
+	}
+}
+
+func TestMCP_ToolsListMirrorsCapabilities(t *testing.T) {
+	resps := mcpExchange(t, `{"jsonrpc":"2.0","id":1,"method":"tools/list"}`)
+	tools := resps[0]["result"].(map[string]any)["tools"].([]any)
+
+	byName := map[string]map[string]any{}
+	for _, raw := range tools {
+		tool := raw.(map[string]any)
+		byName[tool["name"].(string)] = tool
+	}
+	for _, name := range []string{"live", "finished", "match", "leagues", "standings"} {
+		if _, ok := byName[name]; !ok {
+			t.Errorf("tool %q missing from tools/list", name)
+		}
+	}
+
+	finished := byName["finished"]["inputSchema"].(map[string]any)["properties"].(map[string]any)
+	days, ok := finished["days"].(map[string]any)
+	if !ok || days["type"] != "integer" || days["default"] != float64(1) {
+		t.Errorf("finished.days schema = %v", finished["days"])
+	}
+	if _, ok := finished["include_upcoming"]; !ok {
+		t.Errorf("finished schema missing include_upcoming: %v", finished)
+	}
+	if _, ok := finished["timeout"]; ok {
+		t.Errorf("session flag timeout leaked into tool schema")
+	}
+
+	standings := byName["standings"]["inputSchema"].(map[string]any)
+	if req := standings["required"].([]any); len(req) != 1 || req[0] != "league_id" {
+		t.Errorf("standings required = %v, want [league_id]", req)
+	}
+}
+
+func TestMCP_ToolsCall(t *testing.T) {
+	resps := mcpExchange(t,
+		`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"match","arguments":{"id":2001}}}`,
+		`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"finished","arguments":{"days":9}}}`,
+		`{"jsonrpc":"2.0","id":3,"method":"tools/call","params":{"name":"leagues","arguments":{"all":"yes"}}}`,
+		`{"jsonrpc":"2.0","id":4,"method":"tools/call","params":{"name":"nope"}}`,
+		`{"jsonrpc":"2.0","id":5,"method":"resources/list"}`,
+	)
+	if len(resps) != 5 {
+		t.Fatalf("got %d responses, want 5", len(resps))
+	}
+
+	ok := resps[0]["result"].(map[string]any)
+	if ok["isError"] != false {
+		t.Fatalf("match call failed: %v", ok)
+	}
+	env := ok["structuredContent"].(map[string]any)
+	if env["status"] != "ok" || env["count"] != float64(1) {
+		t.Errorf("match envelope = %v", env)
+	}
+
+	for i, wantCode := range map[int]ErrorCode{1: ErrCodeInvalidArgs, 2: ErrCodeInvalidArgs} {
+		res := resps[i]["result"].(map[string]any)
+		if res["isError"] != true {
+			t.Errorf("response %d: isError = %v, want true", i, res["isError"])
+			continue
+		}
+		if code := res["structuredContent"].(map[string]any)["code"]; code != string(wantCode) {
+			t.Errorf("response %d: code = %v, want %s", i, code, wantCode)
+		}
+	}
+
+	if code := resps[3]["error"].(map[string]any)["code"]; code != float64(rpcInvalidParams) {
+		t.Errorf("unknown tool error code = %v, want %d", code, rpcInvalidParams)
+	}
+	if code := resps[4]["error"].(map[string]any)["code"]; code != float64(rpcMethodNotFound) {
+		t.Errorf("unknown method error code = %v, want %d", code, rpcMethodNotFound)
+	}
+}
+
+func TestMCP_ParseError(t *testing.T) {
+	resps := mcpExchange(t, `{not json`)
+	if len(resps) != 1 {
+		t.Fatalf("got %d responses, want 1", len(resps))
+	}
+	if code := resps[0]["error"].(map[string]any)["code"]; code != float64(rpcParseError) {
+		t.Errorf("error code = %v, want %d", code, rpcParseError)
+	}
+}
+
+func TestMCP_ToolsCallFilters(t *testing.T) {
+	resps := mcpExchange(t,
+		`{"jsonrpc":"2.0","id":1,"method":"tools/call","params":{"name":"live","arguments":{"team":["spurs"],"status":["live"]}}}`,
+		`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"live","arguments":{"team":"spurs"}}}`,
+	)
+	if len(resps) != 2 {
+		t.Fatalf("got %d responses, want 2", len(resps))
+	}
+	env := resps[0]["result"].(map[string]any)["structuredContent"].(map[string]any)
+	if env["status"] != "ok" || env["count"] != float64(1) {
+		t.Errorf("filtered live envelope = %v", env)
+	}
+	if res := resps[1]["result"].(map[string]any); res["isError"] != true {
+		t.Errorf("scalar team should be rejected, got %v", res)
+	}
+}
@@ -0,0 +1,98 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/spf13/cobra"
+)
+
+var playerFlagSet cliFlags
+
+// runPlayer is the testable core of the `player` subcommand.
+// args is the positional arg slice from cobra (we expect exactly one player ID).
+func runPlayer(stdout, stderr io.Writer, flags cliFlags, args []string) int {
+	applyPretty(flags)
+
+	if len(args) != 1 {
+		return WriteError(stderr, ErrCodeInvalidArgs,
+			NewInvalidArg("expected exactly one player id, got %d args", len(args)))
+	}
+	id, err := strconv.Atoi(args[0])
+	if err != nil || id <= 0 {
+		return WriteError(stderr, ErrCodeInvalidArgs,
+			NewInvalidArg("player id must be a positive integer, got %q", args[0]))
+	}
+
+	client, ctx, cancel, err := newHeadlessClient(runtimeOpts{
+		mock:    flags.mock,
+		debug:   flags.debug,
+		timeout: flags.timeout,
+	})
+	defer cancel()
+	if err == ErrOffline {
+		return WriteError(stderr, ErrCodeOffline, err)
+	}
+	if err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+
+	var profile *api.PlayerProfile
+	if flags.mock {
+		profile = data.MockPlayerProfile(id)
+	} else {
+		fc := asFotmob(client)
+		if fc == nil {
+			return WriteError(stderr, ErrCodeNotFound, fmt.Errorf("player: %w", api.ErrUnsupported))
+		}
+		profile, err = fc.Player(ctx, id)
+		if errors.Is(err, fotmob.ErrPlayerNotFound) {
+			return WriteError(stderr, ErrCodeNotFound, err)
+		}
+		if err != nil {
+			return WriteError(stderr, ClassifyClientError(err, isTimeout(ctx)), err)
+		}
+	}
+	if profile == nil {
+		return WriteError(stderr, ErrCodeNotFound, fmt.Errorf("no player found for id %d", id))
+	}
+
+	if err := WriteJSON(stdout, []api.PlayerProfile{*profile}); err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+	return ExitOK
+}
+
+var playerCmd = &cobra.Command{
+	Use:   "player <id>",
+	Short: "Get a player's profile and season stats as JSON",
+	Long: `Fetches a player's profile from their FotMob player page: current club, position, shirt number, age, country and height, plus their season stats in their main league (matches, minutes, goals, assists, rating, ...).
+
+Player IDs appear in match lineups (` + "`golazo match <id>`" + `) and team squads (` + "`golazo team <id>`" + `). Other data providers return not_found.
+
+Example:
+  golazo player 737066 | jq '.data[0].stats[] | select(.key == "goals").value'
+
+Example output (truncated):
+  {"status":"ok","count":1,"data":[{"id":737066,"name":"Erling Haaland","team":{"id":8456,"name":"Manchester City"},"position":"Striker","number":9,"age":26,"country":"Norway","height_cm":195,"league":{"id":47,"name":"Premier League"},"season":"2025/2026","stats":[{"key":"goals","label":"Goals","value":20}]}]}`,
+	Args:          cobra.ArbitraryArgs, // validated in runPlayer for precise error envelope
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		code := runPlayer(os.Stdout, os.Stderr, playerFlagSet, args)
+		if code != ExitOK {
+			os.Exit(code)
+		}
+	},
+}
+
+func init() {
+	addCommonCLIFlags(playerCmd, &playerFlagSet)
+	rootCmd.AddCommand(playerCmd)
+}
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/fotmob/fotmobtest"
+)
+
+func TestRunPlayer_InvalidArgs(t *testing.T) {
+	for _, args := range [][]string{nil, {"haaland"}, {"0"}, {"1", "2"}} {
+		var stdout, stderr bytes.Buffer
+		if code := runPlayer(&stdout, &stderr, cliFlags{mock: true, timeout: time.Second}, args); code != ExitInvalidArgs {
+			t.Errorf("args=%q exit = %d, want %d; stderr=%s", args, code, ExitInvalidArgs, stderr.String())
+		}
+	}
+}
+
+func TestRunPlayer_Mock(t *testing.T) {
+	t.Setenv(EnvOffline, "")
+	t.Setenv(EnvAgent, "")
+
+	var stdout, stderr bytes.Buffer
+	code := runPlayer(&stdout, &stderr, cliFlags{mock: true, timeout: time.Second}, []string{"292462"})
+	if code != ExitOK {
+		t.Fatalf("exit = %d, stderr=%s", code, stderr.String())
+	}
+	var env struct {
+		Data []api.PlayerProfile `json:"data"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &env); err != nil {
+		t.Fatalf("unmarshal: %v\nraw: %s", err, stdout.String())
+	}
+	if len(env.Data) != 1 || env.Data[0].Name != "Mohamed Salah" || env.Data[0].Team == nil || len(env.Data[0].Stats) == 0 {
+		t.Fatalf("player = %+v", env.Data)
+	}
+
+	stderr.Reset()
+	if code := runPlayer(&stdout, &stderr, cliFlags{mock: true, timeout: time.Second}, []string{"99999"}); code != ExitNotFound {
+		t.Errorf("unknown id: exit = %d, want %d", code, ExitNotFound)
+	}
+}
+
+func TestRunPlayer_FetchesPlayerPage(t *testing.T) {
+	useFakeFotmob(t)
+	flags := cliFlags{timeout: 5 * time.Second}
+
+	var stdout, stderr bytes.Buffer
+	code := runPlayer(&stdout, &stderr, flags, []string{strconv.Itoa(fotmobtest.SeedPlayerID)})
+	if code != ExitOK {
+		t.Fatalf("exit = %d, stderr=%s", code, stderr.String())
+	}
+	var env struct {
+		Data []api.PlayerProfile `json:"data"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &env); err != nil {
+		t.Fatalf("unmarshal: %v\nraw: %s", err, stdout.String())
+	}
+	if len(env.Data) != 1 || env.Data[0].ID != fotmobtest.SeedPlayerID || env.Data[0].Season != "2025/2026" || len(env.Data[0].Stats) != 3 {
+		t.Errorf("player = %+v", env.Data)
+	}
+
+	stdout.Reset()
+	stderr.Reset()
+	if code := runPlayer(&stdout, &stderr, flags, []string{"1"}); code != ExitNotFound {
+		t.Errorf("unknown id: exit = %d, want %d; stderr=%s", code, ExitNotFound, stderr.String())
+	}
+}
@@ -9,7 +9,9 @@ import (
 	"strings"
 
 	"github.com/0xjuanma/golazo/internal/app"
+	"github.com/0xjuanma/golazo/internal/cassette"
 	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/provider"
 	"github.com/0xjuanma/golazo/internal/version"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/spf13/cobra"
@@ -23,6 +25,9 @@ var updateFlag bool
 var versionFlag bool
 var debugFlag bool
 var wcYearFlag string
+var recordDir string
+var replayDir string
+var providerFlag string
 
 var rootCmd = &cobra.Command{
 	Use:   "golazo",
@@ -32,6 +37,12 @@ var rootCmd = &cobra.Command{
 	// flag/subcommand errors in the agent-facing JSON envelope (see Execute).
 	SilenceErrors: true,
 	SilenceUsage:  true,
+	PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+		if err := configureCassette(recordDir, replayDir); err != nil {
+			return err
+		}
+		return configureProvider(providerFlag)
+	},
 	Run: func(cmd *cobra.Command, args []string) {
 		if versionFlag {
 			version.Print(Version)
@@ -63,14 +74,14 @@ var rootCmd = &cobra.Command{
 				shouldCheck = version.IsOlder(Version, storedLatestVersion)
 			}
 
-			if shouldCheck {
+			if shouldCheck && !cassette.Replaying() {
 				if fetchedVersion, err := data.CheckLatestVersion(); err == nil {
 					_ = data.SaveLatestVersion(fetchedVersion)
 				}
 			}
 		}()
 
-		p := tea.NewProgram(app.New(mockFlag, debugFlag, isDevBuild, newVersionAvailable, Version, wcYearFlag), tea.WithAltScreen())
+		p := tea.NewProgram(app.New(mockFlag, debugFlag, isDevBuild, newVersionAvailable, Version, wcYearFlag, selectedProvider), tea.WithAltScreen())
 		if _, err := p.Run(); err != nil {
 			fmt.Fprintf(os.Stderr, "Error running application: %v\n", err)
 			os.Exit(1)
@@ -219,6 +230,30 @@ func isListedInBrew() bool {
 	return cmd.Run() == nil
 }
 
+// configureCassette selects the process-wide HTTP record/replay mode from
+// --record/--replay, falling back to GOLAZO_RECORD/GOLAZO_REPLAY.
+func configureCassette(record, replay string) error {
+	switch {
+	case record != "" && replay != "":
+		return NewInvalidArg("--record and --replay are mutually exclusive")
+	case record != "":
+		return cassette.Configure(cassette.Record, record)
+	case replay != "":
+		if err := cassette.Configure(cassette.Replay, replay); err != nil {
+			return NewInvalidArg("--replay: %v", err)
+		}
+		return nil
+	}
+	mode, dir, err := cassette.FromEnv()
+	if err != nil {
+		return NewInvalidArg("%v", err)
+	}
+	if err := cassette.Configure(mode, dir); err != nil {
+		return NewInvalidArg("%v", err)
+	}
+	return nil
+}
+
 // Execute runs the root command.
 //
 // All subcommands call os.Exit() directly with the documented exit codes,
@@ -237,5 +272,8 @@ func init() {
 	rootCmd.Flags().BoolVar(&debugFlag, "debug", false, "Enable debug logging to "+data.DebugLogPath())
 	rootCmd.Flags().BoolVarP(&updateFlag, "update", "u", false, "Update golazo to the latest version")
 	rootCmd.Flags().BoolVarP(&versionFlag, "version", "v", false, "Display version information")
+	rootCmd.PersistentFlags().StringVar(&recordDir, "record", "", "Record every HTTP response into this cassette directory (also "+cassette.EnvRecord+")")
+	rootCmd.PersistentFlags().StringVar(&replayDir, "replay", "", "Serve HTTP responses from this cassette directory instead of the network (also "+cassette.EnvReplay+")")
+	rootCmd.PersistentFlags().StringVar(&providerFlag, "provider", "", "Data provider: "+strings.Join(provider.Names(), " or ")+" (default from settings.yaml, else "+provider.Default+")")
 	rootCmd.Flags().StringVar(&wcYearFlag, "wc-year", "", "World Cup year to display (e.g. 2026). With --mock uses bundled preview data; without --mock fetches from API.")
 }
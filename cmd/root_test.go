@@ -1,9 +1,18 @@
 package cmd
 
 import (
+	"bytes"
 	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/cassette"
+	"github.com/0xjuanma/golazo/internal/provider"
 )
 
 func TestDecideUpdate(t *testing.T) {
@@ -92,3 +101,76 @@ func TestDecideUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigureCassette(t *testing.T) {
+	t.Setenv(cassette.EnvRecord, "")
+	t.Setenv(cassette.EnvReplay, "")
+	t.Cleanup(func() { _ = cassette.Configure(cassette.Off, "") })
+	dir := t.TempDir()
+
+	if err := configureCassette(dir, dir); !errors.Is(err, errInvalidArg) {
+		t.Errorf("--record and --replay: err = %v, want invalid arg", err)
+	}
+	if err := configureCassette("", filepath.Join(dir, "missing")); !errors.Is(err, errInvalidArg) {
+		t.Errorf("missing replay dir: err = %v, want invalid arg", err)
+	}
+
+	if err := configureCassette("", dir); err != nil || !cassette.Replaying() {
+		t.Errorf("--replay: err = %v, replaying = %v", err, cassette.Replaying())
+	}
+
+	t.Setenv(cassette.EnvRecord, dir)
+	if err := configureCassette("", ""); err != nil {
+		t.Fatal(err)
+	}
+	if mode, got := cassette.Current(); mode != cassette.Record || got != dir {
+		t.Errorf("env fallback = %v %q, want record %q", mode, got, dir)
+	}
+}
+
+func TestConfigureProvider_FootballDataStandings(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v4/competitions/PL/standings" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(`{"standings":[{"type":"TOTAL","table":[{"position":1,"team":{"id":57,"name":"Arsenal FC","shortName":"Arsenal"},"playedGames":21,"points":49}]}]}`))
+	}))
+	defer srv.Close()
+
+	tmp := t.TempDir()
+	t.Setenv("HOME", tmp)
+	t.Setenv("XDG_CONFIG_HOME", tmp)
+	t.Setenv("XDG_CACHE_HOME", filepath.Join(tmp, "cache"))
+	t.Setenv(EnvOffline, "")
+	t.Setenv(EnvAgent, "")
+	if err := os.MkdirAll(filepath.Join(tmp, "golazo"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	settings := "provider: football-data\nfootball_data:\n  base_url: " + srv.URL + "/v4\n"
+	if err := os.WriteFile(filepath.Join(tmp, "golazo", "settings.yaml"), []byte(settings), 0644); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { selectedProvider = provider.Default })
+
+	if err := configureProvider("espn"); !errors.Is(err, errInvalidArg) {
+		t.Errorf("--provider espn: err = %v, want invalid arg", err)
+	}
+	if err := configureProvider(""); err != nil || selectedProvider != provider.FootballData {
+		t.Fatalf("settings provider: err = %v, selected = %q", err, selectedProvider)
+	}
+
+	var stdout, stderr bytes.Buffer
+	if code := runStandings(&stdout, &stderr, cliFlags{timeout: 5 * time.Second}, []string{"47"}); code != ExitOK {
+		t.Fatalf("exit = %d, stderr=%s", code, stderr.String())
+	}
+	if !strings.Contains(stdout.String(), `"Arsenal FC"`) {
+		t.Errorf("stdout = %s, want the stub's table", stdout.String())
+	}
+
+	stdout.Reset()
+	stderr.Reset()
+	if code := runMatch(&stdout, &stderr, matchFlags{cliFlags: cliFlags{timeout: 5 * time.Second}}, []string{"123"}); code != ExitNotFound {
+		t.Errorf("unknown match: exit = %d, want not_found (stderr=%s)", code, stderr.String())
+	}
+}
@@ -0,0 +1,321 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/app"
+	"github.com/0xjuanma/golazo/internal/cassette"
+	"github.com/spf13/cobra"
+)
+
+// sseKeepAliveInterval is how often an idle /v1/events stream sends a comment
+// line so proxies and browsers keep the connection open.
+const sseKeepAliveInterval = 30 * time.Second
+
+// httpStatusFor maps a CLI exit code onto the HTTP status of a serve response.
+func httpStatusFor(code int) int {
+	switch code {
+	case ExitOK:
+		return http.StatusOK
+	case ExitInvalidArgs:
+		return http.StatusBadRequest
+	case ExitNotFound:
+		return http.StatusNotFound
+	case ExitTimeout:
+		return http.StatusGatewayTimeout
+	case ExitOffline, ExitCircuitOpen:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusBadGateway
+	}
+}
+
+// writeEnvelope writes a captured envelope as the response body.
+func writeEnvelope(w http.ResponseWriter, code int, envelope []byte) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatusFor(code))
+	_, _ = w.Write(envelope)
+	_, _ = w.Write([]byte("\n"))
+}
+
+// writeErrorEnvelope writes an error envelope for failures detected by the
+// server itself (bad query, unknown route).
+func writeErrorEnvelope(w http.ResponseWriter, code ErrorCode, err error) {
+	var buf bytes.Buffer
+	exit := WriteError(&buf, code, err)
+	writeEnvelope(w, exit, bytes.TrimSpace(buf.Bytes()))
+}
+
+// eventHub fans one upstream watcher out to every /v1/events subscriber. The
+// watcher only runs while at least one subscriber is connected, so an idle
+// server makes no polling requests.
+type eventHub struct {
+	mu   sync.Mutex
+	subs map[chan watchEvent]struct{}
+	stop context.CancelFunc
+	// run drives a fresh watcher until ctx is cancelled.
+	run func(ctx context.Context, emit func(watchEvent) error)
+}
+
+func newEventHub(run func(ctx context.Context, emit func(watchEvent) error)) *eventHub {
+	return &eventHub{subs: make(map[chan watchEvent]struct{}), run: run}
+}
+
+// subscribe registers a subscriber, starting the watcher for the first one.
+// The returned func must be called to unsubscribe.
+func (h *eventHub) subscribe() (<-chan watchEvent, func()) {
+	ch := make(chan watchEvent, 64)
+
+	h.mu.Lock()
+	h.subs[ch] = struct{}{}
+	if len(h.subs) == 1 {
+		ctx, cancel := context.WithCancel(context.Background())
+		h.stop = cancel
+		go h.run(ctx, h.broadcast)
+	}
+	h.mu.Unlock()
+
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(h.subs, ch)
+		if len(h.subs) == 0 && h.stop != nil {
+			h.stop()
+			h.stop = nil
+		}
+	}
+}
+
+// broadcast delivers ev to every subscriber. Slow subscribers whose buffer is
+// full miss the event rather than stalling the others.
+func (h *eventHub) broadcast(ev watchEvent) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for ch := range h.subs {
+		select {
+		case ch <- ev:
+		default:
+		}
+	}
+	return nil
+}
+
+// newServeHandler builds the HTTP API. Every /v1/<subcommand> route drives the
+// same run* core as the CLI and returns its envelope; /v1/events streams
+// watch events as server-sent events.
+func newServeHandler(session cliFlags, hub *eventHub) http.Handler {
+	mux := http.NewServeMux()
+
+	for name, cmd := range toolCommands() {
+		pattern := "GET /v1/" + name
+		posName := ""
+		if cmd.Args != "" {
+			posName = toolArgName(cmd.Args)
+			pattern += "/{" + posName + "}"
+		}
+		mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+			positional := ""
+			if posName != "" {
+				positional = r.PathValue(posName)
+			}
+			args, err := queryToolArgs(cmd, r.URL.Query(), positional)
+			if err != nil {
+				writeErrorEnvelope(w, ErrCodeInvalidArgs, err)
+				return
+			}
+			code, envelope := runTool(session, name, args)
+			writeEnvelope(w, code, envelope)
+		})
+	}
+
+	mux.HandleFunc("GET /v1/capabilities", func(w http.ResponseWriter, r *http.Request) {
+		var stdout, stderr bytes.Buffer
+		if code := runCapabilities(&stdout, &stderr, cliFlags{}); code != ExitOK {
+			writeEnvelope(w, code, bytes.TrimSpace(stderr.Bytes()))
+			return
+		}
+		writeEnvelope(w, ExitOK, bytes.TrimSpace(stdout.Bytes()))
+	})
+
+	mux.HandleFunc("GET /v1/events", func(w http.ResponseWriter, r *http.Request) {
+		serveEvents(w, r, hub)
+	})
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeErrorEnvelope(w, ErrCodeNotFound, fmt.Errorf("no route for %s %s", r.Method, r.URL.Path))
+	})
+
+	return mux
+}
+
+// serveEvents streams hub events matching the request's match/league/team
+// query filters until the client disconnects or the server shuts down.
+func serveEvents(w http.ResponseWriter, r *http.Request, hub *eventHub) {
+	var watchCap capabilityCommand
+	for _, cmd := range buildCapabilities().Commands {
+		if cmd.Name == "watch" {
+			watchCap = cmd
+		}
+	}
+	args, err := queryToolArgs(watchCap, r.URL.Query(), "")
+	if err != nil {
+		writeErrorEnvelope(w, ErrCodeInvalidArgs, err)
+		return
+	}
+	filter := matchFilter{matchIDs: args.ints("match"), leagueIDs: args.ints("league"), teams: singleTeam(args.str("team"))}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		writeErrorEnvelope(w, ErrCodeUpstreamError, errors.New("streaming unsupported by this connection"))
+		return
+	}
+
+	events, unsubscribe := hub.subscribe()
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	_, _ = io.WriteString(w, ": subscribed\n\n")
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(sseKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-keepAlive.C:
+			if _, err := io.WriteString(w, ": keep-alive\n\n"); err != nil {
+				return
+			}
+			flusher.Flush()
+		case ev := <-events:
+			if !filter.keep(api.Match{ID: ev.MatchID, League: ev.League, HomeTeam: ev.HomeTeam, AwayTeam: ev.AwayTeam}) {
+				continue
+			}
+			data, err := json.Marshal(ev)
+			if err != nil {
+				continue
+			}
+			if _, err := fmt.Fprintf(w, "event: %s\ndata: %s\n\n", ev.Type, data); err != nil {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// serveFlags extends the session flags with the listen address.
+type serveFlags struct {
+	cliFlags
+	addr string
+}
+
+var serveFlagSet serveFlags
+
+// runServe is the testable core of the `serve` subcommand. It serves until
+// SIGINT/SIGTERM, then shuts down gracefully and exits 0.
+func runServe(stdout, stderr io.Writer, flags serveFlags) int {
+	if flags.timeout <= 0 {
+		return WriteError(stderr, ErrCodeInvalidArgs,
+			NewInvalidArg("--timeout must be positive, got %s", flags.timeout))
+	}
+	if offlineMode() && !flags.mock && !cassette.Replaying() {
+		return WriteError(stderr, ErrCodeOffline, ErrOffline)
+	}
+
+	restore := useSharedClient(flags.debug)
+	defer restore()
+	client := newDataClient(flags.debug)
+	logger := newStderrLogger(flags.debug)
+
+	hub := newEventHub(func(ctx context.Context, emit func(watchEvent) error) {
+		w := newClientWatcher(client, flags.mock, matchFilter{}, logger)
+		_ = watchLoop(ctx, w, app.LiveMatchPollInterval, flags.timeout, emit)
+	})
+
+	ln, err := net.Listen("tcp", flags.addr)
+	if err != nil {
+		return WriteError(stderr, ErrCodeInvalidArgs, NewInvalidArg("cannot listen on %q: %v", flags.addr, err))
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	srv := &http.Server{
+		Handler:           newServeHandler(flags.cliFlags, hub),
+		ReadHeaderTimeout: 10 * time.Second,
+		// Cancelling request contexts on shutdown ends open SSE streams.
+		BaseContext: func(net.Listener) context.Context { return ctx },
+		ErrorLog:    slog.NewLogLogger(logger.Handler(), slog.LevelDebug),
+	}
+	fmt.Fprintf(stderr, "golazo: serving on http://%s\n", ln.Addr())
+
+	serveErr := make(chan error, 1)
+	go func() { serveErr <- srv.Serve(ln) }()
+
+	select {
+	case <-ctx.Done():
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdownCtx)
+	case err := <-serveErr:
+		if !errors.Is(err, http.ErrServerClosed) {
+			return WriteError(stderr, ErrCodeUpstreamError, err)
+		}
+	}
+
+	_ = savePageURLs(client)
+	return ExitOK
+}
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Serve the CLI as a local HTTP/JSON API",
+	Long: `Starts an HTTP server exposing live, finished, fixtures, match, standings, leagues and capabilities with the same envelope (team, player, topscorers, worldcup and ical are CLI-only), backed by one long-lived client so caches, the rate limiter and the concurrency cap are shared across requests. Runs until interrupted (Ctrl-C); exits 0.
+
+Routes (GET):
+  /v1/live  /v1/finished  /v1/fixtures  /v1/leagues  /v1/capabilities
+  /v1/match/{id}  /v1/standings/{league_id}
+  /v1/events   server-sent events stream of watch events
+
+Flags map to snake_case query parameters (e.g. /v1/finished?days=3&include_upcoming=true). Errors map to HTTP status: invalid_args 400, not_found 404, upstream_error 502, offline and circuit_open 503, timeout 504.
+
+Example:
+  golazo serve --addr :8080 &
+  curl -s localhost:8080/v1/live | jq '.count'
+  curl -N 'localhost:8080/v1/events?league=47'`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		code := runServe(os.Stdout, os.Stderr, serveFlagSet)
+		if code != ExitOK {
+			os.Exit(code)
+		}
+	},
+}
+
+func init() {
+	serveCmd.Flags().StringVar(&serveFlagSet.addr, "addr", "127.0.0.1:8080", "Listen address (host:port)")
+	serveCmd.Flags().BoolVar(&serveFlagSet.mock, "mock", false, "Use mock data instead of real API")
+	serveCmd.Flags().BoolVar(&serveFlagSet.debug, "debug", false, "Emit debug logs to stderr")
+	serveCmd.Flags().DurationVar(&serveFlagSet.timeout, "timeout", 15*time.Second, "Per-request upstream timeout")
+	rootCmd.AddCommand(serveCmd)
+}
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	t.Setenv(EnvOffline, "")
+	t.Setenv(EnvAgent, "")
+
+	session := cliFlags{mock: true, timeout: time.Second}
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	hub := newEventHub(func(ctx context.Context, emit func(watchEvent) error) {
+		w := newClientWatcher(nil, true, matchFilter{}, logger)
+		_ = watchLoop(ctx, w, time.Hour, time.Second, emit)
+	})
+	srv := httptest.NewServer(newServeHandler(session, hub))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestServe_Routes(t *testing.T) {
+	srv := newTestServer(t)
+
+	tests := []struct {
+		path       string
+		wantStatus int
+		wantCode   string // error code, "" for ok envelopes
+	}{
+		{"/v1/live", http.StatusOK, ""},
+		{"/v1/live?league=la%20liga,47&team=madrid&status=live", http.StatusOK, ""},
+		{"/v1/live?status=halftime", http.StatusBadRequest, string(ErrCodeInvalidArgs)},
+		{"/v1/finished?days=2&include_upcoming=true", http.StatusOK, ""},
+		{"/v1/fixtures?days_ahead=3", http.StatusOK, ""},
+		{"/v1/leagues?all", http.StatusOK, ""},
+		{"/v1/capabilities", http.StatusOK, ""},
+		{"/v1/match/2001", http.StatusOK, ""},
+		{"/v1/standings/47", http.StatusOK, ""},
+		{"/v1/finished?days=9", http.StatusBadRequest, string(ErrCodeInvalidArgs)},
+		{"/v1/finished?days=abc", http.StatusBadRequest, string(ErrCodeInvalidArgs)},
+		{"/v1/leagues?bogus=1", http.StatusBadRequest, string(ErrCodeInvalidArgs)},
+		{"/v1/match/abc", http.StatusBadRequest, string(ErrCodeInvalidArgs)},
+		{"/v1/match/999999", http.StatusNotFound, string(ErrCodeNotFound)},
+		{"/v1/nope", http.StatusNotFound, string(ErrCodeNotFound)},
+	}
+	for _, tt := range tests {
+		resp, err := http.Get(srv.URL + tt.path)
+		if err != nil {
+			t.Fatalf("GET %s: %v", tt.path, err)
+		}
+		var env struct {
+			Status string `json:"status"`
+			Code   string `json:"code"`
+		}
+		err = json.NewDecoder(resp.Body).Decode(&env)
+		resp.Body.Close()
+		if err != nil {
+			t.Errorf("GET %s: body is not a JSON envelope: %v", tt.path, err)
+			continue
+		}
+		if resp.StatusCode != tt.wantStatus {
+			t.Errorf("GET %s: status = %d, want %d", tt.path, resp.StatusCode, tt.wantStatus)
+		}
+		if tt.wantCode == "" && env.Status != "ok" {
+			t.Errorf("GET %s: envelope status = %q, want ok", tt.path, env.Status)
+		}
+		if env.Code != tt.wantCode {
+			t.Errorf("GET %s: code = %q, want %q", tt.path, env.Code, tt.wantCode)
+		}
+	}
+}
+
+func TestServe_EventsStreamsFilteredSSE(t *testing.T) {
+	srv := newTestServer(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/v1/events?match=2001", nil)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("GET /v1/events: %v", err)
+	}
+	defer resp.Body.Close()
+	if ct := resp.Header.Get("Content-Type"); ct != "text/event-stream" {
+		t.Fatalf("Content-Type = %q", ct)
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data: ") {
+			continue
+		}
+		var ev watchEvent
+		if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data: ")), &ev); err != nil {
+			t.Fatalf("data line is not a watch event: %v\n%s", err, line)
+		}
+		if ev.MatchID != 2001 {
+			t.Errorf("filtered stream leaked match %d", ev.MatchID)
+		}
+		return
+	}
+	t.Fatalf("stream ended without a data line: %v", scanner.Err())
+}
+
+func TestServe_EventsRejectsBadFilter(t *testing.T) {
+	srv := newTestServer(t)
+	resp, err := http.Get(srv.URL + "/v1/events?match=abc")
+	if err != nil {
+		t.Fatalf("GET: %v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("status = %d, want 400", resp.StatusCode)
+	}
+}
+
+func TestEventHub_StopsWatcherWithLastSubscriber(t *testing.T) {
+	stopped := make(chan struct{})
+	hub := newEventHub(func(ctx context.Context, emit func(watchEvent) error) {
+		<-ctx.Done()
+		close(stopped)
+	})
+
+	_, unsubA := hub.subscribe()
+	_, unsubB := hub.subscribe()
+	unsubA()
+	select {
+	case <-stopped:
+		t.Fatal("watcher stopped while a subscriber remained")
+	case <-time.After(50 * time.Millisecond):
+	}
+	unsubB()
+	select {
+	case <-stopped:
+	case <-time.After(time.Second):
+		t.Fatal("watcher still running after last unsubscribe")
+	}
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/spf13/cobra"
+)
+
+// standingsFetcher abstracts LeagueTableWithParent for testing.
+type standingsFetcher func(ctx context.Context, leagueID int, leagueName string, parentLeagueID int) ([]api.LeagueTableEntry, error)
+
+func defaultStandingsFetcher(c api.Client) standingsFetcher {
+	return c.LeagueTableWithParent
+}
+
+var standingsFlagSet cliFlags
+
+// runStandings is the testable core of the `standings` subcommand.
+// args is the positional arg slice from cobra (we expect exactly one league ID).
+func runStandings(stdout, stderr io.Writer, flags cliFlags, args []string) int {
+	applyPretty(flags)
+
+	format, err := outputFormat(flags)
+	if err != nil {
+		return WriteError(stderr, ErrCodeInvalidArgs, err)
+	}
+
+	if len(args) != 1 {
+		return WriteError(stderr, ErrCodeInvalidArgs,
+			NewInvalidArg("expected exactly one league id, got %d args", len(args)))
+	}
+	leagueID, err := strconv.Atoi(args[0])
+	if err != nil || leagueID <= 0 {
+		return WriteError(stderr, ErrCodeInvalidArgs,
+			NewInvalidArg("league id must be a positive integer, got %q", args[0]))
+	}
+
+	client, ctx, cancel, err := newHeadlessClient(runtimeOpts{
+		mock:    flags.mock,
+		debug:   flags.debug,
+		timeout: flags.timeout,
+	})
+	defer cancel()
+	if err == ErrOffline {
+		return WriteError(stderr, ErrCodeOffline, err)
+	}
+	if err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+
+	var table []api.LeagueTableEntry
+	if flags.mock {
+		table = data.MockStandings(leagueID)
+	} else {
+		// The catalog name lets the client map knockout competitions and
+		// sub-season leagues onto the parent league that owns the table.
+		leagueName := leagueCatalog()[leagueID].Name
+		table, err = defaultStandingsFetcher(client)(ctx, leagueID, leagueName, 0)
+		if errors.Is(err, fotmob.ErrNoStandings) {
+			return WriteError(stderr, ErrCodeNotFound, err)
+		}
+		if err != nil {
+			return WriteError(stderr, ClassifyClientError(err, isTimeout(ctx)), err)
+		}
+	}
+	if len(table) == 0 {
+		return WriteError(stderr, ErrCodeNotFound, fmt.Errorf("no standings found for league %d", leagueID))
+	}
+
+	if err := writeList(stdout, stderr, format, table, nil); err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+	return ExitOK
+}
+
+var standingsCmd = &cobra.Command{
+	Use:   "standings <league-id>",
+	Short: "Get a league table as JSON",
+	Long: `Fetches the current standings for a single league by ID (see 'golazo leagues --all' for IDs). Knockout competitions and sub-season leagues resolve to their parent league's table. Leagues without a table (cups, friendlies) return not_found.
+
+Example output:
+  {"status":"ok","count":20,"data":[{"position":1,"team":{"id":8650,"name":"Liverpool","short_name":"Liverpool"},"played":17,"won":13,"drawn":3,"lost":1,"goals_for":40,"goals_against":15,"goal_difference":25,"points":42}]}`,
+	Args:          cobra.ArbitraryArgs, // validated in runStandings for precise error envelope
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		code := runStandings(os.Stdout, os.Stderr, standingsFlagSet, args)
+		if code != ExitOK {
+			os.Exit(code)
+		}
+	},
+}
+
+func init() {
+	addCommonCLIFlags(standingsCmd, &standingsFlagSet)
+	addFormatFlag(standingsCmd, &standingsFlagSet)
+	rootCmd.AddCommand(standingsCmd)
+}
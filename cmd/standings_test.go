@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob/fotmobtest"
+)
+
+func TestRunStandings_InvalidLeagueID(t *testing.T) {
+	cases := [][]string{nil, {"abc"}, {"0"}, {"-5"}, {"47", "87"}}
+	for _, args := range cases {
+		t.Run("", func(t *testing.T) {
+			var stdout, stderr bytes.Buffer
+			code := runStandings(&stdout, &stderr, cliFlags{mock: true, timeout: time.Second}, args)
+			if code != ExitInvalidArgs {
+				t.Errorf("args=%v exit = %d, want %d", args, code, ExitInvalidArgs)
+			}
+			if stdout.Len() != 0 {
+				t.Errorf("stdout should be empty on invalid args, got: %s", stdout.String())
+			}
+		})
+	}
+}
+
+func TestRunStandings_MockKnownLeague(t *testing.T) {
+	t.Setenv(EnvOffline, "")
+	t.Setenv(EnvAgent, "")
+
+	var stdout, stderr bytes.Buffer
+	code := runStandings(&stdout, &stderr, cliFlags{mock: true, timeout: time.Second}, []string{"47"})
+	if code != ExitOK {
+		t.Fatalf("exit = %d, want %d. stderr=%s", code, ExitOK, stderr.String())
+	}
+	var env struct {
+		Status string                 `json:"status"`
+		Count  int                    `json:"count"`
+		Data   []api.LeagueTableEntry `json:"data"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &env); err != nil {
+		t.Fatalf("unmarshal: %v\nraw: %s", err, stdout.String())
+	}
+	want := len(data.MockStandings(47))
+	if env.Count != want || len(env.Data) != want {
+		t.Fatalf("count=%d len=%d, want %d", env.Count, len(env.Data), want)
+	}
+	if env.Data[0].Position != 1 {
+		t.Errorf("data[0].position = %d, want 1", env.Data[0].Position)
+	}
+}
+
+func TestRunStandings_FetchesLeaguePage(t *testing.T) {
+	srv := useFakeFotmob(t)
+
+	var stdout, stderr bytes.Buffer
+	code := runStandings(&stdout, &stderr, cliFlags{timeout: 5 * time.Second}, []string{strconv.Itoa(fotmobtest.SeedLeagueID)})
+	if code != ExitOK {
+		t.Fatalf("exit = %d, stderr=%s", code, stderr.String())
+	}
+	var env struct {
+		Data []api.LeagueTableEntry `json:"data"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &env); err != nil {
+		t.Fatalf("unmarshal: %v\nraw: %s", err, stdout.String())
+	}
+	if len(env.Data) != 5 || env.Data[0].Team.Name != "Liverpool" {
+		t.Errorf("standings = %+v", env.Data)
+	}
+
+	srv.Inject(fotmobtest.LeaguePathPrefix, fotmobtest.Fault{Status: http.StatusInternalServerError})
+	stdout.Reset()
+	if code := runStandings(&stdout, &stderr, cliFlags{timeout: 5 * time.Second}, []string{"87"}); code == ExitOK {
+		t.Errorf("league page 500: exit = %d, want an error", code)
+	}
+}
+
+func TestRunStandings_MockUnknownLeagueReturnsNotFound(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := runStandings(&stdout, &stderr, cliFlags{mock: true, timeout: time.Second}, []string{"132"})
+	if code != ExitNotFound {
+		t.Errorf("exit = %d, want %d", code, ExitNotFound)
+	}
+	var env errEnvelope
+	if err := json.Unmarshal(stderr.Bytes(), &env); err != nil {
+		t.Fatalf("unmarshal stderr: %v", err)
+	}
+	if env.Code != ErrCodeNotFound {
+		t.Errorf("code = %q, want %q", env.Code, ErrCodeNotFound)
+	}
+}
+
+func TestRunStandings_OfflineWithoutMock(t *testing.T) {
+	t.Setenv(EnvOffline, "1")
+
+	var stdout, stderr bytes.Buffer
+	code := runStandings(&stdout, &stderr, cliFlags{timeout: time.Second}, []string{"47"})
+	if code != ExitOffline {
+		t.Errorf("exit = %d, want %d", code, ExitOffline)
+	}
+}
@@ -0,0 +1,206 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/spf13/cobra"
+)
+
+// teamFlags extends the common flag set with the leagues searched when the
+// team is given by name.
+type teamFlags struct {
+	cliFlags
+	leagues []string // league IDs or names; default is the active leagues
+}
+
+var teamFlagSet teamFlags
+
+// resolveTeam picks the one team in teams that query names. An exact
+// (case- and diacritic-insensitive) full or short name wins over name
+// fragments; several candidates are an invalid argument listing them, none
+// is not_found.
+func resolveTeam(query string, teams []api.Team) (api.Team, ErrorCode, error) {
+	q := foldName(query)
+	var exact, partial []api.Team
+	for _, t := range teams {
+		switch {
+		case foldName(t.Name) == q || foldName(t.ShortName) == q:
+			exact = append(exact, t)
+		case teamMatches(t, query):
+			partial = append(partial, t)
+		}
+	}
+	candidates := exact
+	if len(candidates) == 0 {
+		candidates = partial
+	}
+	switch len(candidates) {
+	case 0:
+		return api.Team{}, ErrCodeNotFound, fmt.Errorf("no team matching %q in the searched leagues; pass --league or the team id", query)
+	case 1:
+		return candidates[0], "", nil
+	}
+	names := make([]string, len(candidates))
+	for i, t := range candidates {
+		names[i] = fmt.Sprintf("%s (%d)", t.Name, t.ID)
+	}
+	return api.Team{}, ErrCodeInvalidArgs, NewInvalidArg("team %q is ambiguous: %s; pass the team id", query, strings.Join(names, ", "))
+}
+
+// leagueTeams returns the distinct teams playing in the given leagues'
+// matches. Leagues that fail to load are skipped unless all of them do.
+func leagueTeams(ctx context.Context, client api.Client, leagueIDs []int) ([]api.Team, error) {
+	seen := make(map[int]bool)
+	var teams []api.Team
+	var lastErr error
+	for _, leagueID := range leagueIDs {
+		matches, err := client.LeagueMatches(ctx, leagueID)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		for _, m := range matches {
+			for _, t := range []api.Team{m.HomeTeam, m.AwayTeam} {
+				if t.ID > 0 && !seen[t.ID] {
+					seen[t.ID] = true
+					teams = append(teams, t)
+				}
+			}
+		}
+	}
+	if len(teams) == 0 && lastErr != nil {
+		return nil, lastErr
+	}
+	sort.Slice(teams, func(i, j int) bool { return teams[i].ID < teams[j].ID })
+	return teams, nil
+}
+
+// runTeam is the testable core of the `team` subcommand.
+// args is the positional arg slice from cobra (we expect one team ID or name).
+func runTeam(stdout, stderr io.Writer, flags teamFlags, args []string) int {
+	applyPretty(flags.cliFlags)
+
+	if len(args) == 0 {
+		return WriteError(stderr, ErrCodeInvalidArgs, NewInvalidArg("expected a team id or name"))
+	}
+	// Unquoted multi-word names arrive as several args.
+	query := strings.TrimSpace(strings.Join(args, " "))
+	if query == "" {
+		return WriteError(stderr, ErrCodeInvalidArgs, NewInvalidArg("team must not be empty"))
+	}
+	teamID, err := strconv.Atoi(query)
+	if err == nil && teamID <= 0 {
+		return WriteError(stderr, ErrCodeInvalidArgs,
+			NewInvalidArg("team id must be a positive integer, got %q", query))
+	}
+	var leagueIDs []int
+	for _, q := range flags.leagues {
+		id, err := resolveLeagueID(q)
+		if err != nil {
+			return WriteError(stderr, ErrCodeInvalidArgs, err)
+		}
+		if !containsInt(leagueIDs, id) {
+			leagueIDs = append(leagueIDs, id)
+		}
+	}
+
+	client, ctx, cancel, err := newHeadlessClient(runtimeOpts{
+		mock:    flags.mock,
+		debug:   flags.debug,
+		timeout: flags.timeout,
+	})
+	defer cancel()
+	if err == ErrOffline {
+		return WriteError(stderr, ErrCodeOffline, err)
+	}
+	if err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+
+	fc := asFotmob(client)
+	if !flags.mock && fc == nil {
+		return WriteError(stderr, ErrCodeNotFound, fmt.Errorf("team: %w", api.ErrUnsupported))
+	}
+
+	if teamID == 0 {
+		var teams []api.Team
+		if flags.mock {
+			teams = data.MockTeams()
+		} else {
+			if len(leagueIDs) == 0 {
+				leagueIDs = fotmob.ActiveLeagues()
+			}
+			teams, err = leagueTeams(ctx, client, leagueIDs)
+			if err != nil {
+				return WriteError(stderr, ClassifyClientError(err, isTimeout(ctx)), err)
+			}
+		}
+		t, code, err := resolveTeam(query, teams)
+		if err != nil {
+			return WriteError(stderr, code, err)
+		}
+		teamID = t.ID
+	}
+
+	var overview *api.TeamOverview
+	if flags.mock {
+		overview = data.MockTeamOverview(teamID)
+	} else {
+		overview, err = fc.Team(ctx, teamID)
+		// Best-effort: persist fixture slugs so `golazo match` skips the lookup.
+		_ = savePageURLs(client)
+		if errors.Is(err, fotmob.ErrTeamNotFound) {
+			return WriteError(stderr, ErrCodeNotFound, err)
+		}
+		if err != nil {
+			return WriteError(stderr, ClassifyClientError(err, isTimeout(ctx)), err)
+		}
+	}
+	if overview == nil {
+		return WriteError(stderr, ErrCodeNotFound, fmt.Errorf("no team found for id %d", teamID))
+	}
+
+	if err := WriteJSON(stdout, []api.TeamOverview{*overview}); err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+	return ExitOK
+}
+
+var teamCmd = &cobra.Command{
+	Use:   "team <id|name>",
+	Short: "Get a team's squad, results, fixtures, standing and form as JSON",
+	Long: `Fetches a team overview from its FotMob team page: the squad, the last 5 results (most recent first), the next 5 fixtures (soonest first), its row in its primary league table and a W/D/L form string (oldest first).
+
+A name is matched case- and diacritic-insensitively against the teams playing in the active leagues (or --league, repeatable, by ID or name). An exact full or short name wins over fragments; an ambiguous name returns invalid_args listing the candidates with their IDs. Other data providers return not_found.
+
+Example:
+  golazo team arsenal | jq -r '.data[0].form'
+
+Example output (truncated):
+  {"status":"ok","count":1,"data":[{"team":{"id":9825,"name":"Arsenal","short_name":"Arsenal"},"league":{"id":47,"name":"Premier League"},"standing":{"position":3,"points":38},"form":"WWDLW","results":[...],"fixtures":[...],"squad":[{"id":1083323,"name":"David Raya","number":22,"position":"goalkeeper","age":30,"country":"Spain"}]}]}`,
+	Args:          cobra.ArbitraryArgs, // validated in runTeam for precise error envelope
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		code := runTeam(os.Stdout, os.Stderr, teamFlagSet, args)
+		if code != ExitOK {
+			os.Exit(code)
+		}
+	},
+}
+
+func init() {
+	addCommonCLIFlags(teamCmd, &teamFlagSet.cliFlags)
+	teamCmd.Flags().StringSliceVar(&teamFlagSet.leagues, "league", nil, "Leagues searched for a team name, by ID or name (repeatable; default: active leagues)")
+	rootCmd.AddCommand(teamCmd)
+}
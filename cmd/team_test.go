@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/fotmob/fotmobtest"
+)
+
+func TestResolveTeam(t *testing.T) {
+	teams := []api.Team{
+		{ID: 8456, Name: "Manchester City", ShortName: "Man City"},
+		{ID: 10260, Name: "Manchester United", ShortName: "Man United"},
+		{ID: 9825, Name: "Arsenal", ShortName: "Arsenal"},
+		{ID: 9906, Name: "Atlético Madrid", ShortName: "Atlético"},
+	}
+	for _, tc := range []struct {
+		query    string
+		wantID   int
+		wantCode ErrorCode
+	}{
+		{"arsenal", 9825, ""},
+		{"man city", 8456, ""},
+		{"atletico", 9906, ""},
+		{"manchester", 0, ErrCodeInvalidArgs},
+		{"chelsea", 0, ErrCodeNotFound},
+	} {
+		got, code, err := resolveTeam(tc.query, teams)
+		if got.ID != tc.wantID || code != tc.wantCode || (err != nil) != (tc.wantCode != "") {
+			t.Errorf("resolveTeam(%q) = %d, %q, %v; want %d, %q", tc.query, got.ID, code, err, tc.wantID, tc.wantCode)
+		}
+	}
+
+	// An exact name beats a longer one containing it.
+	teams = append(teams, api.Team{ID: 1, Name: "Arsenal Tula", ShortName: "Arsenal Tula"})
+	if got, _, err := resolveTeam("Arsenal", teams); err != nil || got.ID != 9825 {
+		t.Errorf("exact name: got %d, %v; want 9825", got.ID, err)
+	}
+}
+
+func TestRunTeam_InvalidArgs(t *testing.T) {
+	for _, tc := range []struct {
+		args  []string
+		flags teamFlags
+	}{
+		{nil, teamFlags{}},
+		{[]string{" "}, teamFlags{}},
+		{[]string{"-3"}, teamFlags{}},
+		{[]string{"arsenal"}, teamFlags{leagues: []string{"0"}}},
+	} {
+		tc.flags.cliFlags = cliFlags{mock: true, timeout: time.Second}
+		var stdout, stderr bytes.Buffer
+		if code := runTeam(&stdout, &stderr, tc.flags, tc.args); code != ExitInvalidArgs {
+			t.Errorf("args=%q exit = %d, want %d; stderr=%s", tc.args, code, ExitInvalidArgs, stderr.String())
+		}
+	}
+}
+
+func TestRunTeam_Mock(t *testing.T) {
+	t.Setenv(EnvOffline, "")
+	t.Setenv(EnvAgent, "")
+
+	var stdout, stderr bytes.Buffer
+	code := runTeam(&stdout, &stderr, teamFlags{cliFlags: cliFlags{mock: true, timeout: time.Second}}, []string{"liverpool"})
+	if code != ExitOK {
+		t.Fatalf("exit = %d, stderr=%s", code, stderr.String())
+	}
+	var env struct {
+		Data []api.TeamOverview `json:"data"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &env); err != nil {
+		t.Fatalf("unmarshal: %v\nraw: %s", err, stdout.String())
+	}
+	if len(env.Data) != 1 || env.Data[0].Team.ID != 40 || env.Data[0].Standing == nil || env.Data[0].Standing.Position != 1 {
+		t.Fatalf("team = %+v", env.Data)
+	}
+	if got := env.Data[0]; len(got.Form) != len(got.Results) || got.Squad == nil {
+		t.Errorf("form %q over %d results, squad %v", got.Form, len(got.Results), got.Squad)
+	}
+
+	stderr.Reset()
+	if code := runTeam(&stdout, &stderr, teamFlags{cliFlags: cliFlags{mock: true, timeout: time.Second}}, []string{"99999"}); code != ExitNotFound {
+		t.Errorf("unknown id: exit = %d, want %d", code, ExitNotFound)
+	}
+}
+
+func TestRunTeam_FetchesTeamPage(t *testing.T) {
+	useFakeFotmob(t)
+	flags := teamFlags{cliFlags: cliFlags{timeout: 5 * time.Second}, leagues: []string{"47"}}
+
+	var stdout, stderr bytes.Buffer
+	code := runTeam(&stdout, &stderr, flags, []string{"Manchester", "City"})
+	if code != ExitOK {
+		t.Fatalf("exit = %d, stderr=%s", code, stderr.String())
+	}
+	var env struct {
+		Data []api.TeamOverview `json:"data"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &env); err != nil {
+		t.Fatalf("unmarshal: %v\nraw: %s", err, stdout.String())
+	}
+	if len(env.Data) != 1 || env.Data[0].Team.ID != fotmobtest.SeedTeamID || env.Data[0].Form != "W" || len(env.Data[0].Squad) != 5 {
+		t.Errorf("team = %+v", env.Data)
+	}
+
+	stdout.Reset()
+	stderr.Reset()
+	if code := runTeam(&stdout, &stderr, flags, []string{"1"}); code != ExitNotFound {
+		t.Errorf("unknown id: exit = %d, want %d; stderr=%s", code, ExitNotFound, stderr.String())
+	}
+	stderr.Reset()
+	if code := runTeam(&stdout, &stderr, flags, []string{"barcelona"}); code != ExitNotFound || !strings.Contains(stderr.String(), "barcelona") {
+		t.Errorf("unknown name: exit = %d, want %d; stderr=%s", code, ExitNotFound, stderr.String())
+	}
+}
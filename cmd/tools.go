@@ -0,0 +1,289 @@
+package cmd
+
+import (
+	"bytes"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Long-running front ends (`mcp`, `serve`) expose the one-shot subcommands as
+// "tools": arguments are derived from the capabilities flag metadata and each
+// call drives the same run* core as the CLI, capturing its envelope.
+
+// toolNames lists the subcommands exposed as tools, in listing order. watch
+// (long-running) and capabilities (static) are served separately.
+var toolNames = []string{"live", "finished", "fixtures", "match", "standings", "leagues"}
+
+// toolSessionFlags are fixed for the whole session by the front end's own
+// flags, so they are not tool arguments. --format is pinned to JSON so every
+// result carries the envelope.
+var toolSessionFlags = map[string]bool{"mock": true, "debug": true, "timeout": true, "pretty": true, "format": true}
+
+// toolArgName maps a CLI flag or positional placeholder to a tool argument
+// name: "--days-ahead" -> "days_ahead", "<league-id>" -> "league_id".
+func toolArgName(name string) string {
+	name = strings.Trim(name, "<>")
+	return strings.ReplaceAll(name, "-", "_")
+}
+
+// toolCommands returns the capabilities entries of the exposed tools, keyed
+// by name.
+func toolCommands() map[string]capabilityCommand {
+	byName := make(map[string]capabilityCommand)
+	for _, cmd := range buildCapabilities().Commands {
+		byName[cmd.Name] = cmd
+	}
+	out := make(map[string]capabilityCommand, len(toolNames))
+	for _, name := range toolNames {
+		if cmd, ok := byName[name]; ok {
+			out[name] = cmd
+		}
+	}
+	return out
+}
+
+// toolArgs is a validated tool argument set with capability defaults. The
+// positional argument, if any, is carried separately as the run* cores
+// expect it.
+type toolArgs struct {
+	values     map[string]any
+	flags      map[string]capabilityFlag
+	positional []string
+}
+
+func newEmptyToolArgs(cmd capabilityCommand) toolArgs {
+	a := toolArgs{values: map[string]any{}, flags: map[string]capabilityFlag{}}
+	for _, f := range cmd.Flags {
+		if !toolSessionFlags[f.Name] {
+			a.flags[toolArgName(f.Name)] = f
+		}
+	}
+	return a
+}
+
+// jsonToolArgs validates decoded JSON arguments (MCP tools/call). The
+// positional argument must be an integer; unknown names and wrong types are
+// rejected as invalid_args.
+func jsonToolArgs(cmd capabilityCommand, raw map[string]any) (toolArgs, error) {
+	a := newEmptyToolArgs(cmd)
+	posName := ""
+	if cmd.Args != "" {
+		posName = toolArgName(cmd.Args)
+	}
+
+	for name, v := range raw {
+		if name == posName {
+			n, ok := jsonInt(v)
+			if !ok {
+				return a, NewInvalidArg("%s must be an integer", name)
+			}
+			a.positional = []string{strconv.Itoa(n)}
+			continue
+		}
+		f, ok := a.flags[name]
+		if !ok {
+			return a, NewInvalidArg("unknown argument %q", name)
+		}
+		switch f.Type {
+		case "bool":
+			if _, ok := v.(bool); !ok {
+				return a, NewInvalidArg("%s must be a boolean", name)
+			}
+		case "int":
+			n, ok := jsonInt(v)
+			if !ok {
+				return a, NewInvalidArg("%s must be an integer", name)
+			}
+			v = n
+		case "[]int":
+			list, ok := v.([]any)
+			if !ok {
+				return a, NewInvalidArg("%s must be an array of integers", name)
+			}
+			ints := make([]int, 0, len(list))
+			for _, item := range list {
+				n, ok := jsonInt(item)
+				if !ok {
+					return a, NewInvalidArg("%s must be an array of integers", name)
+				}
+				ints = append(ints, n)
+			}
+			v = ints
+		case "[]string":
+			list, ok := v.([]any)
+			if !ok {
+				return a, NewInvalidArg("%s must be an array of strings", name)
+			}
+			strs := make([]string, 0, len(list))
+			for _, item := range list {
+				s, ok := item.(string)
+				if !ok {
+					return a, NewInvalidArg("%s must be an array of strings", name)
+				}
+				strs = append(strs, s)
+			}
+			v = strs
+		default:
+			if _, ok := v.(string); !ok {
+				return a, NewInvalidArg("%s must be a string", name)
+			}
+		}
+		a.values[name] = v
+	}
+	return a, nil
+}
+
+// queryToolArgs validates URL query parameters (HTTP serve). []int and
+// []string arguments accept repeated parameters and comma-separated lists. The positional
+// argument comes from the path and is validated by the run* core.
+func queryToolArgs(cmd capabilityCommand, q url.Values, positional string) (toolArgs, error) {
+	a := newEmptyToolArgs(cmd)
+	if positional != "" {
+		a.positional = []string{positional}
+	}
+
+	for name, raw := range q {
+		f, ok := a.flags[name]
+		if !ok {
+			return a, NewInvalidArg("unknown query parameter %q", name)
+		}
+		last := raw[len(raw)-1]
+		switch f.Type {
+		case "bool":
+			if last == "" {
+				a.values[name] = true // ?all is shorthand for ?all=true
+				continue
+			}
+			b, err := strconv.ParseBool(last)
+			if err != nil {
+				return a, NewInvalidArg("%s must be a boolean, got %q", name, last)
+			}
+			a.values[name] = b
+		case "int":
+			n, err := strconv.Atoi(last)
+			if err != nil {
+				return a, NewInvalidArg("%s must be an integer, got %q", name, last)
+			}
+			a.values[name] = n
+		case "[]int":
+			var ints []int
+			for _, v := range raw {
+				for _, part := range strings.Split(v, ",") {
+					n, err := strconv.Atoi(strings.TrimSpace(part))
+					if err != nil {
+						return a, NewInvalidArg("%s must be a list of integers, got %q", name, v)
+					}
+					ints = append(ints, n)
+				}
+			}
+			a.values[name] = ints
+		case "[]string":
+			var strs []string
+			for _, v := range raw {
+				strs = append(strs, strings.Split(v, ",")...)
+			}
+			a.values[name] = strs
+		default:
+			a.values[name] = last
+		}
+	}
+	return a, nil
+}
+
+// jsonInt converts a decoded JSON number to int if it is integral.
+func jsonInt(v any) (int, bool) {
+	f, ok := v.(float64)
+	if !ok || f != math.Trunc(f) || math.Abs(f) > math.MaxInt32 {
+		return 0, false
+	}
+	return int(f), true
+}
+
+func (a toolArgs) lookup(name string) any {
+	if v, ok := a.values[name]; ok {
+		return v
+	}
+	return a.flags[name].Default
+}
+
+func (a toolArgs) boolean(name string) bool {
+	v, _ := a.lookup(name).(bool)
+	return v
+}
+
+func (a toolArgs) integer(name string) int {
+	v, _ := a.lookup(name).(int)
+	return v
+}
+
+func (a toolArgs) str(name string) string {
+	v, _ := a.lookup(name).(string)
+	return v
+}
+
+func (a toolArgs) ints(name string) []int {
+	v, _ := a.lookup(name).([]int)
+	return v
+}
+
+func (a toolArgs) strs(name string) []string {
+	v, _ := a.lookup(name).([]string)
+	return v
+}
+
+// filters collects the --league/--team/--status arguments of live and
+// finished.
+func (a toolArgs) filters() listFilterFlags {
+	return listFilterFlags{leagues: a.strs("league"), teams: a.strs("team"), statuses: a.strs("status")}
+}
+
+// runTool runs one tool through its run* core and returns the exit code and
+// the envelope it wrote (the success envelope on ExitOK, the error envelope
+// otherwise). Output is always compact.
+func runTool(session cliFlags, name string, args toolArgs) (int, []byte) {
+	var stdout, stderr bytes.Buffer
+	base := session
+	base.pretty = false
+	base.format = string(FormatJSON)
+
+	code := ExitOK
+	switch name {
+	case "live":
+		base.filters = args.filters()
+		code = runLive(&stdout, &stderr, base)
+	case "finished":
+		base.filters = args.filters()
+		code = runFinished(&stdout, &stderr, finishedFlags{
+			cliFlags:        base,
+			days:            args.integer("days"),
+			includeUpcoming: args.boolean("include_upcoming"),
+		})
+	case "fixtures":
+		code = runFixtures(&stdout, &stderr, fixturesFlags{
+			cliFlags:  base,
+			daysAhead: args.integer("days_ahead"),
+		})
+	case "match":
+		code = runMatch(&stdout, &stderr, matchFlags{
+			cliFlags:   base,
+			date:       args.str("date"),
+			leagues:    args.strs("league"),
+			pageURL:    args.str("page_url"),
+			commentary: args.boolean("commentary"),
+			h2h:        args.boolean("h2h"),
+		}, args.positional)
+	case "standings":
+		code = runStandings(&stdout, &stderr, base, args.positional)
+	case "leagues":
+		code = runLeagues(&stdout, &stderr, leaguesFlags{cliFlags: base, all: args.boolean("all")})
+	default:
+		code = WriteError(&stderr, ErrCodeInvalidArgs, NewInvalidArg("unknown tool %q", name))
+	}
+
+	if code != ExitOK {
+		return code, bytes.TrimSpace(stderr.Bytes())
+	}
+	return code, bytes.TrimSpace(stdout.Bytes())
+}
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"errors"
+	"io"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/spf13/cobra"
+)
+
+// topScorersFlags extends the common flag set with the stat list and season.
+type topScorersFlags struct {
+	cliFlags
+	stat   string // one of api.TopStats
+	season string // "" = current
+}
+
+var topScorersFlagSet topScorersFlags
+
+// leagueSeasonPattern accepts a single-year season ("2022") or a split one
+// ("2024/2025").
+var leagueSeasonPattern = regexp.MustCompile(`^\d{4}(/\d{4})?$`)
+
+// runTopScorers is the testable core of the `topscorers` subcommand.
+// args is the positional arg slice from cobra (we expect exactly one league).
+func runTopScorers(stdout, stderr io.Writer, flags topScorersFlags, args []string) int {
+	applyPretty(flags.cliFlags)
+
+	format, err := outputFormat(flags.cliFlags)
+	if err != nil {
+		return WriteError(stderr, ErrCodeInvalidArgs, err)
+	}
+
+	if len(args) != 1 {
+		return WriteError(stderr, ErrCodeInvalidArgs,
+			NewInvalidArg("expected exactly one league id or name, got %d args", len(args)))
+	}
+	leagueID, err := resolveLeagueID(args[0])
+	if err != nil {
+		return WriteError(stderr, ErrCodeInvalidArgs, err)
+	}
+	if !api.IsTopStat(flags.stat) {
+		return WriteError(stderr, ErrCodeInvalidArgs,
+			NewInvalidArg("--stat must be one of %s, got %q", strings.Join(api.TopStats, ", "), flags.stat))
+	}
+	if flags.season != "" && !leagueSeasonPattern.MatchString(flags.season) {
+		return WriteError(stderr, ErrCodeInvalidArgs,
+			NewInvalidArg("--season must be a year or a split season (e.g. 2022 or 2024/2025), got %q", flags.season))
+	}
+
+	client, ctx, cancel, err := newHeadlessClient(runtimeOpts{
+		mock:    flags.mock,
+		debug:   flags.debug,
+		timeout: flags.timeout,
+	})
+	defer cancel()
+	if err == ErrOffline {
+		return WriteError(stderr, ErrCodeOffline, err)
+	}
+	if err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+
+	var entries []api.LeagueStatEntry
+	if flags.mock {
+		entries = data.MockLeagueTopStats(leagueID, flags.stat)
+	} else {
+		entries, err = client.LeagueTopStats(ctx, leagueID, flags.season, flags.stat)
+		if errors.Is(err, fotmob.ErrNoLeagueStats) {
+			return WriteError(stderr, ErrCodeNotFound, err)
+		}
+		if err != nil {
+			return WriteError(stderr, ClassifyClientError(err, isTimeout(ctx)), err)
+		}
+	}
+
+	if err := writeList(stdout, stderr, format, entries, nil); err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+	return ExitOK
+}
+
+var topScorersCmd = &cobra.Command{
+	Use:   "topscorers <league>",
+	Short: "Get a league's top scorers (or assists, ratings, clean sheets, cards) as JSON",
+	Long: `Fetches a league's ranked player list for one stat, by league ID or name (see 'golazo leagues --all'). --stat picks goals (default), assists, rating, clean_sheets, yellow_cards or red_cards; --season a past season, e.g. 2024/2025 (a single year for tournaments).
+
+Leagues without player stats (most cups) return not_found. The football-data.org provider serves goals and assists only.
+
+Example:
+  golazo topscorers "premier league" --stat assists --format table
+
+Example output (truncated):
+  {"status":"ok","count":20,"data":[{"rank":1,"player_id":737066,"player_name":"Erling Haaland","team_id":8456,"team":"Manchester City","value":19}]}`,
+	Args:          cobra.ArbitraryArgs, // validated in runTopScorers for precise error envelope
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		code := runTopScorers(os.Stdout, os.Stderr, topScorersFlagSet, args)
+		if code != ExitOK {
+			os.Exit(code)
+		}
+	},
+}
+
+func init() {
+	addCommonCLIFlags(topScorersCmd, &topScorersFlagSet.cliFlags)
+	addFormatFlag(topScorersCmd, &topScorersFlagSet.cliFlags)
+	topScorersCmd.Flags().StringVar(&topScorersFlagSet.stat, "stat", api.StatGoals, "Stat to rank by: goals, assists, rating, clean_sheets, yellow_cards or red_cards")
+	topScorersCmd.Flags().StringVar(&topScorersFlagSet.season, "season", "", "Season, e.g. 2024/2025 or 2022 (default: current)")
+	rootCmd.AddCommand(topScorersCmd)
+}
@@ -0,0 +1,92 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/fotmob/fotmobtest"
+)
+
+func TestRunTopScorers_InvalidArgs(t *testing.T) {
+	for _, tc := range []struct {
+		args   []string
+		stat   string
+		season string
+	}{
+		{args: nil, stat: "goals"},
+		{args: []string{"47", "87"}, stat: "goals"},
+		{args: []string{"0"}, stat: "goals"},
+		{args: []string{"47"}, stat: "tackles"},
+		{args: []string{"47"}, stat: "goals", season: "24/25"},
+	} {
+		var stdout, stderr bytes.Buffer
+		flags := topScorersFlags{cliFlags: cliFlags{mock: true, timeout: time.Second}, stat: tc.stat, season: tc.season}
+		if code := runTopScorers(&stdout, &stderr, flags, tc.args); code != ExitInvalidArgs {
+			t.Errorf("%+v: exit = %d, want %d; stderr=%s", tc, code, ExitInvalidArgs, stderr.String())
+		}
+	}
+}
+
+func TestRunTopScorers_Mock(t *testing.T) {
+	t.Setenv(EnvOffline, "")
+	t.Setenv(EnvAgent, "")
+
+	var stdout, stderr bytes.Buffer
+	flags := topScorersFlags{cliFlags: cliFlags{mock: true, timeout: time.Second}, stat: api.StatAssists}
+	code := runTopScorers(&stdout, &stderr, flags, []string{"premier league"})
+	if code != ExitOK {
+		t.Fatalf("exit = %d, stderr=%s", code, stderr.String())
+	}
+	var env struct {
+		Count int                   `json:"count"`
+		Data  []api.LeagueStatEntry `json:"data"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &env); err != nil {
+		t.Fatalf("unmarshal: %v\nraw: %s", err, stdout.String())
+	}
+	if env.Count != len(env.Data) || len(env.Data) == 0 || env.Data[0].PlayerName != "Mohamed Salah" || env.Data[0].Value != 8 {
+		t.Fatalf("assists = %+v", env)
+	}
+
+	// Leagues without mock stats are an empty list, not null.
+	stdout.Reset()
+	flags.stat = api.StatGoals
+	if code := runTopScorers(&stdout, &stderr, flags, []string{"87"}); code != ExitOK {
+		t.Fatalf("empty: exit = %d, stderr=%s", code, stderr.String())
+	}
+	if !bytes.Contains(stdout.Bytes(), []byte(`"data":[]`)) {
+		t.Errorf("empty: output = %s", stdout.String())
+	}
+}
+
+func TestRunTopScorers_FetchesStatList(t *testing.T) {
+	useFakeFotmob(t)
+	flags := topScorersFlags{cliFlags: cliFlags{timeout: 5 * time.Second}, stat: api.StatRating}
+
+	var stdout, stderr bytes.Buffer
+	code := runTopScorers(&stdout, &stderr, flags, []string{strconv.Itoa(fotmobtest.SeedLeagueID)})
+	if code != ExitOK {
+		t.Fatalf("exit = %d, stderr=%s", code, stderr.String())
+	}
+	var env struct {
+		Data []api.LeagueStatEntry `json:"data"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &env); err != nil {
+		t.Fatalf("unmarshal: %v\nraw: %s", err, stdout.String())
+	}
+	if len(env.Data) == 0 || env.Data[0].Rank != 1 || env.Data[0].Value != 7.92 {
+		t.Errorf("rating = %+v", env.Data)
+	}
+
+	// The World Cup page links goals only.
+	stdout.Reset()
+	stderr.Reset()
+	flags.stat = api.StatAssists
+	if code := runTopScorers(&stdout, &stderr, flags, []string{"77"}); code != ExitNotFound {
+		t.Errorf("world cup assists: exit = %d, want %d; stderr=%s", code, ExitNotFound, stderr.String())
+	}
+}
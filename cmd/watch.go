@@ -0,0 +1,406 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/app"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/spf13/cobra"
+)
+
+// Watch event types emitted by `golazo watch`, one JSON object per line.
+const (
+	WatchEventGoal         = "goal"
+	WatchEventCard         = "card"
+	WatchEventSubstitution = "substitution"
+	WatchEventStatus       = "status"
+	WatchEventFinalWhistle = "final_whistle"
+)
+
+// watchEvent is a single NDJSON line on the watch stream. Match context is
+// repeated on every line so each one is self-contained for jq and loggers.
+type watchEvent struct {
+	Type       string          `json:"type"`
+	MatchID    int             `json:"match_id"`
+	League     api.League      `json:"league"`
+	HomeTeam   api.Team        `json:"home_team"`
+	AwayTeam   api.Team        `json:"away_team"`
+	Status     api.MatchStatus `json:"status"`
+	PrevStatus api.MatchStatus `json:"prev_status,omitempty"` // status events only
+	HomeScore  *int            `json:"home_score,omitempty"`
+	AwayScore  *int            `json:"away_score,omitempty"`
+	LiveTime   *string         `json:"live_time,omitempty"`
+	Event      *api.MatchEvent `json:"event,omitempty"` // goal/card/substitution events only
+	EmittedAt  time.Time       `json:"emitted_at"`
+}
+
+// watchListFetcher returns the current live and upcoming matches.
+type watchListFetcher func(ctx context.Context) (live, upcoming []api.Match, err error)
+
+func defaultWatchListFetcher(c api.Client, leagueIDs []int) watchListFetcher {
+	if len(leagueIDs) == 0 {
+		return c.LiveAndUpcoming
+	}
+	// Explicit leagues are fetched directly so --league works for leagues
+	// outside the user's active selection.
+	return func(ctx context.Context) (live, upcoming []api.Match, err error) {
+		var lastErr error
+		ok := 0
+		for _, id := range leagueIDs {
+			l, u, err := c.LiveAndUpcomingForLeague(ctx, id)
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			ok++
+			live = append(live, l...)
+			upcoming = append(upcoming, u...)
+		}
+		if ok == 0 {
+			return nil, nil, lastErr
+		}
+		return live, upcoming, nil
+	}
+}
+
+// watchedMatch is the per-match state carried between polls.
+type watchedMatch struct {
+	match  api.Match
+	events []api.MatchEvent
+	// detailed is false until the first details fetch succeeds; that fetch
+	// only sets the event baseline.
+	detailed bool
+}
+
+// watcher diffs successive polls into watch events. It is not safe for
+// concurrent use; runWatch drives it from a single goroutine.
+type watcher struct {
+	list    watchListFetcher
+	details matchDetailsFetcher
+	filter  matchFilter
+	parser  *fotmob.LiveUpdateParser
+	logger  *slog.Logger
+	now     func() time.Time
+
+	// emitInitial makes the first details fetch of each match emit its
+	// existing events instead of silently baselining them (mock mode).
+	emitInitial bool
+
+	matches map[int]*watchedMatch
+}
+
+func newWatcher(list watchListFetcher, details matchDetailsFetcher, filter matchFilter, logger *slog.Logger) *watcher {
+	return &watcher{
+		list:    list,
+		details: details,
+		filter:  filter,
+		parser:  fotmob.NewLiveUpdateParser(),
+		logger:  logger,
+		now:     time.Now,
+		matches: make(map[int]*watchedMatch),
+	}
+}
+
+// poll fetches one round of listings and details and returns the events that
+// happened since the previous poll. A match seen for the first time is
+// baselined without emitting anything, unless it was tracked before kickoff.
+func (w *watcher) poll(ctx context.Context) ([]watchEvent, error) {
+	live, upcoming, err := w.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []watchEvent
+
+	inListing := make(map[int]bool, len(live)+len(upcoming))
+	for _, m := range upcoming {
+		if !w.filter.keep(m) {
+			continue
+		}
+		inListing[m.ID] = true
+		out = append(out, w.observe(m)...)
+	}
+	for _, m := range live {
+		if !w.filter.keep(m) {
+			continue
+		}
+		inListing[m.ID] = true
+		out = append(out, w.observe(m)...)
+		out = append(out, w.refreshDetails(ctx, m.ID)...)
+	}
+
+	// A live match that drops off the listing has either finished or been
+	// interrupted; its details tell which.
+	for id, wm := range w.matches {
+		if inListing[id] {
+			continue
+		}
+		if wm.match.Status != api.MatchStatusLive {
+			delete(w.matches, id)
+			continue
+		}
+		out = append(out, w.refreshDetails(ctx, id)...)
+		if wm.match.Status != api.MatchStatusLive {
+			delete(w.matches, id)
+		}
+	}
+
+	return out, nil
+}
+
+// observe records a listing entry and emits a status event when its status
+// changed since the last poll.
+func (w *watcher) observe(m api.Match) []watchEvent {
+	wm, ok := w.matches[m.ID]
+	if !ok {
+		wm = &watchedMatch{match: m}
+		w.matches[m.ID] = wm
+	}
+	// A match that has not kicked off has no events, so everything after
+	// kickoff is new and needs no baseline fetch.
+	if m.Status == api.MatchStatusNotStarted {
+		wm.detailed = true
+	}
+	prev := wm.match.Status
+	wm.match = m
+	if !ok || prev == m.Status {
+		return nil
+	}
+	return w.statusEvents(wm, prev)
+}
+
+// refreshDetails fetches details for a tracked match and emits new events and
+// any status transition the details reveal. Fetch failures are logged and the
+// match is retried on the next poll.
+func (w *watcher) refreshDetails(ctx context.Context, matchID int) []watchEvent {
+	wm := w.matches[matchID]
+	details, err := w.details(ctx, matchID)
+	if err != nil || details == nil {
+		w.logger.Debug("watch: match details fetch failed", "matchID", matchID, "err", err)
+		return nil
+	}
+
+	var out []watchEvent
+	prev := wm.match.Status
+	wm.match.HomeScore = details.HomeScore
+	wm.match.AwayScore = details.AwayScore
+	if details.LiveTime != nil {
+		wm.match.LiveTime = details.LiveTime
+	}
+
+	var fresh []api.MatchEvent
+	if wm.detailed || w.emitInitial {
+		fresh = w.parser.NewEvents(wm.events, details.Events)
+	}
+	wm.events = details.Events
+	wm.detailed = true
+
+	for i := range fresh {
+		typ := watchEventType(fresh[i])
+		if typ == "" {
+			continue
+		}
+		ev := w.base(typ, wm)
+		ev.Event = &fresh[i]
+		out = append(out, ev)
+	}
+
+	if details.Status != "" && details.Status != prev {
+		wm.match.Status = details.Status
+		out = append(out, w.statusEvents(wm, prev)...)
+	}
+	return out
+}
+
+// statusEvents emits a status transition, plus a final_whistle when the
+// match has just finished.
+func (w *watcher) statusEvents(wm *watchedMatch, prev api.MatchStatus) []watchEvent {
+	ev := w.base(WatchEventStatus, wm)
+	ev.PrevStatus = prev
+	out := []watchEvent{ev}
+	if wm.match.Status == api.MatchStatusFinished {
+		out = append(out, w.base(WatchEventFinalWhistle, wm))
+	}
+	return out
+}
+
+func (w *watcher) base(typ string, wm *watchedMatch) watchEvent {
+	m := wm.match
+	return watchEvent{
+		Type:      typ,
+		MatchID:   m.ID,
+		League:    m.League,
+		HomeTeam:  m.HomeTeam,
+		AwayTeam:  m.AwayTeam,
+		Status:    m.Status,
+		HomeScore: m.HomeScore,
+		AwayScore: m.AwayScore,
+		LiveTime:  m.LiveTime,
+		EmittedAt: w.now().UTC(),
+	}
+}
+
+// watchEventType maps a match event onto a watch event type, or "" for
+// event kinds the stream does not report (added time, VAR notes, ...).
+func watchEventType(e api.MatchEvent) string {
+	switch strings.ToLower(e.Type) {
+	case "goal":
+		return WatchEventGoal
+	case "card":
+		return WatchEventCard
+	case "substitution":
+		return WatchEventSubstitution
+	default:
+		return ""
+	}
+}
+
+// watchLoop polls until ctx is cancelled, handing each event to emit. Poll
+// errors are logged and retried on the next tick; only an emit error (e.g. a
+// closed pipe) stops the loop early.
+func watchLoop(ctx context.Context, w *watcher, interval, pollTimeout time.Duration, emit func(watchEvent) error) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		pollCtx, cancel := context.WithTimeout(ctx, pollTimeout)
+		events, err := w.poll(pollCtx)
+		cancel()
+		if err != nil && ctx.Err() == nil {
+			w.logger.Debug("watch: poll failed", "err", err)
+		}
+		for _, ev := range events {
+			if err := emit(ev); err != nil {
+				return err
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// newClientWatcher builds a watcher backed by client, or by the bundled mock
+// data (emitted on the first poll) when mock is set.
+func newClientWatcher(client api.Client, mock bool, filter matchFilter, logger *slog.Logger) *watcher {
+	if mock {
+		w := newWatcher(
+			func(ctx context.Context) ([]api.Match, []api.Match, error) {
+				return data.MockLiveMatches(), data.MockUpcomingMatches(), nil
+			},
+			func(ctx context.Context, matchID int) (*api.MatchDetails, error) {
+				return data.MockMatchDetails(matchID)
+			},
+			filter, logger)
+		w.emitInitial = true
+		return w
+	}
+	return newWatcher(defaultWatchListFetcher(client, filter.leagueIDs), client.MatchDetailsForceRefresh, filter, logger)
+}
+
+// watchFlags extends the common flag set with the stream filters.
+type watchFlags struct {
+	cliFlags
+	matches []int
+	leagues []int
+	team    string
+}
+
+var watchFlagSet watchFlags
+
+// runWatch is the testable core of the `watch` subcommand. It streams until
+// SIGINT/SIGTERM and exits 0; only setup errors use the error envelope.
+func runWatch(stdout, stderr io.Writer, flags watchFlags) int {
+	for _, id := range flags.matches {
+		if id <= 0 {
+			return WriteError(stderr, ErrCodeInvalidArgs,
+				NewInvalidArg("--match must be a positive integer, got %d", id))
+		}
+	}
+	for _, id := range flags.leagues {
+		if id <= 0 {
+			return WriteError(stderr, ErrCodeInvalidArgs,
+				NewInvalidArg("--league must be a positive integer, got %d", id))
+		}
+	}
+	if flags.timeout <= 0 {
+		return WriteError(stderr, ErrCodeInvalidArgs,
+			NewInvalidArg("--timeout must be positive, got %s", flags.timeout))
+	}
+
+	client, _, cancel, err := newHeadlessClient(runtimeOpts{
+		mock:    flags.mock,
+		debug:   flags.debug,
+		timeout: flags.timeout,
+	})
+	defer cancel()
+	if err == ErrOffline {
+		return WriteError(stderr, ErrCodeOffline, err)
+	}
+	if err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+
+	filter := matchFilter{matchIDs: flags.matches, leagueIDs: flags.leagues, teams: singleTeam(flags.team)}
+	logger := newStderrLogger(flags.debug)
+
+	w := newClientWatcher(client, flags.mock, filter, logger)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	enc := json.NewEncoder(stdout)
+	err = watchLoop(ctx, w, app.LiveMatchPollInterval, flags.timeout, func(ev watchEvent) error {
+		return enc.Encode(ev)
+	})
+	if !flags.mock {
+		_ = savePageURLs(client)
+	}
+	if err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+	return ExitOK
+}
+
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Stream live match events as NDJSON",
+	Long: `Polls live matches every 90 seconds (the TUI's poll cadence) and prints one JSON object per line for each new goal, card, substitution, status change and final whistle. Runs until interrupted (Ctrl-C); exits 0.
+
+Events already on the board when watch starts are not replayed. With --mock, the bundled mock events are emitted on the first poll. --timeout bounds each poll, not the stream.
+
+Example output:
+  {"type":"goal","match_id":4506424,"league":{"id":47,"name":"Premier League","country":"England"},"home_team":{"id":8455,"name":"Chelsea","short_name":"Chelsea"},"away_team":{"id":6,"name":"Tottenham","short_name":"Spurs"},"status":"live","home_score":2,"away_score":1,"live_time":"67'","event":{"id":91,"minute":67,"type":"goal","team":{"id":8455,"name":"Chelsea","short_name":"Chelsea"},"player":"Palmer","timestamp":"2026-06-12T20:12:00Z"},"emitted_at":"2026-06-12T20:13:05Z"}`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		code := runWatch(os.Stdout, os.Stderr, watchFlagSet)
+		if code != ExitOK {
+			os.Exit(code)
+		}
+	},
+}
+
+func init() {
+	// No --pretty: NDJSON lines are always compact.
+	watchCmd.Flags().BoolVar(&watchFlagSet.mock, "mock", false, "Use mock data instead of real API")
+	watchCmd.Flags().BoolVar(&watchFlagSet.debug, "debug", false, "Emit debug logs to stderr")
+	watchCmd.Flags().DurationVar(&watchFlagSet.timeout, "timeout", 15*time.Second, "Per-poll request timeout")
+	watchCmd.Flags().IntSliceVar(&watchFlagSet.matches, "match", nil, "Only stream these match IDs (repeatable)")
+	watchCmd.Flags().IntSliceVar(&watchFlagSet.leagues, "league", nil, "Only stream these league IDs (repeatable; default: active leagues)")
+	watchCmd.Flags().StringVar(&watchFlagSet.team, "team", "", "Only stream matches for this team (ID or name fragment)")
+	rootCmd.AddCommand(watchCmd)
+}
@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+func intPtr(v int) *int { return &v }
+
+func strPtr(s string) *string { return &s }
+
+// fakeWatchSource serves scripted listings and details to a watcher.
+type fakeWatchSource struct {
+	live, upcoming []api.Match
+	details        map[int]*api.MatchDetails
+}
+
+func (f *fakeWatchSource) list(ctx context.Context) ([]api.Match, []api.Match, error) {
+	return f.live, f.upcoming, nil
+}
+
+func (f *fakeWatchSource) detail(ctx context.Context, matchID int) (*api.MatchDetails, error) {
+	return f.details[matchID], nil
+}
+
+func newTestWatcher(src *fakeWatchSource, filter matchFilter) *watcher {
+	return newWatcher(src.list, src.detail, filter, slog.New(slog.NewTextHandler(io.Discard, nil)))
+}
+
+func watchTypes(events []watchEvent) []string {
+	out := make([]string, 0, len(events))
+	for _, ev := range events {
+		out = append(out, ev.Type)
+	}
+	return out
+}
+
+func TestWatcher_BaselinesThenEmitsNewEvents(t *testing.T) {
+	home := api.Team{ID: 1, Name: "Home FC", ShortName: "Home"}
+	away := api.Team{ID: 2, Name: "Away United", ShortName: "Away"}
+	m := api.Match{ID: 10, League: api.League{ID: 47}, HomeTeam: home, AwayTeam: away, Status: api.MatchStatusLive}
+	src := &fakeWatchSource{
+		live: []api.Match{m},
+		details: map[int]*api.MatchDetails{10: {
+			Match:  m,
+			Events: []api.MatchEvent{{ID: 1, Minute: 5, Type: "goal", Team: home, Player: strPtr("A")}},
+		}},
+	}
+	w := newTestWatcher(src, matchFilter{})
+
+	events, err := w.poll(context.Background())
+	if err != nil {
+		t.Fatalf("poll: %v", err)
+	}
+	if len(events) != 0 {
+		t.Fatalf("first poll emitted %v, want baseline only", watchTypes(events))
+	}
+
+	src.details[10].Events = append(src.details[10].Events,
+		api.MatchEvent{ID: 2, Minute: 30, Type: "card", Team: away},
+		api.MatchEvent{ID: 3, Minute: 45, Type: "addedTime"},
+		api.MatchEvent{ID: 4, Minute: 60, Type: "substitution", Team: home},
+	)
+	src.details[10].HomeScore, src.details[10].AwayScore = intPtr(1), intPtr(0)
+
+	events, _ = w.poll(context.Background())
+	got := watchTypes(events)
+	want := []string{WatchEventCard, WatchEventSubstitution}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Fatalf("second poll = %v, want %v", got, want)
+	}
+	if events[0].Event == nil || events[0].Event.ID != 2 {
+		t.Errorf("card event payload = %+v", events[0].Event)
+	}
+	if events[0].HomeScore == nil || *events[0].HomeScore != 1 {
+		t.Errorf("score not carried onto event: %+v", events[0])
+	}
+}
+
+func TestWatcher_KickoffAndFinalWhistle(t *testing.T) {
+	m := api.Match{ID: 20, Status: api.MatchStatusNotStarted}
+	src := &fakeWatchSource{upcoming: []api.Match{m}, details: map[int]*api.MatchDetails{}}
+	w := newTestWatcher(src, matchFilter{})
+
+	if events, _ := w.poll(context.Background()); len(events) != 0 {
+		t.Fatalf("first poll emitted %v", watchTypes(events))
+	}
+
+	// Kickoff: events since kickoff are new because the match was tracked
+	// before it started.
+	m.Status = api.MatchStatusLive
+	src.upcoming, src.live = nil, []api.Match{m}
+	src.details[20] = &api.MatchDetails{Match: m, Events: []api.MatchEvent{{ID: 7, Minute: 3, Type: "goal"}}}
+	events, _ := w.poll(context.Background())
+	got := watchTypes(events)
+	if len(got) != 2 || got[0] != WatchEventStatus || got[1] != WatchEventGoal {
+		t.Fatalf("kickoff poll = %v, want [status goal]", got)
+	}
+	if events[0].PrevStatus != api.MatchStatusNotStarted || events[0].Status != api.MatchStatusLive {
+		t.Errorf("status event = %s -> %s", events[0].PrevStatus, events[0].Status)
+	}
+
+	// Full time: the match drops off the live listing and details say finished.
+	src.live = nil
+	src.details[20].Status = api.MatchStatusFinished
+	events, _ = w.poll(context.Background())
+	got = watchTypes(events)
+	if len(got) != 2 || got[0] != WatchEventStatus || got[1] != WatchEventFinalWhistle {
+		t.Fatalf("full-time poll = %v, want [status final_whistle]", got)
+	}
+	if _, tracked := w.matches[20]; tracked {
+		t.Errorf("finished match still tracked")
+	}
+}
+
+func TestWatchLoop_MockEmitsNDJSONAndStopsOnCancel(t *testing.T) {
+	w := newWatcher(
+		func(ctx context.Context) ([]api.Match, []api.Match, error) {
+			return data.MockLiveMatches(), nil, nil
+		},
+		func(ctx context.Context, matchID int) (*api.MatchDetails, error) {
+			return data.MockMatchDetails(matchID)
+		},
+		matchFilter{matchIDs: []int{2001}},
+		slog.New(slog.NewTextHandler(io.Discard, nil)),
+	)
+	w.emitInitial = true
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel() // one poll, then return
+
+	var stdout bytes.Buffer
+	enc := json.NewEncoder(&stdout)
+	emit := func(ev watchEvent) error { return enc.Encode(ev) }
+	if err := watchLoop(ctx, w, time.Hour, time.Second, emit); err != nil {
+		t.Fatalf("watchLoop: %v", err)
+	}
+
+	lines := 0
+	scanner := bufio.NewScanner(&stdout)
+	for scanner.Scan() {
+		var ev watchEvent
+		if err := json.Unmarshal(scanner.Bytes(), &ev); err != nil {
+			t.Fatalf("line %d is not a JSON object: %v\n%s", lines, err, scanner.Text())
+		}
+		if ev.MatchID != 2001 {
+			t.Errorf("filtered stream leaked match %d", ev.MatchID)
+		}
+		lines++
+	}
+	if lines == 0 {
+		t.Errorf("mock watch emitted no events")
+	}
+}
+
+func TestRunWatch_InvalidArgs(t *testing.T) {
+	cases := []watchFlags{
+		{cliFlags: cliFlags{mock: true, timeout: time.Second}, matches: []int{0}},
+		{cliFlags: cliFlags{mock: true, timeout: time.Second}, leagues: []int{-47}},
+		{cliFlags: cliFlags{mock: true, timeout: 0}},
+	}
+	for _, flags := range cases {
+		var stdout, stderr bytes.Buffer
+		if code := runWatch(&stdout, &stderr, flags); code != ExitInvalidArgs {
+			t.Errorf("flags %+v: exit = %d, want %d", flags, code, ExitInvalidArgs)
+		}
+		if stdout.Len() != 0 {
+			t.Errorf("stdout should be empty on invalid args, got: %s", stdout.String())
+		}
+	}
+}
+
+func TestRunWatch_Offline(t *testing.T) {
+	t.Setenv(EnvOffline, "1")
+	var stdout, stderr bytes.Buffer
+	code := runWatch(&stdout, &stderr, watchFlags{cliFlags: cliFlags{timeout: time.Second}})
+	if code != ExitOffline {
+		t.Errorf("exit = %d, want %d", code, ExitOffline)
+	}
+}
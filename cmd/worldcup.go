@@ -0,0 +1,280 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/spf13/cobra"
+)
+
+// WCStageBronze is the stage key `worldcup bracket` gives the third-place
+// play-off, which it appends after the final.
+const WCStageBronze = "bronze"
+
+// DefaultWCUpcomingDaysAhead matches the TUI's upcoming panel (today + 3).
+const DefaultWCUpcomingDaysAhead = 3
+
+// World Cup views served by runWorldCup.
+const (
+	wcViewGroups  = "groups"
+	wcViewBracket = "bracket"
+	wcViewScorers = "scorers"
+)
+
+var wcSeasonPattern = regexp.MustCompile(`^\d{4}$`)
+
+// worldCupFlags extends the common flag set with --season.
+type worldCupFlags struct {
+	cliFlags
+	season string
+}
+
+var (
+	wcGroupsFlagSet  worldCupFlags
+	wcBracketFlagSet worldCupFlags
+	wcScorersFlagSet worldCupFlags
+)
+
+// mockWorldCupData picks the bundled dataset: the completed 2022 tournament
+// for --season 2022, the 2026 preview otherwise.
+func mockWorldCupData(season string) *api.WorldCupData {
+	if season == "2022" {
+		return data.MockWorldCupData()
+	}
+	return data.MockWorldCupData2026()
+}
+
+// bracketRounds returns the knockout rounds in order, with the third-place
+// play-off appended as a WCStageBronze round when known.
+func bracketRounds(wc *api.WorldCupData) []api.WCKnockoutRound {
+	rounds := append([]api.WCKnockoutRound{}, wc.KnockoutRounds...)
+	if wc.BronzeFinal != nil {
+		rounds = append(rounds, api.WCKnockoutRound{
+			Stage:    WCStageBronze,
+			Label:    "Third place",
+			Matchups: []api.WCMatchup{*wc.BronzeFinal},
+		})
+	}
+	return rounds
+}
+
+// runWorldCup is the testable core of `worldcup groups|bracket|scorers`.
+func runWorldCup(stdout, stderr io.Writer, view string, flags worldCupFlags) int {
+	applyPretty(flags.cliFlags)
+
+	if flags.season != "" && !wcSeasonPattern.MatchString(flags.season) {
+		return WriteError(stderr, ErrCodeInvalidArgs,
+			NewInvalidArg("--season must be a four-digit year (e.g. 2026), got %q", flags.season))
+	}
+
+	client, ctx, cancel, err := newHeadlessClient(runtimeOpts{
+		mock:    flags.mock,
+		debug:   flags.debug,
+		timeout: flags.timeout,
+	})
+	defer cancel()
+	if err == ErrOffline {
+		return WriteError(stderr, ErrCodeOffline, err)
+	}
+	if err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+
+	var out any
+	if view == wcViewScorers {
+		var scorers []api.WCTopScorer
+		switch {
+		case flags.mock && flags.season == "2022":
+			scorers = data.MockWorldCupTopScorers()
+		case flags.mock:
+			scorers = mockWorldCupData(flags.season).TopScorers
+		default:
+			scorers, err = client.WorldCupTopScorers(ctx, flags.season)
+		}
+		out = scorers
+	} else {
+		var wc *api.WorldCupData
+		if flags.mock {
+			wc = mockWorldCupData(flags.season)
+		} else {
+			wc, err = client.WorldCupData(ctx, flags.season)
+		}
+		if err == nil {
+			if view == wcViewGroups {
+				out = wc.Groups
+			} else {
+				out = bracketRounds(wc)
+			}
+		}
+	}
+	if err != nil {
+		return WriteError(stderr, ClassifyClientError(err, isTimeout(ctx)), err)
+	}
+
+	if err := WriteJSON(stdout, out); err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+	return ExitOK
+}
+
+// worldCupUpcomingFlags extends the common flag set with --days-ahead.
+type worldCupUpcomingFlags struct {
+	cliFlags
+	daysAhead int
+}
+
+var wcUpcomingFlagSet worldCupUpcomingFlags
+
+// mockWorldCupUpcoming returns the bundled World Cup fixtures that kick off
+// within the look-ahead window.
+func mockWorldCupUpcoming(now time.Time, daysAhead int) []api.Match {
+	end := now.AddDate(0, 0, daysAhead+1)
+	var out []api.Match
+	for _, m := range data.MockWorldCupUpcoming() {
+		if m.MatchTime != nil && m.MatchTime.Before(end) {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// runWorldCupUpcoming is the testable core of `worldcup upcoming`: the
+// fixtures subcommand restricted to the World Cup league, whichever leagues
+// are active.
+func runWorldCupUpcoming(stdout, stderr io.Writer, flags worldCupUpcomingFlags) int {
+	applyPretty(flags.cliFlags)
+
+	format, err := outputFormat(flags.cliFlags)
+	if err != nil {
+		return WriteError(stderr, ErrCodeInvalidArgs, err)
+	}
+
+	if flags.daysAhead < 1 || flags.daysAhead > MaxFixturesDaysAhead {
+		return WriteError(stderr, ErrCodeInvalidArgs,
+			NewInvalidArg("--days-ahead must be between 1 and %d, got %d", MaxFixturesDaysAhead, flags.daysAhead))
+	}
+
+	client, ctx, cancel, err := newHeadlessClient(runtimeOpts{
+		mock:    flags.mock,
+		debug:   flags.debug,
+		timeout: flags.timeout,
+	})
+	defer cancel()
+	if err == ErrOffline {
+		return WriteError(stderr, ErrCodeOffline, err)
+	}
+	if err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+
+	var (
+		matches     []api.Match
+		failedDates []string
+	)
+	if flags.mock {
+		matches = mockWorldCupUpcoming(time.Now(), flags.daysAhead)
+	} else {
+		fetch := defaultFinishedFetcher(client, []int{api.WCFotMobLeagueID})
+		matches, failedDates, err = collectFixtures(ctx, fetch, time.Now(), flags.daysAhead)
+		if err != nil {
+			return WriteError(stderr, ClassifyClientError(err, isTimeout(ctx)), err)
+		}
+		if isTimeout(ctx) {
+			return WriteError(stderr, ErrCodeTimeout,
+				fmt.Errorf("world cup fixtures fetch timed out after %s", flags.timeout))
+		}
+		_ = savePageURLs(client)
+	}
+
+	SortMatches(matches)
+
+	if err := writeList(stdout, stderr, format, matches, failedDates); err != nil {
+		return WriteError(stderr, ErrCodeUpstreamError, err)
+	}
+	return ExitOK
+}
+
+var worldCupCmd = &cobra.Command{
+	Use:   "worldcup",
+	Short: "FIFA World Cup groups, bracket, top scorers and fixtures as JSON",
+	Long: `World Cup data, as shown in the TUI's World Cup view. Pick a subcommand:
+
+  golazo worldcup groups    group tables
+  golazo worldcup bracket   knockout rounds, final and third-place play-off
+  golazo worldcup scorers   top scorers
+  golazo worldcup upcoming  World Cup fixtures over the next days
+
+--season (e.g. 2022, 2026) selects the tournament; the default is the current one. With --mock, --season 2022 serves the completed Qatar tournament and anything else the 2026 preview.`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+}
+
+// newWorldCupViewCmd builds one of the groups/bracket/scorers subcommands.
+func newWorldCupViewCmd(view, short, long string, flags *worldCupFlags) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:           view,
+		Short:         short,
+		Long:          long,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		Run: func(cmd *cobra.Command, args []string) {
+			code := runWorldCup(os.Stdout, os.Stderr, view, *flags)
+			if code != ExitOK {
+				os.Exit(code)
+			}
+		},
+	}
+	addCommonCLIFlags(cmd, &flags.cliFlags)
+	cmd.Flags().StringVar(&flags.season, "season", "", "Tournament year, e.g. 2026 (default: current)")
+	return cmd
+}
+
+var wcUpcomingCmd = &cobra.Command{
+	Use:           "upcoming",
+	Short:         "List upcoming World Cup matches as JSON",
+	Long:          `Fetches not-yet-started World Cup matches from today through the next --days-ahead days (default 3, max 14), whether or not the World Cup is an active league. Same envelope and Match schema as ` + "`golazo fixtures`" + `.`,
+	SilenceUsage:  true,
+	SilenceErrors: true,
+	Run: func(cmd *cobra.Command, args []string) {
+		code := runWorldCupUpcoming(os.Stdout, os.Stderr, wcUpcomingFlagSet)
+		if code != ExitOK {
+			os.Exit(code)
+		}
+	},
+}
+
+func init() {
+	worldCupCmd.AddCommand(newWorldCupViewCmd(wcViewGroups,
+		"List World Cup group tables as JSON",
+		`Prints every group with its table (WCGroup objects; teams use the standings LeagueTableEntry schema).
+
+Example output:
+  {"status":"ok","count":8,"data":[{"id":868714,"letter":"C","name":"Group C","teams":[{"position":1,"team":{"id":6706,"name":"Argentina","short_name":"ARG"},"played":3,"won":2,"drawn":0,"lost":1,"goals_for":5,"goals_against":2,"goal_difference":3,"points":6}]}]}`,
+		&wcGroupsFlagSet))
+	worldCupCmd.AddCommand(newWorldCupViewCmd(wcViewBracket,
+		"List World Cup knockout rounds as JSON",
+		`Prints the knockout rounds in order (WCKnockoutRound objects), ending with the final and a "bronze" round for the third-place play-off. Matchups carry scores, penalty scores and the winner's team ID once decided; undecided slots set tbd_home/tbd_away.
+
+Example output:
+  {"status":"ok","count":5,"data":[{"stage":"final","label":"Final","matchups":[{"home_team":"Argentina","home_team_id":6706,"home_short":"ARG","away_team":"France","away_team_id":6723,"away_short":"FRA","home_score":3,"away_score":3,"home_pen_score":4,"away_pen_score":2,"winner_id":6706,"is_penalties":true,"tbd_home":false,"tbd_away":false}]}]}`,
+		&wcBracketFlagSet))
+	worldCupCmd.AddCommand(newWorldCupViewCmd(wcViewScorers,
+		"List World Cup top scorers as JSON",
+		`Prints the top scorers ranked by goals (WCTopScorer objects). Empty before the tournament starts.
+
+Example output:
+  {"status":"ok","count":2,"data":[{"player_name":"Kylian Mbappé","team":"France","goals":8,"assists":0},{"player_name":"Lionel Messi","team":"Argentina","goals":7,"assists":0}]}`,
+		&wcScorersFlagSet))
+
+	addCommonCLIFlags(wcUpcomingCmd, &wcUpcomingFlagSet.cliFlags)
+	addFormatFlag(wcUpcomingCmd, &wcUpcomingFlagSet.cliFlags)
+	wcUpcomingCmd.Flags().IntVar(&wcUpcomingFlagSet.daysAhead, "days-ahead", DefaultWCUpcomingDaysAhead, "Number of days to look ahead (1..14)")
+	worldCupCmd.AddCommand(wcUpcomingCmd)
+
+	rootCmd.AddCommand(worldCupCmd)
+}
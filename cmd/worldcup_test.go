@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+func runWorldCupMock(t *testing.T, view, season string) []byte {
+	t.Helper()
+	t.Setenv(EnvOffline, "")
+	t.Setenv(EnvAgent, "")
+
+	var stdout, stderr bytes.Buffer
+	code := runWorldCup(&stdout, &stderr, view, worldCupFlags{
+		cliFlags: cliFlags{mock: true, timeout: time.Second},
+		season:   season,
+	})
+	if code != ExitOK {
+		t.Fatalf("%s: exit = %d, stderr=%s", view, code, stderr.String())
+	}
+	return stdout.Bytes()
+}
+
+func TestRunWorldCup_MockGroups(t *testing.T) {
+	var env struct {
+		Count int           `json:"count"`
+		Data  []api.WCGroup `json:"data"`
+	}
+	if err := json.Unmarshal(runWorldCupMock(t, wcViewGroups, "2026"), &env); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	want := len(data.MockWorldCupData2026().Groups)
+	if env.Count != want || len(env.Data) != want {
+		t.Errorf("count = %d, data = %d, want %d", env.Count, len(env.Data), want)
+	}
+	if len(env.Data) > 0 && (env.Data[0].Letter == "" || len(env.Data[0].Teams) == 0) {
+		t.Errorf("first group = %+v, want letter and teams", env.Data[0])
+	}
+}
+
+func TestRunWorldCup_MockBracketSchema(t *testing.T) {
+	var env struct {
+		Data []map[string]any `json:"data"`
+	}
+	if err := json.Unmarshal(runWorldCupMock(t, wcViewBracket, "2022"), &env); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	n := len(env.Data)
+	if n < 2 {
+		t.Fatalf("rounds = %d, want the knockout rounds plus bronze", n)
+	}
+	if env.Data[n-1]["stage"] != WCStageBronze || env.Data[n-2]["stage"] != "final" {
+		t.Errorf("last stages = %v, %v; want final then %s", env.Data[n-2]["stage"], env.Data[n-1]["stage"], WCStageBronze)
+	}
+
+	final := env.Data[n-2]["matchups"].([]any)[0].(map[string]any)
+	for key, want := range map[string]any{
+		"home_team":      "Argentina",
+		"away_team":      "France",
+		"home_pen_score": float64(4),
+		"away_pen_score": float64(2),
+		"winner_id":      float64(6706),
+		"is_penalties":   true,
+	} {
+		if final[key] != want {
+			t.Errorf("final[%q] = %v, want %v", key, final[key], want)
+		}
+	}
+}
+
+func TestRunWorldCup_MockScorers(t *testing.T) {
+	var env struct {
+		Data []api.WCTopScorer `json:"data"`
+	}
+	if err := json.Unmarshal(runWorldCupMock(t, wcViewScorers, "2022"), &env); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(env.Data) == 0 || env.Data[0].PlayerName != "Kylian Mbappé" || env.Data[0].Goals != 8 {
+		t.Errorf("scorers = %+v", env.Data)
+	}
+
+	// The 2026 preview has no scorers yet: an empty list, not null.
+	raw := runWorldCupMock(t, wcViewScorers, "2026")
+	var empty struct {
+		Count int             `json:"count"`
+		Data  json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(raw, &empty); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if empty.Count != 0 || string(empty.Data) != "[]" {
+		t.Errorf("2026 scorers = %s", raw)
+	}
+}
+
+func TestRunWorldCup_InvalidSeason(t *testing.T) {
+	var stdout, stderr bytes.Buffer
+	code := runWorldCup(&stdout, &stderr, wcViewGroups, worldCupFlags{cliFlags: cliFlags{mock: true}, season: "26"})
+	if code != ExitInvalidArgs {
+		t.Errorf("exit = %d, want %d", code, ExitInvalidArgs)
+	}
+	if stdout.Len() != 0 {
+		t.Errorf("stdout should be empty on invalid args, got: %s", stdout.String())
+	}
+}
+
+func TestRunWorldCupUpcoming_MockWindow(t *testing.T) {
+	t.Setenv(EnvOffline, "")
+	t.Setenv(EnvAgent, "")
+
+	var stdout, stderr bytes.Buffer
+	code := runWorldCupUpcoming(&stdout, &stderr, worldCupUpcomingFlags{
+		cliFlags:  cliFlags{mock: true, timeout: time.Second},
+		daysAhead: 1,
+	})
+	if code != ExitOK {
+		t.Fatalf("exit = %d, stderr=%s", code, stderr.String())
+	}
+	var env struct {
+		Data []api.Match `json:"data"`
+	}
+	if err := json.Unmarshal(stdout.Bytes(), &env); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if len(env.Data) == 0 || len(env.Data) >= len(data.MockWorldCupUpcoming()) {
+		t.Errorf("got %d matches, want a non-empty subset of the mock fixtures", len(env.Data))
+	}
+	for _, m := range env.Data {
+		if m.League.ID != api.WCFotMobLeagueID {
+			t.Errorf("match %d league = %d, want %d", m.ID, m.League.ID, api.WCFotMobLeagueID)
+		}
+	}
+}
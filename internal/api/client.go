@@ -2,18 +2,56 @@ package api
 
 import (
 	"context"
+	"errors"
 	"time"
 )
 
+// ErrUnsupported is returned (wrapped) by a Client for data its provider does
+// not offer, e.g. a league outside its coverage or World Cup brackets.
+var ErrUnsupported = errors.New("not supported by this data provider")
+
 // Client defines the interface for a football API client.
 // This abstraction allows us to swap implementations (FotMob, other APIs, mock, etc.)
+//
+// League IDs are golazo's (FotMob's numbering, as in settings.yaml); providers
+// with another numbering translate them. Tabs are "fixtures" (live and not
+// started) and "results" (finished).
 type Client interface {
 	// MatchesByDate retrieves all matches for a specific date.
 	MatchesByDate(ctx context.Context, date time.Time) ([]Match, error)
 
+	// MatchesByDateWithTabs retrieves matches for a date across the active
+	// leagues, limited to the given tabs.
+	MatchesByDateWithTabs(ctx context.Context, date time.Time, tabs []string) ([]Match, error)
+
+	// MatchesByDateForLeagues is MatchesByDateWithTabs over an explicit league set.
+	MatchesByDateForLeagues(ctx context.Context, date time.Time, tabs []string, leagueIDs []int) ([]Match, error)
+
+	// MatchesForLeagueAndDate retrieves one league's matches on a date for a
+	// single tab. Used for progressive loading.
+	MatchesForLeagueAndDate(ctx context.Context, leagueID int, date time.Time, tab string) ([]Match, error)
+
+	// LiveAndUpcoming retrieves the matches in progress and those scheduled
+	// for today across the active leagues.
+	LiveAndUpcoming(ctx context.Context) (live, upcoming []Match, err error)
+
+	// LiveAndUpcomingForLeague is LiveAndUpcoming for a single league.
+	LiveAndUpcomingForLeague(ctx context.Context, leagueID int) (live, upcoming []Match, err error)
+
+	// LiveAndUpcomingForLeagues is LiveAndUpcoming over an explicit league set.
+	LiveAndUpcomingForLeagues(ctx context.Context, leagueIDs []int) (live, upcoming []Match, err error)
+
 	// MatchDetails retrieves detailed information about a specific match.
 	MatchDetails(ctx context.Context, matchID int) (*MatchDetails, error)
 
+	// MatchDetailsForceRefresh is MatchDetails bypassing short-lived caches,
+	// for live polling.
+	MatchDetailsForceRefresh(ctx context.Context, matchID int) (*MatchDetails, error)
+
+	// Commentary retrieves the match's live commentary in match order. With a
+	// sinceID from an earlier call, only the entries after it are returned.
+	Commentary(ctx context.Context, matchID int, sinceID string) ([]CommentaryEntry, error)
+
 	// Leagues retrieves available leagues.
 	Leagues(ctx context.Context) ([]League, error)
 
@@ -23,4 +61,19 @@ type Client interface {
 	// LeagueTable retrieves the league table/standings for a specific league.
 	// leagueName is used to detect parent leagues for knockout competitions.
 	LeagueTable(ctx context.Context, leagueID int, leagueName string) ([]LeagueTableEntry, error)
+
+	// LeagueTableWithParent is LeagueTable preferring parentLeagueID (from
+	// match details) for sub-season leagues without standings of their own.
+	LeagueTableWithParent(ctx context.Context, leagueID int, leagueName string, parentLeagueID int) ([]LeagueTableEntry, error)
+
+	// WorldCupData retrieves the World Cup groups and knockout bracket for a
+	// season ("" for the current one).
+	WorldCupData(ctx context.Context, season string) (*WorldCupData, error)
+
+	// WorldCupTopScorers retrieves the World Cup top scorers for a season.
+	WorldCupTopScorers(ctx context.Context, season string) ([]WCTopScorer, error)
+
+	// LeagueTopStats retrieves a league's ranked player list for one of
+	// TopStats, for a season ("" for the current one).
+	LeagueTopStats(ctx context.Context, leagueID int, season, stat string) ([]LeagueStatEntry, error)
 }
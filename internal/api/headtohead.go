@@ -0,0 +1,30 @@
+package api
+
+import "strings"
+
+// HeadToHead is the record between a match's two teams, counted from the
+// side of the match's home team whichever venue the meetings were at.
+type HeadToHead struct {
+	HomeWins int     `json:"home_wins"`
+	Draws    int     `json:"draws"`
+	AwayWins int     `json:"away_wins"`
+	Matches  []Match `json:"matches"` // Recent meetings, most recent first
+}
+
+// NewHeadToHead builds a head-to-head record for homeTeamID from the
+// meetings in matches (most recent first), tallying the finished ones.
+func NewHeadToHead(homeTeamID int, matches []Match) *HeadToHead {
+	var finished []Match
+	for _, m := range matches {
+		if m.Status == MatchStatusFinished {
+			finished = append(finished, m)
+		}
+	}
+	form := FormString(homeTeamID, finished)
+	return &HeadToHead{
+		HomeWins: strings.Count(form, "W"),
+		Draws:    strings.Count(form, "D"),
+		AwayWins: strings.Count(form, "L"),
+		Matches:  append([]Match{}, matches...),
+	}
+}
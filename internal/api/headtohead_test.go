@@ -0,0 +1,22 @@
+package api
+
+import "testing"
+
+func TestNewHeadToHead(t *testing.T) {
+	meeting := func(homeID, awayID, home, away int) Match {
+		return Match{HomeTeam: Team{ID: homeID}, AwayTeam: Team{ID: awayID}, HomeScore: &home, AwayScore: &away, Status: MatchStatusFinished}
+	}
+	matches := []Match{
+		{HomeTeam: Team{ID: 1}, AwayTeam: Team{ID: 2}, Status: MatchStatusNotStarted}, // this fixture
+		meeting(2, 1, 0, 1), // win away from home
+		meeting(1, 2, 2, 2),
+		meeting(1, 2, 0, 3),
+	}
+	h := NewHeadToHead(1, matches)
+	if h.HomeWins != 1 || h.Draws != 1 || h.AwayWins != 1 || len(h.Matches) != 4 {
+		t.Errorf("NewHeadToHead = %+v, want 1-1-1 over 4 matches", h)
+	}
+	if h := NewHeadToHead(1, nil); h.Matches == nil {
+		t.Error("Matches should be empty, not nil")
+	}
+}
@@ -0,0 +1,36 @@
+package api
+
+// League stat lists served by Client.LeagueTopStats.
+const (
+	StatGoals       = "goals"
+	StatAssists     = "assists"
+	StatRating      = "rating"
+	StatCleanSheets = "clean_sheets"
+	StatYellowCards = "yellow_cards"
+	StatRedCards    = "red_cards"
+)
+
+// TopStats lists the stats LeagueTopStats accepts, in display order.
+var TopStats = []string{StatGoals, StatAssists, StatRating, StatCleanSheets, StatYellowCards, StatRedCards}
+
+// IsTopStat reports whether stat is one of TopStats.
+func IsTopStat(stat string) bool {
+	for _, s := range TopStats {
+		if s == stat {
+			return true
+		}
+	}
+	return false
+}
+
+// LeagueStatEntry is a player's row in a league stat list, e.g. the top
+// scorers. Value is the stat itself: goals, assists, average rating, clean
+// sheets or cards.
+type LeagueStatEntry struct {
+	Rank       int     `json:"rank"`
+	PlayerID   int     `json:"player_id,omitempty"`
+	PlayerName string  `json:"player_name"`
+	TeamID     int     `json:"team_id,omitempty"`
+	Team       string  `json:"team"`
+	Value      float64 `json:"value"`
+}
@@ -0,0 +1,39 @@
+package api
+
+// PlayerMatchStats is a player's output in one match.
+type PlayerMatchStats struct {
+	Minutes         int `json:"minutes"`
+	Goals           int `json:"goals"`
+	Assists         int `json:"assists"`
+	Shots           int `json:"shots"`
+	ShotsOnTarget   int `json:"shots_on_target"`
+	Passes          int `json:"passes"`
+	PassesCompleted int `json:"passes_completed"`
+	Duels           int `json:"duels"`
+	DuelsWon        int `json:"duels_won"`
+	YellowCards     int `json:"yellow_cards"`
+	RedCards        int `json:"red_cards"`
+}
+
+// PlayerProfile is a player's page: who they are and their season so far in
+// their main league.
+type PlayerProfile struct {
+	ID       int                `json:"id"`
+	Name     string             `json:"name"`
+	Team     *Team              `json:"team,omitempty"`     // Current club
+	Position string             `json:"position,omitempty"` // Provider's label, e.g. "Striker"
+	Number   int                `json:"number,omitempty"`
+	Age      int                `json:"age,omitempty"`
+	Country  string             `json:"country,omitempty"`
+	HeightCM int                `json:"height_cm,omitempty"`
+	League   *League            `json:"league,omitempty"` // League the season stats are for
+	Season   string             `json:"season,omitempty"` // e.g. "2025/2026"
+	Stats    []PlayerSeasonStat `json:"stats"`
+}
+
+// PlayerSeasonStat is one season total, e.g. goals or minutes played.
+type PlayerSeasonStat struct {
+	Key   string  `json:"key"` // Stable identifier, e.g. "goals", "minutes_played"
+	Label string  `json:"label"`
+	Value float64 `json:"value"`
+}
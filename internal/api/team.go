@@ -0,0 +1,57 @@
+package api
+
+// Squad member positions.
+const (
+	PositionCoach      = "coach"
+	PositionGoalkeeper = "goalkeeper"
+	PositionDefender   = "defender"
+	PositionMidfielder = "midfielder"
+	PositionForward    = "forward"
+)
+
+// TeamOverview is a team's page: squad, recent results, upcoming fixtures and
+// where it stands in its primary league.
+type TeamOverview struct {
+	Team     Team              `json:"team"`
+	Country  string            `json:"country,omitempty"`
+	League   *League           `json:"league,omitempty"`   // Primary league
+	Standing *LeagueTableEntry `json:"standing,omitempty"` // Row in the primary league table, if it has one
+	Form     string            `json:"form"`               // Recent results, oldest first, e.g. "WWDLW"
+	Results  []Match           `json:"results"`            // Last finished matches, most recent first
+	Fixtures []Match           `json:"fixtures"`           // Next matches, soonest first
+	Squad    []SquadMember     `json:"squad"`
+}
+
+// SquadMember is a player or coach in a team's squad.
+type SquadMember struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	Number   int    `json:"number,omitempty"`
+	Position string `json:"position"` // One of the Position constants
+	Age      int    `json:"age,omitempty"`
+	Country  string `json:"country,omitempty"`
+}
+
+// FormString returns teamID's results as W/D/L from its point of view, in
+// the order given. Matches without a score are skipped.
+func FormString(teamID int, matches []Match) string {
+	form := make([]byte, 0, len(matches))
+	for _, m := range matches {
+		if m.HomeScore == nil || m.AwayScore == nil {
+			continue
+		}
+		own, other := *m.HomeScore, *m.AwayScore
+		if m.AwayTeam.ID == teamID {
+			own, other = other, own
+		}
+		switch {
+		case own > other:
+			form = append(form, 'W')
+		case own < other:
+			form = append(form, 'L')
+		default:
+			form = append(form, 'D')
+		}
+	}
+	return string(form)
+}
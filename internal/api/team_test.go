@@ -0,0 +1,26 @@
+package api
+
+import "testing"
+
+func TestFormString(t *testing.T) {
+	score := func(home, away int) (*int, *int) { return &home, &away }
+	match := func(homeID, awayID, home, away int) Match {
+		m := Match{HomeTeam: Team{ID: homeID}, AwayTeam: Team{ID: awayID}}
+		m.HomeScore, m.AwayScore = score(home, away)
+		return m
+	}
+
+	matches := []Match{
+		match(1, 2, 2, 0), // home win
+		match(3, 1, 2, 0), // away loss
+		match(1, 4, 1, 1), // draw
+		{HomeTeam: Team{ID: 1}, AwayTeam: Team{ID: 5}}, // not played
+		match(6, 1, 0, 3), // away win
+	}
+	if got := FormString(1, matches); got != "WLDW" {
+		t.Errorf("FormString = %q, want WLDW", got)
+	}
+	if got := FormString(1, nil); got != "" {
+		t.Errorf("FormString(nil) = %q, want empty", got)
+	}
+}
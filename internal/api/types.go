@@ -60,6 +60,19 @@ type MatchEvent struct {
 	Timestamp     time.Time `json:"timestamp"`
 }
 
+// CommentaryEntry is one line of the minute-by-minute live commentary.
+// Entries are in match order; ID is stable across fetches, so the last seen
+// ID can be passed back to fetch only newer lines.
+type CommentaryEntry struct {
+	ID            string `json:"id"`
+	Minute        int    `json:"minute"`
+	AddedMinute   int    `json:"added_minute,omitempty"`
+	DisplayMinute string `json:"display_minute,omitempty"` // e.g. "45+2'"; empty for pre-match and full-time lines
+	Type          string `json:"type,omitempty"`           // provider's category, e.g. "goal", "yellow-card", "comment"
+	Text          string `json:"text"`
+	IsHome        *bool  `json:"is_home,omitempty"` // Team the line is about, when known
+}
+
 // MatchStatistic represents a single match statistic (possession, shots, etc.)
 type MatchStatistic struct {
 	Key       string `json:"key"`        // e.g., "possession", "shots_total"
@@ -70,11 +83,12 @@ type MatchStatistic struct {
 
 // PlayerInfo represents basic player information for lineups
 type PlayerInfo struct {
-	ID       int    `json:"id"`
-	Name     string `json:"name"`
-	Number   int    `json:"number,omitempty"`
-	Position string `json:"position,omitempty"`
-	Rating   string `json:"rating,omitempty"` // Player rating (e.g., "7.2")
+	ID       int               `json:"id"`
+	Name     string            `json:"name"`
+	Number   int               `json:"number,omitempty"`
+	Position string            `json:"position,omitempty"`
+	Rating   string            `json:"rating,omitempty"` // Player rating (e.g., "7.2")
+	Stats    *PlayerMatchStats `json:"stats,omitempty"`  // Nil until the provider publishes player stats
 }
 
 // MatchDetails contains detailed information about a match
@@ -121,6 +135,13 @@ type MatchDetails struct {
 	HomeXG *float64 `json:"home_xg,omitempty"` // Expected goals for home team
 	AwayXG *float64 `json:"away_xg,omitempty"` // Expected goals for away team
 
+	Momentum   []MomentumPoint `json:"momentum,omitempty"`    // Per-minute attacking momentum
+	XGTimeline []XGPoint       `json:"xg_timeline,omitempty"` // Cumulative xG after each shot
+	Shots      []Shot          `json:"shots,omitempty"`       // Every shot, in match order
+
+	// Previous meetings of the two teams (if available)
+	HeadToHead *HeadToHead `json:"head_to_head,omitempty"`
+
 	// Highlight video (if available)
 	Highlight *MatchHighlight `json:"highlight,omitempty"` // Official highlight video link
 
@@ -129,6 +150,51 @@ type MatchDetails struct {
 	WhoLostOnAggregate string `json:"who_lost_on_aggregate,omitempty"` // team name eliminated on aggregate
 }
 
+// MomentumPoint is one sample of the attacking momentum graph.
+// Value runs from -100 (away dominating) to 100 (home dominating).
+type MomentumPoint struct {
+	Minute float64 `json:"minute"`
+	Value  int     `json:"value"`
+}
+
+// XGPoint is the running expected-goals total after a shot. Minute is the
+// regular minute; stoppage time goes in AddedMinute, as for Shot.
+type XGPoint struct {
+	Minute      int     `json:"minute"`
+	AddedMinute int     `json:"added_minute,omitempty"`
+	HomeXG      float64 `json:"home_xg"`
+	AwayXG      float64 `json:"away_xg"`
+}
+
+// Shot outcomes.
+const (
+	ShotOutcomeGoal    = "goal"
+	ShotOutcomeSaved   = "saved"
+	ShotOutcomeMissed  = "missed"
+	ShotOutcomePost    = "post"
+	ShotOutcomeBlocked = "blocked"
+)
+
+// Shot is a single attempt on goal from the shot map.
+// X and Y are pitch coordinates in the provider's units (FotMob: x 0-105
+// towards the opponent's goal, y 0-68).
+type Shot struct {
+	Minute      int      `json:"minute"`
+	AddedMinute int      `json:"added_minute,omitempty"`
+	TeamID      int      `json:"team_id"`
+	IsHome      bool     `json:"is_home"`
+	PlayerID    int      `json:"player_id,omitempty"`
+	Player      string   `json:"player"`
+	XG          float64  `json:"xg"`
+	XGOT        *float64 `json:"xgot,omitempty"`      // Expected goals on target (on-target shots only)
+	Outcome     string   `json:"outcome"`             // One of the ShotOutcome constants
+	ShotType    string   `json:"shot_type,omitempty"` // e.g. "RightFoot", "Header"
+	Situation   string   `json:"situation,omitempty"` // e.g. "RegularPlay", "SetPiece", "Penalty"
+	X           float64  `json:"x"`
+	Y           float64  `json:"y"`
+	OwnGoal     bool     `json:"own_goal,omitempty"`
+}
+
 // MatchHighlight represents an official highlight video for a match
 type MatchHighlight struct {
 	URL    string `json:"url"`              // Direct link to highlight video
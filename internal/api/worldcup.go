@@ -5,55 +5,55 @@ const WCFotMobLeagueID = 77
 
 // WCGroup represents a single World Cup group with its standings.
 type WCGroup struct {
-	ID     int
-	Letter string // "A", "B", "C", etc.
-	Name   string // "Group A", "Group B", etc.
-	Teams  []LeagueTableEntry
+	ID     int                `json:"id"`
+	Letter string             `json:"letter"` // "A", "B", "C", etc.
+	Name   string             `json:"name"`   // "Group A", "Group B", etc.
+	Teams  []LeagueTableEntry `json:"teams"`
 }
 
 // WCMatchup represents a single knockout stage matchup.
 type WCMatchup struct {
-	HomeTeam    string
-	HomeTeamID  int
-	HomeShort   string
-	AwayTeam    string
-	AwayTeamID  int
-	AwayShort   string
-	HomeScore    *int
-	AwayScore    *int
-	HomePenScore *int
-	AwayPenScore *int
-	WinnerID     *int
-	IsPenalties  bool
-	TBDHome     bool
-	TBDAway     bool
+	HomeTeam     string `json:"home_team"`
+	HomeTeamID   int    `json:"home_team_id"`
+	HomeShort    string `json:"home_short"`
+	AwayTeam     string `json:"away_team"`
+	AwayTeamID   int    `json:"away_team_id"`
+	AwayShort    string `json:"away_short"`
+	HomeScore    *int   `json:"home_score,omitempty"`
+	AwayScore    *int   `json:"away_score,omitempty"`
+	HomePenScore *int   `json:"home_pen_score,omitempty"`
+	AwayPenScore *int   `json:"away_pen_score,omitempty"`
+	WinnerID     *int   `json:"winner_id,omitempty"`
+	IsPenalties  bool   `json:"is_penalties"`
+	TBDHome      bool   `json:"tbd_home"` // home slot not yet decided
+	TBDAway      bool   `json:"tbd_away"` // away slot not yet decided
 }
 
 // WCKnockoutRound represents a round in the knockout stage.
 type WCKnockoutRound struct {
-	Stage    string // FotMob stage key: "1/16", "1/8", "1/4", "1/2", "final"
-	Label    string // Human-readable: "Round of 32", "Round of 16", etc.
-	Matchups []WCMatchup
+	Stage    string      `json:"stage"` // FotMob stage key: "1/16", "1/8", "1/4", "1/2", "final"
+	Label    string      `json:"label"` // Human-readable: "Round of 32", "Round of 16", etc.
+	Matchups []WCMatchup `json:"matchups"`
 }
 
 // WCTopScorer represents a player's top scorer entry for the current World Cup.
 type WCTopScorer struct {
-	PlayerName string
-	Team       string
-	Goals      int
-	Assists    int
+	PlayerName string `json:"player_name"`
+	Team       string `json:"team"`
+	Goals      int    `json:"goals"`
+	Assists    int    `json:"assists"`
 }
 
 // WorldCupData contains all World Cup tournament data.
 type WorldCupData struct {
-	Season         string // "2022", "2026"
-	Name           string // "FIFA World Cup 2022"
-	Groups         []WCGroup
-	KnockoutRounds []WCKnockoutRound // ordered R32/R16 → QF → SF → Final (bronze excluded)
-	BronzeFinal    *WCMatchup
-	Champion       *Team
-	RunnerUp       *Team
-	TopScorers     []WCTopScorer
+	Season         string            `json:"season"` // "2022", "2026"
+	Name           string            `json:"name"`   // "FIFA World Cup 2022"
+	Groups         []WCGroup         `json:"groups"`
+	KnockoutRounds []WCKnockoutRound `json:"knockout_rounds"` // ordered R32/R16 → QF → SF → Final (bronze excluded)
+	BronzeFinal    *WCMatchup        `json:"bronze_final,omitempty"`
+	Champion       *Team             `json:"champion,omitempty"`
+	RunnerUp       *Team             `json:"runner_up,omitempty"`
+	TopScorers     []WCTopScorer     `json:"top_scorers,omitempty"`
 }
 
 // DeriveFinalists extracts champion and runner-up from the final matchup's WinnerID.
@@ -24,7 +24,7 @@ const LiveBatchSize = 4
 // fetchLiveBatchData fetches live matches for a batch of leagues concurrently.
 // batchIndex: 0, 1, 2, ... (each batch fetches LiveBatchSize leagues in parallel)
 // Results appear after each batch completes, giving progressive updates while being fast.
-func fetchLiveBatchData(parentCtx context.Context, client *fotmob.Client, useMockData bool, batchIndex int) tea.Cmd {
+func fetchLiveBatchData(parentCtx context.Context, client api.Client, useMockData bool, batchIndex int) tea.Cmd {
 	return func() tea.Msg {
 		totalLeagues := fotmob.TotalLeagues()
 		startIdx := batchIndex * LiveBatchSize
@@ -109,7 +109,7 @@ func fetchLiveBatchData(parentCtx context.Context, client *fotmob.Client, useMoc
 // This is used to keep the live matches list current while the user is in the view.
 // Fetches both live and upcoming matches so the upcoming section stays current
 // as matches transition from upcoming to live.
-func scheduleLiveRefresh(client *fotmob.Client, useMockData bool) tea.Cmd {
+func scheduleLiveRefresh(client api.Client, useMockData bool) tea.Cmd {
 	return tea.Tick(LiveRefreshInterval, func(t time.Time) tea.Msg {
 		if useMockData {
 			return liveRefreshMsg{matches: data.MockLiveMatches()}
@@ -138,7 +138,7 @@ func scheduleLiveRefresh(client *fotmob.Client, useMockData bool) tea.Cmd {
 // FotMob league-page cache before re-fetching. Wired to the user-initiated
 // "r" key in the live view so the user can pull fresh data when FotMob's
 // server-rendered page lags realtime around kickoff.
-func refreshLiveNow(client *fotmob.Client, useMockData bool) tea.Cmd {
+func refreshLiveNow(client api.Client, useMockData bool) tea.Cmd {
 	return func() tea.Msg {
 		if useMockData {
 			return liveRefreshMsg{matches: data.MockLiveMatches()}
@@ -148,7 +148,9 @@ func refreshLiveNow(client *fotmob.Client, useMockData bool) tea.Cmd {
 			return liveRefreshMsg{matches: nil}
 		}
 
-		client.Cache().ClearPages()
+		if fc, ok := client.(*fotmob.Client); ok {
+			fc.Cache().ClearPages()
+		}
 
 		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 		defer cancel()
@@ -164,7 +166,7 @@ func refreshLiveNow(client *fotmob.Client, useMockData bool) tea.Cmd {
 
 // fetchMatchDetails fetches match details from the API.
 // Returns mock data if useMockData is true, otherwise uses real API.
-func fetchMatchDetails(client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
+func fetchMatchDetails(client api.Client, matchID int, useMockData bool) tea.Cmd {
 	return func() tea.Msg {
 		if useMockData {
 			details, _ := data.MockMatchDetails(matchID)
@@ -185,7 +187,7 @@ func fetchMatchDetails(client *fotmob.Client, matchID int, useMockData bool) tea
 
 // fetchMatchDetailsForceRefresh fetches match details with cache bypass.
 // Forces fresh data from the API, ignoring any cached data.
-func fetchMatchDetailsForceRefresh(client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
+func fetchMatchDetailsForceRefresh(client api.Client, matchID int, useMockData bool) tea.Cmd {
 	return func() tea.Msg {
 		if useMockData {
 			details, _ := data.MockMatchDetails(matchID)
@@ -204,10 +206,13 @@ func fetchMatchDetailsForceRefresh(client *fotmob.Client, matchID int, useMockDa
 	}
 }
 
-// schedulePollTick schedules the next poll after 90 seconds.
+// LiveMatchPollInterval is the interval between match details polls for a live match.
+const LiveMatchPollInterval = 90 * time.Second
+
+// schedulePollTick schedules the next poll after LiveMatchPollInterval.
 // When the tick fires, it sends pollTickMsg which triggers the actual API call.
 func schedulePollTick(matchID, gen int) tea.Cmd {
-	return tea.Tick(90*time.Second, func(t time.Time) tea.Msg {
+	return tea.Tick(LiveMatchPollInterval, func(t time.Time) tea.Msg {
 		return pollTickMsg{matchID: matchID, gen: gen}
 	})
 }
@@ -225,7 +230,7 @@ func schedulePollSpinnerHide() tea.Cmd {
 // fetchPollMatchDetails fetches match details for a poll refresh.
 // This is called when pollTickMsg is received, with loading state visible.
 // Uses force refresh to bypass cache and ensure fresh data for live matches.
-func fetchPollMatchDetails(client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
+func fetchPollMatchDetails(client api.Client, matchID int, useMockData bool) tea.Cmd {
 	return func() tea.Msg {
 		if useMockData {
 			details, _ := data.MockMatchDetails(matchID)
@@ -249,7 +254,7 @@ func fetchPollMatchDetails(client *fotmob.Client, matchID int, useMockData bool)
 // dayIndex: 0 = today, 1 = yesterday, etc.
 // totalDays: total number of days to fetch (for isLast calculation)
 // This enables showing results immediately as each day's data arrives.
-func fetchStatsDayData(parentCtx context.Context, client *fotmob.Client, useMockData bool, dayIndex int, totalDays int) tea.Cmd {
+func fetchStatsDayData(parentCtx context.Context, client api.Client, useMockData bool, dayIndex int, totalDays int) tea.Cmd {
 	return func() tea.Msg {
 		isToday := dayIndex == 0
 		isLast := dayIndex == totalDays-1
@@ -339,7 +344,7 @@ func fetchStatsDayData(parentCtx context.Context, client *fotmob.Client, useMock
 }
 
 // fetchStatsMatchDetailsFotmob fetches match details from FotMob API for stats view.
-func fetchStatsMatchDetailsFotmob(client *fotmob.Client, matchID int, useMockData bool) tea.Cmd {
+func fetchStatsMatchDetailsFotmob(client api.Client, matchID int, useMockData bool) tea.Cmd {
 	return func() tea.Msg {
 		if useMockData {
 			details, _ := data.MockFinishedMatchDetails(matchID)
@@ -505,11 +510,27 @@ func buildGoalInfos(details *api.MatchDetails) []reddit.GoalInfo {
 	return goals
 }
 
+// fetchCommentary fetches a match's live commentary after sinceID ("" for all
+// of it). Mock data has no commentary.
+func fetchCommentary(client api.Client, matchID int, sinceID string, useMockData bool) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData || client == nil {
+			return commentaryMsg{matchID: matchID, sinceID: sinceID}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		entries, err := client.Commentary(ctx, matchID, sinceID)
+		return commentaryMsg{matchID: matchID, sinceID: sinceID, entries: entries, err: err}
+	}
+}
+
 // fetchStandings fetches league standings for a specific league.
 // Used to populate the standings dialog.
 // parentLeagueID is used for multi-season leagues (e.g., Liga MX Clausura -> Liga MX)
 // where the sub-league ID has no standings but the parent league does.
-func fetchStandings(client *fotmob.Client, leagueID int, leagueName string, parentLeagueID int, homeTeamID, awayTeamID int) tea.Cmd {
+func fetchStandings(client api.Client, leagueID int, leagueName string, parentLeagueID int, homeTeamID, awayTeamID int) tea.Cmd {
 	return func() tea.Msg {
 		if client == nil {
 			return standingsMsg{leagueID: leagueID, standings: nil}
@@ -532,3 +553,58 @@ func fetchStandings(client *fotmob.Client, leagueID int, leagueName string, pare
 		}
 	}
 }
+
+// fetchTeam fetches a team overview for the team dialog. Only FotMob serves
+// team pages; other providers report api.ErrUnsupported.
+func fetchTeam(client api.Client, teamID int, useMockData bool) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			return teamMsg{teamID: teamID, overview: data.MockTeamOverview(teamID)}
+		}
+		fc, ok := client.(*fotmob.Client)
+		if !ok {
+			return teamMsg{teamID: teamID, err: fmt.Errorf("team: %w", api.ErrUnsupported)}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		overview, err := fc.Team(ctx, teamID)
+		return teamMsg{teamID: teamID, overview: overview, err: err}
+	}
+}
+
+// fetchLeagueStats fetches one of a league's player stat lists (see
+// api.TopStats) for the current season.
+func fetchLeagueStats(client api.Client, league api.League, stat string, useMockData bool) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			return leagueStatsMsg{league: league, stat: stat, entries: data.MockLeagueTopStats(league.ID, stat)}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		entries, err := client.LeagueTopStats(ctx, league.ID, "", stat)
+		return leagueStatsMsg{league: league, stat: stat, entries: entries, err: err}
+	}
+}
+
+// fetchHeadToHead fetches the head-to-head record of an upcoming match from
+// its match details.
+func fetchHeadToHead(client api.Client, match api.Match, useMockData bool) tea.Cmd {
+	return func() tea.Msg {
+		if useMockData {
+			return headToHeadMsg{match: match, h2h: data.MockHeadToHead(match), upcoming: true}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		details, err := client.MatchDetails(ctx, match.ID)
+		if err != nil {
+			return headToHeadMsg{match: match, upcoming: true, err: err}
+		}
+		return headToHeadMsg{match: match, h2h: details.HeadToHead, upcoming: true}
+	}
+}
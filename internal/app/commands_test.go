@@ -7,7 +7,10 @@ import (
 	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
 	"github.com/0xjuanma/golazo/internal/reddit"
+	"github.com/0xjuanma/golazo/internal/ui"
+	tea "github.com/charmbracelet/bubbletea"
 )
 
 // testLogger returns a slog.Logger that discards output, matching the
@@ -213,3 +216,159 @@ func TestHandleGoalLinkNotFoundIsRecorded(t *testing.T) {
 		t.Errorf("expected nil-link sentinel entry in goalLinks for %+v", key)
 	}
 }
+
+// TestHandleCommentaryAppendsNewEntries covers the incremental feed: entries
+// for the open match are appended once, and a refetch that returns the whole
+// feed again (sinceID no longer present) does not duplicate them.
+func TestHandleCommentaryAppendsNewEntries(t *testing.T) {
+	m := model{
+		matchDetails:   &api.MatchDetails{Match: api.Match{ID: 7}},
+		showCommentary: true,
+		useMockData:    true,
+		logger:         testLogger(),
+	}
+	first := []api.CommentaryEntry{{ID: "a", Text: "Kick-off."}, {ID: "b", Minute: 3, Text: "Shot."}}
+
+	next, _ := m.handleCommentary(commentaryMsg{matchID: 7, entries: first})
+	m = next.(model)
+	next, _ = m.handleCommentary(commentaryMsg{matchID: 7, sinceID: "gone", entries: append(first, api.CommentaryEntry{ID: "c", Minute: 9, Text: "Goal!"})})
+	m = next.(model)
+	if len(m.commentary) != 3 || m.commentary[2].ID != "c" || m.commentaryMatchID != 7 {
+		t.Fatalf("commentary = %+v, want a, b, c once each", m.commentary)
+	}
+
+	msg := m.commentaryCmd()().(commentaryMsg)
+	if msg.matchID != 7 || msg.sinceID != "c" {
+		t.Errorf("next fetch = match %d since %q, want match 7 since \"c\"", msg.matchID, msg.sinceID)
+	}
+
+	next, _ = m.handleCommentary(commentaryMsg{matchID: 8, entries: first})
+	if got := next.(model).commentary; len(got) != 3 {
+		t.Errorf("commentary for another match was applied: %+v", got)
+	}
+}
+
+// TestTeamCmdOpensTeamDialog covers the t/T keys' path: the away team is
+// fetched (mock data here) and its overview opens the team dialog; a failed
+// fetch only sets the error hint.
+func TestTeamCmdOpensTeamDialog(t *testing.T) {
+	m := model{
+		matchDetails: &api.MatchDetails{Match: api.Match{
+			ID:       1001,
+			HomeTeam: api.Team{ID: 50, Name: "Manchester City"},
+			AwayTeam: api.Team{ID: 42, Name: "Arsenal"},
+		}},
+		useMockData:   true,
+		dialogOverlay: ui.NewDialogOverlay(),
+		logger:        testLogger(),
+	}
+
+	msg := m.teamCmd(false)().(teamMsg)
+	if msg.teamID != 42 || msg.overview == nil || msg.overview.Team.Name != "Arsenal" {
+		t.Fatalf("away team msg = %+v", msg)
+	}
+	next, _ := m.handleTeam(msg)
+	if !next.(model).dialogOverlay.ContainsDialog(ui.TeamDialogID) {
+		t.Error("team dialog not opened")
+	}
+
+	next, _ = m.handleTeam(teamMsg{teamID: 1, err: api.ErrUnsupported})
+	if got := next.(model).lastError; got != constants.ErrorNoTeam {
+		t.Errorf("lastError = %q, want %q", got, constants.ErrorNoTeam)
+	}
+}
+
+// TestDialogOpenActionStacksPlayerDialog covers Enter in the formations
+// dialog: the player dialog opens on top and Esc returns to formations.
+func TestDialogOpenActionStacksPlayerDialog(t *testing.T) {
+	m := model{dialogOverlay: ui.NewDialogOverlay(), logger: testLogger()}
+	m.dialogOverlay.OpenDialog(ui.NewFormationsDialog("Man City", "Arsenal", "4-3-3", "4-2-3-1",
+		[]api.PlayerInfo{{ID: 1, Name: "Ederson"}}, nil))
+
+	next, _ := m.handleKeyPress(tea.KeyMsg{Type: tea.KeyEnter})
+	m = next.(model)
+	if front := m.dialogOverlay.FrontDialog(); front == nil || front.ID() != ui.PlayerDialogID {
+		t.Fatalf("front dialog = %v, want the player dialog", front)
+	}
+	next, _ = m.handleKeyPress(tea.KeyMsg{Type: tea.KeyEsc})
+	m = next.(model)
+	if front := m.dialogOverlay.FrontDialog(); front == nil || front.ID() != ui.FormationsDialogID {
+		t.Errorf("after Esc front dialog = %v, want formations", front)
+	}
+}
+
+func TestHeadToHeadUpcomingCyclesMatches(t *testing.T) {
+	city := api.Team{ID: 50, Name: "Manchester City"}
+	arsenal := api.Team{ID: 42, Name: "Arsenal"}
+	chelsea := api.Team{ID: 49, Name: "Chelsea"}
+	m := model{dialogOverlay: ui.NewDialogOverlay(), logger: testLogger(), useMockData: true, currentView: viewLiveMatches}
+	m.liveUpcomingMatches = []ui.MatchDisplay{
+		{Match: api.Match{ID: 1, HomeTeam: city, AwayTeam: arsenal}},
+		{Match: api.Match{ID: 2, HomeTeam: chelsea, AwayTeam: city}},
+	}
+
+	open := func(cmd tea.Cmd) headToHeadMsg {
+		t.Helper()
+		if cmd == nil {
+			t.Fatal("expected a head-to-head fetch")
+		}
+		msg := cmd().(headToHeadMsg)
+		next, _ := m.Update(msg)
+		m = next.(model)
+		return msg
+	}
+
+	next, cmd := m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("V")})
+	m = next.(model)
+	if msg := open(cmd); msg.match.ID != 1 {
+		t.Errorf("V opened match %d, want 1", msg.match.ID)
+	}
+	if front := m.dialogOverlay.FrontDialog(); front == nil || front.ID() != ui.HeadToHeadDialogID {
+		t.Fatalf("front dialog = %v, want head-to-head", front)
+	}
+
+	// V inside the dialog replaces it with the next upcoming match, then wraps.
+	for _, want := range []int{2, 1} {
+		next, cmd = m.handleKeyPress(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("V")})
+		m = next.(model)
+		if msg := open(cmd); msg.match.ID != want {
+			t.Errorf("next opened match %d, want %d", msg.match.ID, want)
+		}
+	}
+	m.dialogOverlay.CloseFrontDialog()
+	if m.dialogOverlay.HasDialogs() {
+		t.Error("head-to-head dialogs stacked up instead of replacing each other")
+	}
+}
+
+func TestLeagueStatsDialogSwitchesStat(t *testing.T) {
+	m := model{dialogOverlay: ui.NewDialogOverlay(), logger: testLogger(), useMockData: true, currentView: viewLiveMatches}
+	m.matchDetails = &api.MatchDetails{Match: api.Match{ID: 1, League: api.League{ID: 47, Name: "Premier League"}}}
+
+	press := func(key tea.KeyMsg) leagueStatsMsg {
+		t.Helper()
+		next, cmd := m.handleKeyPress(key)
+		m = next.(model)
+		if cmd == nil {
+			t.Fatalf("%s: expected a league stats fetch", key)
+		}
+		msg := cmd().(leagueStatsMsg)
+		next, _ = m.Update(msg)
+		m = next.(model)
+		return msg
+	}
+
+	if msg := press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")}); msg.stat != api.StatGoals || msg.league.ID != 47 {
+		t.Errorf("p fetched %s of league %d, want goals of 47", msg.stat, msg.league.ID)
+	}
+	if front := m.dialogOverlay.FrontDialog(); front == nil || front.ID() != ui.TopScorersDialogID {
+		t.Fatalf("front dialog = %v, want league stats", front)
+	}
+	if msg := press(tea.KeyMsg{Type: tea.KeyTab}); msg.stat != api.StatAssists || len(msg.entries) == 0 {
+		t.Errorf("Tab fetched %s (%d entries), want assists", msg.stat, len(msg.entries))
+	}
+	m.dialogOverlay.CloseFrontDialog()
+	if m.dialogOverlay.HasDialogs() {
+		t.Error("league stats dialogs stacked up instead of replacing each other")
+	}
+}
@@ -52,7 +52,7 @@ func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				return m, fetchWorldCupMockData(m.wcYear)
 			}
 			m.debugLog(fmt.Sprintf("fetchWorldCupData: year=%q", m.wcYear))
-			return m, fetchWorldCupData(m.loadCtx, m.fotmobClient, m.wcYear)
+			return m, fetchWorldCupData(m.loadCtx, m.client, m.wcYear)
 		}
 
 		m.mainViewLoading = true
@@ -92,7 +92,7 @@ func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.statsMatchesList.SetItems([]list.Item{}) // Clear list
 			cmds = append(cmds, ui.SpinnerTick())
 			// Start fetching day 0 (today) first - results shown immediately when it completes
-			cmds = append(cmds, fetchStatsDayData(m.loadCtx, m.fotmobClient, m.useMockData, 0, fotmob.StatsDataDays))
+			cmds = append(cmds, fetchStatsDayData(m.loadCtx, m.client, m.useMockData, 0, fotmob.StatsDataDays))
 		case 1: // Live Matches view - preload live matches progressively (parallel batches)
 			m.liveViewLoading = true
 			m.loading = true
@@ -102,10 +102,11 @@ func (m model) handleMainViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.liveMatchesBuffer = nil                                               // Clear buffer
 			m.liveUpcomingBuffer = nil                                              // Clear upcoming buffer
 			m.liveUpcomingMatches = nil                                             // Clear upcoming display
+			m.h2hUpcomingIndex = 0
 			m.liveMatchesList.SetItems([]list.Item{})
 			cmds = append(cmds, ui.SpinnerTick())
 			// Start fetching batch 0 (4 leagues in parallel) - results shown when batch completes
-			cmds = append(cmds, fetchLiveBatchData(m.loadCtx, m.fotmobClient, m.useMockData, 0))
+			cmds = append(cmds, fetchLiveBatchData(m.loadCtx, m.client, m.useMockData, 0))
 		}
 
 		return m, tea.Batch(cmds...)
@@ -172,7 +173,7 @@ func (m model) handleStatsViewKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.loadCancel()
 	}
 	m.loadCtx, m.loadCancel = context.WithCancel(context.Background())
-	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchStatsDayData(m.loadCtx, m.fotmobClient, m.useMockData, 0, fotmob.StatsDataDays))
+	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchStatsDayData(m.loadCtx, m.client, m.useMockData, 0, fotmob.StatsDataDays))
 }
 
 // loadMatchDetails loads match details for the live matches view.
@@ -195,9 +196,9 @@ func (m model) loadMatchDetailsWithRefresh(matchID int, forceRefresh bool) (tea.
 
 	var cmd tea.Cmd
 	if forceRefresh {
-		cmd = fetchMatchDetailsForceRefresh(m.fotmobClient, matchID, m.useMockData)
+		cmd = fetchMatchDetailsForceRefresh(m.client, matchID, m.useMockData)
 	} else {
-		cmd = fetchMatchDetails(m.fotmobClient, matchID, m.useMockData)
+		cmd = fetchMatchDetails(m.client, matchID, m.useMockData)
 	}
 
 	if chainAlive {
@@ -233,7 +234,7 @@ func (m model) loadStatsMatchDetailsWithRefresh(matchID int, forceRefresh bool)
 	m.loading = true
 	m.statsViewLoading = true
 	m.debugLog(fmt.Sprintf("Fetching match details from API for ID: %d", matchID))
-	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchStatsMatchDetailsFotmob(m.fotmobClient, matchID, m.useMockData))
+	return m, tea.Batch(m.spinner.Tick, ui.SpinnerTick(), fetchStatsMatchDetailsFotmob(m.client, matchID, m.useMockData))
 }
 
 // handleSettingsViewKeys processes keyboard input for the settings view.
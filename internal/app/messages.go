@@ -96,6 +96,15 @@ type goalLinksDoneMsg struct {
 	matchID int
 }
 
+// commentaryMsg contains live commentary entries fetched after sinceID
+// ("" for the whole feed).
+type commentaryMsg struct {
+	matchID int
+	sinceID string
+	entries []api.CommentaryEntry
+	err     error
+}
+
 // standingsMsg contains league standings from API response.
 // Used to populate the standings dialog.
 type standingsMsg struct {
@@ -106,6 +115,32 @@ type standingsMsg struct {
 	awayTeamID int
 }
 
+// teamMsg contains a team overview for the team dialog.
+type teamMsg struct {
+	teamID   int
+	overview *api.TeamOverview
+	err      error
+}
+
+// headToHeadMsg contains a match's head-to-head record for the head-to-head
+// dialog. upcoming is set when it was fetched for the live view's upcoming
+// list.
+type headToHeadMsg struct {
+	match    api.Match
+	h2h      *api.HeadToHead
+	upcoming bool
+	err      error
+}
+
+// leagueStatsMsg contains a league's player stat list for the league stats
+// dialog.
+type leagueStatsMsg struct {
+	league  api.League
+	stat    string
+	entries []api.LeagueStatEntry
+	err     error
+}
+
 // wcDataMsg contains World Cup data fetched from FotMob or mock.
 type wcDataMsg struct {
 	data *api.WorldCupData
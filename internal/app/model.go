@@ -14,6 +14,7 @@ import (
 	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/fotmob"
 	"github.com/0xjuanma/golazo/internal/notify"
+	"github.com/0xjuanma/golazo/internal/provider"
 	"github.com/0xjuanma/golazo/internal/reddit"
 	"github.com/0xjuanma/golazo/internal/ui"
 	"github.com/0xjuanma/golazo/internal/ui/logo"
@@ -70,9 +71,13 @@ type model struct {
 	matches             []ui.MatchDisplay
 	upcomingMatches     []ui.MatchDisplay // Upcoming matches for 1-day stats view (deprecated, kept for compatibility)
 	liveUpcomingMatches []ui.MatchDisplay // Upcoming matches for live view (shown at bottom of left panel)
+	h2hUpcomingIndex    int               // Upcoming match whose head-to-head V opens next
 	matchDetails        *api.MatchDetails
 	matchDetailsCache   map[int]*api.MatchDetails // Cache to avoid repeated API calls
 	liveUpdates         []string
+	commentary          []api.CommentaryEntry // Live commentary for commentaryMatchID, in match order
+	commentaryMatchID   int
+	showCommentary      bool // Live panel shows the commentary feed instead of events (c)
 	lastEvents          []api.MatchEvent
 	lastHomeScore       int // Track last known home score for goal notifications
 	lastAwayScore       int // Track last known away score for goal notifications
@@ -155,7 +160,7 @@ type model struct {
 	standingsCache map[int]*standingsCacheEntry
 
 	// API clients
-	fotmobClient *fotmob.Client
+	client       api.Client
 	parser       *fotmob.LiveUpdateParser
 	redditClient *reddit.Client
 
@@ -186,7 +191,9 @@ type model struct {
 // isDevBuild indicates if this is a development build.
 // newVersionAvailable indicates if a newer version is available.
 // appVersion is the current application version string.
-func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable bool, appVersion string, wcYear string) model {
+// providerName selects the data source (see package provider); an empty
+// name uses the default.
+func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable bool, appVersion string, wcYear string, providerName string) model {
 	// Initialize structured logger
 	logger, logFile := initLogger(debugMode)
 
@@ -287,7 +294,7 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 		newVersionAvailable:    newVersionAvailable,
 		appVersion:             appVersion,
 		wcYear:               wcYear,
-		fotmobClient:           newFotmobClient(logger),
+		client:                 newClient(providerName, logger),
 		parser:                 fotmob.NewLiveUpdateParser(),
 		redditClient:           redditClient,
 		goalLinks:              make(map[reddit.GoalLinkKey]*reddit.GoalLink),
@@ -313,10 +320,14 @@ func New(useMockData bool, debugMode bool, isDevBuild bool, newVersionAvailable
 	}
 }
 
-// newFotmobClient creates a FotMob client and wires the debug logger.
-func newFotmobClient(logger *slog.Logger) *fotmob.Client {
-	c := fotmob.NewClient()
-	c.SetLogger(logger)
+// newClient creates the data provider's client and wires the debug logger.
+// Falls back to FotMob if the provider cannot be built.
+func newClient(providerName string, logger *slog.Logger) api.Client {
+	c, err := provider.New(providerName, logger)
+	if err != nil {
+		logger.Warn("data provider unavailable, using default", "provider", providerName, "error", err)
+		c, _ = provider.New(provider.Default, logger)
+	}
 	return c
 }
 
@@ -78,6 +78,18 @@ func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case standingsMsg:
 		return m.handleStandings(msg)
 
+	case commentaryMsg:
+		return m.handleCommentary(msg)
+
+	case teamMsg:
+		return m.handleTeam(msg)
+
+	case headToHeadMsg:
+		return m.handleHeadToHead(msg)
+
+	case leagueStatsMsg:
+		return m.handleLeagueStats(msg)
+
 	case wcDataMsg:
 		return m.handleWCData(msg)
 
@@ -285,6 +297,11 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 		m.liveUpdates = m.parser.ParseEvents(msg.details.Events, msg.details.HomeTeam, msg.details.AwayTeam)
 		m.lastEvents = msg.details.Events
 
+		// Keep the commentary feed in step with each load and poll
+		if cmd := m.commentaryCmd(); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+
 		// Continue polling if match is live
 		if msg.details.Status == api.MatchStatusLive {
 			// For initial load, clear loading state
@@ -315,9 +332,16 @@ func (m model) handleMatchDetails(msg matchDetailsMsg) (tea.Model, tea.Cmd) {
 func (m model) handleKeyPress(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 	// If dialog overlay has active dialogs, route messages there first
 	if m.dialogOverlay != nil && m.dialogOverlay.HasDialogs() {
-		action := m.dialogOverlay.Update(msg)
-		if _, ok := action.(ui.DialogActionClose); ok {
+		switch action := m.dialogOverlay.Update(msg).(type) {
+		case ui.DialogActionClose:
+			m.dialogOverlay.CloseFrontDialog()
+		case ui.DialogActionOpen:
+			m.dialogOverlay.OpenDialog(action.Dialog)
+		case ui.DialogActionNext:
 			m.dialogOverlay.CloseFrontDialog()
+			return m, m.upcomingHeadToHeadCmd()
+		case ui.DialogActionSwitchStat:
+			return m, fetchLeagueStats(m.client, action.League, action.Stat, m.useMockData)
 		}
 		return m, nil
 	}
@@ -395,6 +419,8 @@ func (m model) resetToMainView() (tea.Model, tea.Cmd) {
 	m.matchDetails = nil
 	m.matchDetailsCache = make(map[int]*api.MatchDetails)
 	m.liveUpdates = nil
+	m.commentary = nil
+	m.commentaryMatchID = 0
 	m.lastEvents = nil
 	m.lastHomeScore = 0
 	m.lastAwayScore = 0
@@ -419,6 +445,27 @@ func (m model) handleLiveMatchesSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			m.openStatisticsDialog()
 			return m, nil
 		}
+		if msg.String() == "c" {
+			m.showCommentary = !m.showCommentary
+			return m, m.commentaryCmd()
+		}
+		if msg.String() == "t" || msg.String() == "T" {
+			return m, m.teamCmd(msg.String() == "t")
+		}
+		if msg.String() == "v" {
+			m.openHeadToHeadDialog()
+			return m, nil
+		}
+		if msg.String() == "p" {
+			return m, m.leagueStatsCmd()
+		}
+		if msg.String() == "V" {
+			if len(m.liveUpcomingMatches) == 0 {
+				m.lastError = constants.ErrorNoUpcoming
+				return m, nil
+			}
+			return m, m.upcomingHeadToHeadCmd()
+		}
 		if msg.String() == "s" && m.matchDetails != nil {
 			leagueID := m.matchDetails.League.ID
 			if entry, ok := m.standingsCache[leagueID]; ok && time.Since(entry.fetchedAt) < 5*time.Minute {
@@ -427,7 +474,7 @@ func (m model) handleLiveMatchesSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 				return m, nil
 			}
 			return m, fetchStandings(
-				m.fotmobClient,
+				m.client,
 				leagueID,
 				m.matchDetails.League.Name,
 				m.matchDetails.League.ParentLeagueID,
@@ -491,7 +538,7 @@ func (m model) handleLiveMatchesSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			return m.loadMatchDetailsWithRefresh(m.matchDetails.ID, true)
 		}
 		m.debugLog("Forcing live list refresh (clearing page-body cache)")
-		return m, refreshLiveNow(m.fotmobClient, m.useMockData)
+		return m, refreshLiveNow(m.client, m.useMockData)
 	}
 
 	return m, listCmd
@@ -552,7 +599,7 @@ func (m model) handleStatsSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			// Fetch standings and open dialog
 			if m.matchDetails != nil {
 				return m, fetchStandings(
-					m.fotmobClient,
+					m.client,
 					m.matchDetails.League.ID,
 					m.matchDetails.League.Name,
 					m.matchDetails.League.ParentLeagueID,
@@ -565,6 +612,16 @@ func (m model) handleStatsSelection(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 			// Open full statistics dialog
 			m.openStatisticsDialog()
 			return m, nil
+		case "t", "T":
+			// Fetch the home (t) or away (T) team and open its dialog
+			return m, m.teamCmd(msg.String() == "t")
+		case "v":
+			// Open head-to-head dialog
+			m.openHeadToHeadDialog()
+			return m, nil
+		case "p":
+			// Fetch the league's top scorers and open the league stats dialog
+			return m, m.leagueStatsCmd()
 		}
 	}
 
@@ -642,7 +699,7 @@ func (m model) handleLiveMatches(msg liveMatchesMsg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	// Schedule the next refresh (5-min timer)
-	cmds = append(cmds, scheduleLiveRefresh(m.fotmobClient, m.useMockData))
+	cmds = append(cmds, scheduleLiveRefresh(m.client, m.useMockData))
 
 	if len(msg.matches) == 0 {
 		m.liveViewLoading = false
@@ -689,7 +746,7 @@ func (m model) handleLiveRefresh(msg liveRefreshMsg) (tea.Model, tea.Cmd) {
 	var cmds []tea.Cmd
 
 	// Schedule the next refresh
-	cmds = append(cmds, scheduleLiveRefresh(m.fotmobClient, m.useMockData))
+	cmds = append(cmds, scheduleLiveRefresh(m.client, m.useMockData))
 	// Update upcoming matches
 	upcomingDisplay := make([]ui.MatchDisplay, 0, len(msg.upcoming))
 	for _, match := range msg.upcoming {
@@ -806,14 +863,14 @@ func (m model) handleLiveBatchData(msg liveBatchDataMsg) (tea.Model, tea.Cmd) {
 		}
 
 		// Schedule periodic refresh
-		cmds = append(cmds, scheduleLiveRefresh(m.fotmobClient, m.useMockData))
+		cmds = append(cmds, scheduleLiveRefresh(m.client, m.useMockData))
 
 		return m, tea.Batch(cmds...)
 	}
 
 	// Otherwise, fetch next batch
 	nextBatchIndex := msg.batchIndex + 1
-	cmds = append(cmds, fetchLiveBatchData(m.loadCtx, m.fotmobClient, m.useMockData, nextBatchIndex))
+	cmds = append(cmds, fetchLiveBatchData(m.loadCtx, m.client, m.useMockData, nextBatchIndex))
 
 	return m, tea.Batch(cmds...)
 }
@@ -993,7 +1050,7 @@ func (m model) handleStatsDayData(msg statsDayDataMsg) (tea.Model, tea.Cmd) {
 
 	// Otherwise, fetch next day
 	nextDayIndex := msg.dayIndex + 1
-	cmds = append(cmds, fetchStatsDayData(m.loadCtx, m.fotmobClient, m.useMockData, nextDayIndex, m.statsTotalDays))
+	cmds = append(cmds, fetchStatsDayData(m.loadCtx, m.client, m.useMockData, nextDayIndex, m.statsTotalDays))
 
 	return m, tea.Batch(cmds...)
 }
@@ -1178,7 +1235,7 @@ func (m model) handlePollTick(msg pollTickMsg) (tea.Model, tea.Cmd) {
 	// Start the actual API call, spinner animation, and 1s display timer
 	// Also check for any new goals that might have been scored since last poll
 	return m, tea.Batch(
-		fetchPollMatchDetails(m.fotmobClient, msg.matchID, m.useMockData),
+		fetchPollMatchDetails(m.client, msg.matchID, m.useMockData),
 		schedulePollSpinnerHide(), // Hide spinner after 0.5 seconds
 	)
 }
@@ -1397,6 +1454,48 @@ func (m *model) openFormationsDialog() {
 	m.dialogOverlay.OpenDialog(dialog)
 }
 
+// commentaryCmd fetches commentary for the open match while the feed is
+// shown, continuing after the newest entry already held.
+func (m model) commentaryCmd() tea.Cmd {
+	if !m.showCommentary || m.matchDetails == nil {
+		return nil
+	}
+	sinceID := ""
+	if m.commentaryMatchID == m.matchDetails.ID && len(m.commentary) > 0 {
+		sinceID = m.commentary[len(m.commentary)-1].ID
+	}
+	return fetchCommentary(m.client, m.matchDetails.ID, sinceID, m.useMockData)
+}
+
+// handleCommentary appends newly fetched commentary for the open match.
+// Entries already held are skipped, since a sinceID that dropped out of the
+// feed returns it whole.
+func (m model) handleCommentary(msg commentaryMsg) (tea.Model, tea.Cmd) {
+	if m.matchDetails == nil || msg.matchID != m.matchDetails.ID {
+		return m, nil
+	}
+	if msg.err != nil {
+		m.debugLog(fmt.Sprintf("handleCommentary: match %d: %v", msg.matchID, msg.err))
+		return m, nil
+	}
+	if m.commentaryMatchID != msg.matchID {
+		m.commentary = nil
+		m.commentaryMatchID = msg.matchID
+	}
+
+	seen := make(map[string]bool, len(m.commentary))
+	for _, e := range m.commentary {
+		seen[e.ID] = true
+	}
+	for _, e := range msg.entries {
+		if !seen[e.ID] {
+			m.commentary = append(m.commentary, e)
+			seen[e.ID] = true
+		}
+	}
+	return m, nil
+}
+
 // handleStandings processes standings data and opens the standings dialog.
 func (m model) handleStandings(msg standingsMsg) (tea.Model, tea.Cmd) {
 	m.debugLog(fmt.Sprintf("handleStandings: received msg with %d standings, leagueID=%d, leagueName=%s",
@@ -1432,6 +1531,103 @@ func (m model) handleStandings(msg standingsMsg) (tea.Model, tea.Cmd) {
 	return m, nil
 }
 
+// teamCmd fetches the current match's home or away team for the team dialog.
+func (m model) teamCmd(home bool) tea.Cmd {
+	if m.matchDetails == nil {
+		return nil
+	}
+	team := m.matchDetails.AwayTeam
+	if home {
+		team = m.matchDetails.HomeTeam
+	}
+	return fetchTeam(m.client, team.ID, m.useMockData)
+}
+
+// handleTeam opens the team dialog with a fetched team overview.
+func (m model) handleTeam(msg teamMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil || msg.overview == nil {
+		m.debugLog(fmt.Sprintf("handleTeam: no overview for team %d: %v", msg.teamID, msg.err))
+		m.lastError = constants.ErrorNoTeam
+		return m, nil
+	}
+	if m.dialogOverlay == nil {
+		return m, nil
+	}
+	m.dialogOverlay.OpenDialog(ui.NewTeamDialog(msg.overview))
+	return m, nil
+}
+
+// openHeadToHeadDialog opens the head-to-head dialog for the current match.
+func (m *model) openHeadToHeadDialog() {
+	if m.matchDetails == nil || m.dialogOverlay == nil {
+		return
+	}
+	if m.matchDetails.HeadToHead == nil {
+		m.lastError = constants.ErrorNoHeadToHead
+		return
+	}
+	m.dialogOverlay.OpenDialog(ui.NewHeadToHeadDialog(m.matchDetails.Match, m.matchDetails.HeadToHead, false))
+}
+
+// upcomingHeadToHeadCmd fetches the head-to-head of the next upcoming match
+// in the live view, wrapping around at the end of the list.
+func (m *model) upcomingHeadToHeadCmd() tea.Cmd {
+	if len(m.liveUpcomingMatches) == 0 {
+		return nil
+	}
+	if m.h2hUpcomingIndex >= len(m.liveUpcomingMatches) {
+		m.h2hUpcomingIndex = 0
+	}
+	match := m.liveUpcomingMatches[m.h2hUpcomingIndex].Match
+	m.h2hUpcomingIndex++
+	return fetchHeadToHead(m.client, match, m.useMockData)
+}
+
+// handleHeadToHead opens the head-to-head dialog for an upcoming match.
+func (m model) handleHeadToHead(msg headToHeadMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil || msg.h2h == nil {
+		m.debugLog(fmt.Sprintf("handleHeadToHead: no head-to-head for match %d: %v", msg.match.ID, msg.err))
+		m.lastError = constants.ErrorNoHeadToHead
+		return m, nil
+	}
+	if m.dialogOverlay == nil {
+		return m, nil
+	}
+	m.dialogOverlay.OpenDialog(ui.NewHeadToHeadDialog(msg.match, msg.h2h, msg.upcoming))
+	return m, nil
+}
+
+// leagueStatsCmd fetches the top scorers of the current match's league,
+// preferring the parent league of sub-season leagues, which owns the stats.
+func (m model) leagueStatsCmd() tea.Cmd {
+	if m.matchDetails == nil {
+		return nil
+	}
+	league := m.matchDetails.League
+	if league.ParentLeagueID > 0 {
+		league.ID = league.ParentLeagueID
+	}
+	return fetchLeagueStats(m.client, league, api.StatGoals, m.useMockData)
+}
+
+// handleLeagueStats opens the league stats dialog, replacing the one whose
+// stat was switched.
+func (m model) handleLeagueStats(msg leagueStatsMsg) (tea.Model, tea.Cmd) {
+	if msg.err != nil {
+		m.debugLog(fmt.Sprintf("handleLeagueStats: no %s for league %d: %v", msg.stat, msg.league.ID, msg.err))
+		m.lastError = constants.ErrorNoTopStats
+		return m, nil
+	}
+	if m.dialogOverlay == nil {
+		return m, nil
+	}
+	if front := m.dialogOverlay.FrontDialog(); front != nil && front.ID() == ui.TopScorersDialogID {
+		m.dialogOverlay.CloseFrontDialog()
+	}
+	m.dialogOverlay.OpenDialog(ui.NewLeagueStatsDialog(msg.league, msg.stat, msg.entries))
+	return m, nil
+}
+
 // openStatisticsDialog opens the full statistics dialog for the current match.
 func (m *model) openStatisticsDialog() {
 	if m.matchDetails == nil || m.dialogOverlay == nil {
@@ -31,6 +31,8 @@ func (m model) View() string {
 			m.liveMatchesList,
 			m.matchDetails,
 			m.liveUpdates,
+			m.commentary,
+			m.showCommentary,
 			m.spinner,
 			m.loading,
 			m.randomSpinner,
@@ -8,7 +8,6 @@ import (
 
 	"github.com/0xjuanma/golazo/internal/api"
 	"github.com/0xjuanma/golazo/internal/data"
-	"github.com/0xjuanma/golazo/internal/fotmob"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -30,7 +29,7 @@ func fetchWorldCupMockData(season string) tea.Cmd {
 
 // fetchWorldCupData fetches live World Cup data from FotMob.
 // season is passed to the API (e.g. "2026", "2022"); "" means current/latest.
-func fetchWorldCupData(parentCtx context.Context, client *fotmob.Client, season string) tea.Cmd {
+func fetchWorldCupData(parentCtx context.Context, client api.Client, season string) tea.Cmd {
 	return func() tea.Msg {
 		if client == nil {
 			return wcDataMsg{data: data.MockWorldCupData()}
@@ -54,7 +53,7 @@ func fetchWorldCupData(parentCtx context.Context, client *fotmob.Client, season
 // Returns matches sorted ascending by kickoff time with duplicates removed.
 //
 // Falls back to MockWorldCupUpcoming when client is nil.
-func fetchWCUpcomingMatches(parentCtx context.Context, client *fotmob.Client) ([]api.Match, error) {
+func fetchWCUpcomingMatches(parentCtx context.Context, client api.Client) ([]api.Match, error) {
 	if client == nil {
 		return data.MockWorldCupUpcoming(), nil
 	}
@@ -114,7 +113,7 @@ func sortAndDedupeWCUpcoming(matches []api.Match) []api.Match {
 // fetchWorldCupUpcoming wraps fetchWCUpcomingMatches as a tea.Cmd, emitting a
 // wcUpcomingMsg with the results (or error). Falls back to the mock when
 // client is nil.
-func fetchWorldCupUpcoming(parentCtx context.Context, client *fotmob.Client) tea.Cmd {
+func fetchWorldCupUpcoming(parentCtx context.Context, client api.Client) tea.Cmd {
 	return func() tea.Msg {
 		matches, err := fetchWCUpcomingMatches(parentCtx, client)
 		if err != nil {
@@ -126,7 +125,7 @@ func fetchWorldCupUpcoming(parentCtx context.Context, client *fotmob.Client) tea
 
 // fetchWorldCupTopScorers fetches the current WC top scorers from FotMob and
 // emits a wcTopScorersMsg. Falls back to nil scorers when client is nil.
-func fetchWorldCupTopScorers(parentCtx context.Context, client *fotmob.Client, season string) tea.Cmd {
+func fetchWorldCupTopScorers(parentCtx context.Context, client api.Client, season string) tea.Cmd {
 	return func() tea.Msg {
 		if client == nil {
 			return wcTopScorersMsg{}
@@ -65,7 +65,7 @@ func (m model) handleWCGroupsKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.wcSubView = wcSubViewUpcoming
 		m.wcUpcomingLoading = true
 		m.wcUpcomingLastError = ""
-		return m, tea.Batch(tea.ClearScreen, fetchWorldCupUpcoming(m.loadCtx, m.fotmobClient))
+		return m, tea.Batch(tea.ClearScreen, fetchWorldCupUpcoming(m.loadCtx, m.client))
 
 	case "s":
 		return m.openTopScorersDialog()
@@ -97,7 +97,7 @@ func (m model) handleWCBracketKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.wcSubView = wcSubViewUpcoming
 		m.wcUpcomingLoading = true
 		m.wcUpcomingLastError = ""
-		return m, tea.Batch(tea.ClearScreen, fetchWorldCupUpcoming(m.loadCtx, m.fotmobClient))
+		return m, tea.Batch(tea.ClearScreen, fetchWorldCupUpcoming(m.loadCtx, m.client))
 
 	case "s":
 		return m.openTopScorersDialog()
@@ -149,7 +149,7 @@ func (m model) handleWCGroupGridKeys(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
 		m.wcSubView = wcSubViewUpcoming
 		m.wcUpcomingLoading = true
 		m.wcUpcomingLastError = ""
-		return m, tea.Batch(tea.ClearScreen, fetchWorldCupUpcoming(m.loadCtx, m.fotmobClient))
+		return m, tea.Batch(tea.ClearScreen, fetchWorldCupUpcoming(m.loadCtx, m.client))
 
 	case "s":
 		return m.openTopScorersDialog()
@@ -238,5 +238,5 @@ func (m model) openTopScorersDialog() (tea.Model, tea.Cmd) {
 		return m, nil
 	}
 	m.wcTopScorersLoading = true
-	return m, fetchWorldCupTopScorers(m.loadCtx, m.fotmobClient, m.wcYear)
+	return m, fetchWorldCupTopScorers(m.loadCtx, m.client, m.wcYear)
 }
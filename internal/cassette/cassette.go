@@ -0,0 +1,271 @@
+// Package cassette provides a record/replay http.RoundTripper. In record
+// mode every real response is written to a cassette directory; in replay
+// mode requests are served from that directory without touching the network.
+// Used for reproducible bug reports, offline demos and parser test fixtures.
+package cassette
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Env vars that select a mode when no --record/--replay flag is given.
+const (
+	EnvRecord = "GOLAZO_RECORD" // cassette dir to record into
+	EnvReplay = "GOLAZO_REPLAY" // cassette dir to replay from
+)
+
+// Mode selects what a Transport does with requests.
+type Mode int
+
+const (
+	// Off passes requests straight through (today's behaviour).
+	Off Mode = iota
+	// Record passes requests through and saves every response.
+	Record
+	// Replay serves requests from the cassette only.
+	Replay
+)
+
+// String returns the mode name used in flags and logs.
+func (m Mode) String() string {
+	switch m {
+	case Record:
+		return "record"
+	case Replay:
+		return "replay"
+	default:
+		return "off"
+	}
+}
+
+// ErrNotRecorded is returned in replay mode for a request the cassette has
+// no response for.
+var ErrNotRecorded = errors.New("cassette: request not recorded")
+
+// entry is the JSON sidecar stored next to each response body.
+type entry struct {
+	Method string      `json:"method"`
+	URL    string      `json:"url"`
+	Status int         `json:"status"`
+	Header http.Header `json:"header"`
+}
+
+// Transport records or replays HTTP exchanges. Requests are matched on
+// method and full URL. Each exchange is stored as <name>.json (method, URL,
+// status, headers) plus <name>.body (the raw body, e.g. page HTML), where
+// name is a readable host/path slug followed by a short hash of the request.
+type Transport struct {
+	Mode Mode
+	Dir  string
+	// Next performs real requests in Record mode. Defaults to
+	// http.DefaultTransport.
+	Next http.RoundTripper
+
+	mu sync.Mutex // serializes cassette writes
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch t.Mode {
+	case Replay:
+		return t.replay(req)
+	case Record:
+		return t.record(req)
+	default:
+		return t.next().RoundTrip(req)
+	}
+}
+
+func (t *Transport) next() http.RoundTripper {
+	if t.Next != nil {
+		return t.Next
+	}
+	return http.DefaultTransport
+}
+
+func (t *Transport) replay(req *http.Request) (*http.Response, error) {
+	base := filepath.Join(t.Dir, Name(req.Method, req.URL.String()))
+	meta, err := os.ReadFile(base + ".json")
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("%w: %s %s", ErrNotRecorded, req.Method, req.URL)
+		}
+		return nil, fmt.Errorf("cassette: read %s: %w", base+".json", err)
+	}
+	var e entry
+	if err := json.Unmarshal(meta, &e); err != nil {
+		return nil, fmt.Errorf("cassette: parse %s: %w", base+".json", err)
+	}
+	body, err := os.ReadFile(base + ".body")
+	if err != nil {
+		return nil, fmt.Errorf("cassette: read %s: %w", base+".body", err)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", e.Status, http.StatusText(e.Status)),
+		StatusCode:    e.Status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        e.Header,
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+func (t *Transport) record(req *http.Request) (*http.Response, error) {
+	resp, err := t.next().RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	header := resp.Header.Clone()
+	header.Del("Set-Cookie") // keep session cookies out of shared cassettes
+	e := entry{Method: req.Method, URL: req.URL.String(), Status: resp.StatusCode, Header: header}
+	if err := t.save(e, body); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (t *Transport) save(e entry, body []byte) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if err := os.MkdirAll(t.Dir, 0755); err != nil {
+		return fmt.Errorf("cassette: %w", err)
+	}
+	meta, err := json.MarshalIndent(e, "", "  ")
+	if err != nil {
+		return fmt.Errorf("cassette: %w", err)
+	}
+	base := filepath.Join(t.Dir, Name(e.Method, e.URL))
+	// Body first: replay keys off the .json file, so it must never see a
+	// sidecar without its body.
+	if err := os.WriteFile(base+".body", body, 0644); err != nil {
+		return fmt.Errorf("cassette: %w", err)
+	}
+	if err := os.WriteFile(base+".json", meta, 0644); err != nil {
+		return fmt.Errorf("cassette: %w", err)
+	}
+	return nil
+}
+
+// Name returns the cassette file name (without extension) for a request,
+// e.g. "www.fotmob.com_leagues_47-3f9a1c2b7d10".
+func Name(method, rawURL string) string {
+	sum := sha256.Sum256([]byte(method + " " + rawURL))
+
+	slug := rawURL
+	if i := strings.Index(slug, "://"); i >= 0 {
+		slug = slug[i+3:]
+	}
+	if i := strings.IndexAny(slug, "?#"); i >= 0 {
+		slug = slug[:i]
+	}
+	slug = strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-':
+			return r
+		}
+		return '_'
+	}, strings.TrimSuffix(slug, "/"))
+	if len(slug) > 80 {
+		slug = slug[:80]
+	}
+	return slug + "-" + hex.EncodeToString(sum[:6])
+}
+
+var (
+	defaultMu   sync.RWMutex
+	defaultMode Mode
+	defaultDir  string
+	configured  bool
+)
+
+// Configure sets the process-wide mode used by Wrap. dir is required for
+// Record and Replay.
+func Configure(mode Mode, dir string) error {
+	if mode != Off && dir == "" {
+		return fmt.Errorf("cassette: %s mode needs a directory", mode)
+	}
+	if mode == Replay {
+		info, err := os.Stat(dir)
+		if err != nil {
+			return fmt.Errorf("cassette: replay dir: %w", err)
+		}
+		if !info.IsDir() {
+			return fmt.Errorf("cassette: replay dir %s is not a directory", dir)
+		}
+	}
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	defaultMode, defaultDir, configured = mode, dir, true
+	return nil
+}
+
+// FromEnv returns the mode selected by GOLAZO_RECORD / GOLAZO_REPLAY. Setting
+// both is an error.
+func FromEnv() (Mode, string, error) {
+	rec, rep := os.Getenv(EnvRecord), os.Getenv(EnvReplay)
+	switch {
+	case rec != "" && rep != "":
+		return Off, "", fmt.Errorf("cassette: %s and %s are mutually exclusive", EnvRecord, EnvReplay)
+	case rec != "":
+		return Record, rec, nil
+	case rep != "":
+		return Replay, rep, nil
+	}
+	return Off, "", nil
+}
+
+// Current returns the process-wide mode and directory. Until Configure is
+// called it falls back to the env vars, ignoring invalid combinations.
+func Current() (Mode, string) {
+	defaultMu.RLock()
+	mode, dir, ok := defaultMode, defaultDir, configured
+	defaultMu.RUnlock()
+	if ok {
+		return mode, dir
+	}
+	mode, dir, err := FromEnv()
+	if err != nil {
+		return Off, ""
+	}
+	return mode, dir
+}
+
+// Replaying reports whether the process-wide mode is Replay, i.e. no request
+// reaches the network.
+func Replaying() bool {
+	mode, _ := Current()
+	return mode == Replay
+}
+
+// Wrap returns next wrapped in a Transport for the process-wide mode, or next
+// itself when the mode is Off. HTTP clients call it at construction time.
+func Wrap(next http.RoundTripper) http.RoundTripper {
+	mode, dir := Current()
+	if mode == Off {
+		return next
+	}
+	return &Transport{Mode: mode, Dir: dir, Next: next}
+}
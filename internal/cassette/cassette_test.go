@@ -0,0 +1,107 @@
+package cassette
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestTransport_RecordThenReplay(t *testing.T) {
+	hits := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("Content-Type", "text/html")
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.WriteHeader(http.StatusTeapot)
+		_, _ = io.WriteString(w, "<html>"+r.URL.Query().Get("id")+"</html>")
+	}))
+	dir := t.TempDir()
+
+	rec := &http.Client{Transport: &Transport{Mode: Record, Dir: dir}}
+	resp, err := rec.Get(srv.URL + "/leagues?id=47")
+	if err != nil {
+		t.Fatalf("record: %v", err)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if string(body) != "<html>47</html>" {
+		t.Fatalf("recorded body = %q", body)
+	}
+	srv.Close()
+
+	play := &http.Client{Transport: &Transport{Mode: Replay, Dir: dir}}
+	resp, err = play.Get(srv.URL + "/leagues?id=47")
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+	body, _ = io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusTeapot || string(body) != "<html>47</html>" {
+		t.Errorf("replayed %d %q", resp.StatusCode, body)
+	}
+	if resp.Header.Get("Content-Type") != "text/html" || resp.Header.Get("Set-Cookie") != "" {
+		t.Errorf("replayed headers = %v, want Content-Type kept and Set-Cookie dropped", resp.Header)
+	}
+	if hits != 1 {
+		t.Errorf("server hits = %d, want 1", hits)
+	}
+
+	raw, err := os.ReadFile(filepath.Join(dir, Name("GET", srv.URL+"/leagues?id=47")+".body"))
+	if err != nil || string(raw) != "<html>47</html>" {
+		t.Errorf("body file = %q (%v), want the raw response", raw, err)
+	}
+
+	_, err = play.Get(srv.URL + "/leagues?id=87")
+	if !errors.Is(err, ErrNotRecorded) {
+		t.Errorf("unrecorded request err = %v, want ErrNotRecorded", err)
+	}
+}
+
+func TestName(t *testing.T) {
+	a := Name("GET", "https://www.fotmob.com/leagues/47?tab=fixtures")
+	if !strings.HasPrefix(a, "www.fotmob.com_leagues_47-") {
+		t.Errorf("name = %q, want a readable host/path prefix", a)
+	}
+	if a == Name("GET", "https://www.fotmob.com/leagues/47?tab=results") {
+		t.Error("different queries must not share a cassette entry")
+	}
+	if a != Name("GET", "https://www.fotmob.com/leagues/47?tab=fixtures") {
+		t.Error("name is not deterministic")
+	}
+}
+
+func TestFromEnvAndWrap(t *testing.T) {
+	t.Setenv(EnvRecord, "a")
+	t.Setenv(EnvReplay, "b")
+	if _, _, err := FromEnv(); err == nil {
+		t.Error("both env vars set: want an error")
+	}
+
+	t.Setenv(EnvRecord, "")
+	dir := t.TempDir()
+	t.Setenv(EnvReplay, dir)
+	mode, got, err := FromEnv()
+	if err != nil || mode != Replay || got != dir {
+		t.Errorf("FromEnv = %v %q %v", mode, got, err)
+	}
+
+	if err := Configure(Replay, filepath.Join(dir, "missing")); err == nil {
+		t.Error("replaying a missing dir: want an error")
+	}
+	if err := Configure(Record, dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = Configure(Off, "") })
+	if tr, ok := Wrap(http.DefaultTransport).(*Transport); !ok || tr.Mode != Record || tr.Dir != dir {
+		t.Errorf("Wrap = %#v, want a record Transport", Wrap(http.DefaultTransport))
+	}
+	_ = Configure(Off, "")
+	if Wrap(http.DefaultTransport) != http.DefaultTransport {
+		t.Error("Wrap with mode off must return next unchanged")
+	}
+}
@@ -18,6 +18,7 @@ const (
 	PanelMinuteByMinute    = "Minute-by-minute"
 	PanelMatchStatistics   = "Match Statistics"
 	PanelUpdates           = "Updates"
+	PanelCommentary        = "Commentary"
 	PanelLeaguePreferences = "League Preferences"
 )
 
@@ -27,6 +28,7 @@ const (
 	EmptyNoFinishedMatches = "No finished matches"
 	EmptySelectMatch       = "Select a match"
 	EmptyNoUpdates         = "No updates"
+	EmptyNoCommentary      = "No commentary"
 	EmptyNoMatches         = "No matches available"
 )
 
@@ -40,19 +42,28 @@ const (
 // Help text
 const (
 	HelpMainMenu           = "↑/↓: navigate  Enter: select  q: quit"
-	HelpMatchesView        = "↑/↓: navigate  r: refresh  x: statistics  s: standings  /: filter  Esc: back  q: quit"
+	HelpMatchesView        = "↑/↓: navigate  r: refresh  c: commentary  x: statistics  s: standings  t/T: home/away team  v/V: head-to-head/upcoming  p: league stats  /: filter  Esc: back  q: quit"
 	HelpSettingsView       = "↑/↓: navigate  ←/→: switch tabs  Space: toggle  /: filter  Enter: save  Esc: back"
 	HelpStatsView          = "h/l: date range  j/k: navigate  Tab: focus details  ↑/↓: scroll when focused  r: refresh details  /: filter  Esc: back"
 	HelpStatsViewUnfocused = "Tab: focus details"
-	HelpStatsViewFocused   = "Tab: unfocus  s: standings  f: formations  x: all statistics  ↑/↓: scroll"
+	HelpStatsViewFocused   = "Tab: unfocus  s: standings  f: formations  x: all statistics  t/T: home/away team  v: head-to-head  p: league stats  ↑/↓: scroll"
 	HelpStandingsDialog    = "Esc: close"
-	HelpFormationsDialog   = "Tab/←/→: switch team  Esc: close"
+	HelpFormationsDialog   = "Tab/←/→: switch team  ↑/↓: select player  Enter: player stats  Esc: close"
 	HelpStatisticsDialog   = "↑/↓: navigate  Esc: close"
 	HelpTopScorersDialog   = "↑/↓: navigate  Esc: close"
+	HelpLeagueStatsDialog  = "Tab/←/→: switch stat  ↑/↓: navigate  Esc: close"
+	HelpTeamDialog         = "↑/↓: scroll  Esc: close"
+	HelpPlayerDialog       = "Esc: back to formations"
+	HelpHeadToHeadDialog   = "↑/↓: scroll  Esc: close"
+	HelpHeadToHeadUpcoming = "↑/↓: scroll  V: next upcoming  Esc: close"
 
 	// Edge case user-facing hints
 	ErrorNoStatistics = "No statistics available yet"
 	ErrorNoStandings  = "No standings available"
+	ErrorNoTeam       = "Team not available"
+	ErrorNoHeadToHead = "No head-to-head available"
+	ErrorNoUpcoming   = "No upcoming matches"
+	ErrorNoTopStats   = "No player stats available for this league"
 )
 
 // Status text
@@ -22,6 +22,7 @@ func MockMatchDetails(matchID int) (*api.MatchDetails, error) {
 				Venue:      getMockVenue(matchID),
 				Referee:    getMockReferee(matchID),
 				Attendance: getMockAttendance(matchID),
+				HeadToHead: MockHeadToHead(liveMatches[i]),
 			}, nil
 		}
 	}
@@ -39,6 +40,7 @@ func MockMatchDetails(matchID int) (*api.MatchDetails, error) {
 				Venue:      getMockVenue(matchID),
 				Referee:    getMockReferee(matchID),
 				Attendance: getMockAttendance(matchID),
+				HeadToHead: MockHeadToHead(finishedMatches[i]),
 			}, nil
 		}
 	}
@@ -35,6 +35,7 @@ func MockFinishedMatchDetails(matchID int) (*api.MatchDetails, error) {
 		Venue:      getMockVenue(matchID),
 		Referee:    getMockReferee(matchID),
 		Attendance: getMockAttendance(matchID),
+		HeadToHead: MockHeadToHead(*match),
 	}
 
 	// Add mock penalties for some matches to demonstrate the feature
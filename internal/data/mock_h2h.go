@@ -0,0 +1,46 @@
+package data
+
+import (
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// MockHeadToHead returns a head-to-head record for match: its teams' other
+// mock meetings, if any, plus two made-up past meetings (a 1-1 at the away
+// side a year before and a 2-0 home win two years before), most recent first.
+func MockHeadToHead(match api.Match) *api.HeadToHead {
+	var meetings []api.Match
+	for _, m := range allMockMatches() {
+		if m.ID == match.ID || m.Status != api.MatchStatusFinished {
+			continue
+		}
+		if (m.HomeTeam.ID == match.HomeTeam.ID && m.AwayTeam.ID == match.AwayTeam.ID) ||
+			(m.HomeTeam.ID == match.AwayTeam.ID && m.AwayTeam.ID == match.HomeTeam.ID) {
+			meetings = append(meetings, m)
+		}
+	}
+
+	ref := time.Now()
+	if match.MatchTime != nil {
+		ref = *match.MatchTime
+	}
+	past := func(id int, home, away api.Team, years, homeScore, awayScore int) api.Match {
+		kickoff := ref.AddDate(-years, 0, 0)
+		return api.Match{
+			ID:        id,
+			League:    match.League,
+			HomeTeam:  home,
+			AwayTeam:  away,
+			Status:    api.MatchStatusFinished,
+			HomeScore: &homeScore,
+			AwayScore: &awayScore,
+			MatchTime: &kickoff,
+		}
+	}
+	meetings = append(meetings,
+		past(match.ID+100000, match.AwayTeam, match.HomeTeam, 1, 1, 1),
+		past(match.ID+200000, match.HomeTeam, match.AwayTeam, 2, 2, 0),
+	)
+	return api.NewHeadToHead(match.HomeTeam.ID, meetings)
+}
@@ -0,0 +1,71 @@
+package data
+
+import "github.com/0xjuanma/golazo/internal/api"
+
+// MockLeagueTopStats returns a league stat list for the mock Premier League
+// (every stat) and the World Cup (goals). Returns nil for other leagues and
+// stats.
+func MockLeagueTopStats(leagueID int, stat string) []api.LeagueStatEntry {
+	switch leagueID {
+	case api.WCFotMobLeagueID:
+		if stat != api.StatGoals {
+			return nil
+		}
+		var entries []api.LeagueStatEntry
+		for i, s := range MockWorldCupTopScorers() {
+			entries = append(entries, api.LeagueStatEntry{Rank: i + 1, PlayerName: s.PlayerName, Team: s.Team, Value: float64(s.Goals)})
+		}
+		return entries
+	case 47:
+		return mockPremierLeagueStats()[stat]
+	}
+	return nil
+}
+
+func mockPremierLeagueStats() map[string][]api.LeagueStatEntry {
+	return map[string][]api.LeagueStatEntry{
+		api.StatGoals: {
+			statEntry(1, 737066, "Erling Haaland", 50, "Manchester City", 19),
+			statEntry(2, 292462, "Mohamed Salah", 40, "Liverpool", 12),
+			statEntry(3, 1096353, "Cole Palmer", 49, "Chelsea", 9),
+			statEntry(4, 493165, "Alexander Isak", 39, "Newcastle United", 9),
+			statEntry(5, 668581, "Ollie Watkins", 66, "Aston Villa", 8),
+		},
+		api.StatAssists: {
+			statEntry(1, 292462, "Mohamed Salah", 40, "Liverpool", 8),
+			statEntry(2, 1096353, "Cole Palmer", 49, "Chelsea", 6),
+			statEntry(3, 934235, "Bukayo Saka", 42, "Arsenal", 6),
+			statEntry(4, 171101, "Bruno Fernandes", 33, "Manchester United", 5),
+			statEntry(5, 737066, "Erling Haaland", 50, "Manchester City", 2),
+		},
+		api.StatRating: {
+			statEntry(1, 292462, "Mohamed Salah", 40, "Liverpool", 7.92),
+			statEntry(2, 737066, "Erling Haaland", 50, "Manchester City", 7.71),
+			statEntry(3, 1096353, "Cole Palmer", 49, "Chelsea", 7.64),
+			statEntry(4, 934235, "Bukayo Saka", 42, "Arsenal", 7.58),
+			statEntry(5, 171101, "Bruno Fernandes", 33, "Manchester United", 7.41),
+		},
+		api.StatCleanSheets: {
+			statEntry(1, 562727, "David Raya", 42, "Arsenal", 9),
+			statEntry(2, 1021586, "Alisson", 40, "Liverpool", 8),
+			statEntry(3, 317898, "Robert Sánchez", 49, "Chelsea", 6),
+			statEntry(4, 1053716, "Ederson", 50, "Manchester City", 5),
+			statEntry(5, 562733, "Emiliano Martínez", 66, "Aston Villa", 5),
+		},
+		api.StatYellowCards: {
+			statEntry(1, 1114432, "Moisés Caicedo", 49, "Chelsea", 6),
+			statEntry(2, 171101, "Bruno Fernandes", 33, "Manchester United", 5),
+			statEntry(3, 609551, "Bruno Guimarães", 39, "Newcastle United", 5),
+			statEntry(4, 493102, "Declan Rice", 42, "Arsenal", 4),
+			statEntry(5, 588497, "John McGinn", 66, "Aston Villa", 4),
+		},
+		api.StatRedCards: {
+			statEntry(1, 493102, "Declan Rice", 42, "Arsenal", 1),
+			statEntry(2, 171101, "Bruno Fernandes", 33, "Manchester United", 1),
+		},
+	}
+}
+
+func statEntry(rank, playerID int, player string, teamID int, team string, value float64) api.LeagueStatEntry {
+	return api.LeagueStatEntry{Rank: rank, PlayerID: playerID, PlayerName: player, TeamID: teamID, Team: team, Value: value}
+}
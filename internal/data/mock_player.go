@@ -0,0 +1,49 @@
+package data
+
+import "github.com/0xjuanma/golazo/internal/api"
+
+// MockPlayerProfile returns a player profile for a few headline players of
+// the mock teams. Returns nil for other IDs.
+func MockPlayerProfile(playerID int) *api.PlayerProfile {
+	for _, p := range mockPlayers() {
+		if p.ID == playerID {
+			return &p
+		}
+	}
+	return nil
+}
+
+func mockPlayers() []api.PlayerProfile {
+	premierLeague := &api.League{ID: 47, Name: "Premier League"}
+	return []api.PlayerProfile{
+		{
+			ID: 292462, Name: "Mohamed Salah", Position: "Right Winger", Number: 11, Age: 34, Country: "Egypt", HeightCM: 175,
+			Team:   &api.Team{ID: 40, Name: "Liverpool", ShortName: "Liverpool"},
+			League: premierLeague, Season: "2025/2026",
+			Stats: mockSeasonStats(17, 1480, 12, 8, 7.92),
+		},
+		{
+			ID: 1096353, Name: "Cole Palmer", Position: "Attacking Midfielder", Number: 10, Age: 24, Country: "England", HeightCM: 189,
+			Team:   &api.Team{ID: 49, Name: "Chelsea", ShortName: "Chelsea"},
+			League: premierLeague, Season: "2025/2026",
+			Stats: mockSeasonStats(16, 1352, 9, 6, 7.64),
+		},
+		{
+			ID: 737066, Name: "Erling Haaland", Position: "Striker", Number: 9, Age: 26, Country: "Norway", HeightCM: 195,
+			Team:   &api.Team{ID: 50, Name: "Manchester City", ShortName: "Man City"},
+			League: premierLeague, Season: "2025/2026",
+			Stats: mockSeasonStats(17, 1501, 19, 2, 7.71),
+		},
+	}
+}
+
+// mockSeasonStats builds the season stats FotMob lists for outfield players.
+func mockSeasonStats(matches, minutes, goals, assists int, rating float64) []api.PlayerSeasonStat {
+	return []api.PlayerSeasonStat{
+		{Key: "matches_uppercase", Label: "Matches", Value: float64(matches)},
+		{Key: "minutes_played", Label: "Minutes played", Value: float64(minutes)},
+		{Key: "goals", Label: "Goals", Value: float64(goals)},
+		{Key: "assists", Label: "Assists", Value: float64(assists)},
+		{Key: "rating", Label: "Rating", Value: rating},
+	}
+}
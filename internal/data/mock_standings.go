@@ -0,0 +1,42 @@
+package data
+
+import "github.com/0xjuanma/golazo/internal/api"
+
+// MockStandings returns a league table for the leagues used by the mock
+// match data (Premier League, La Liga, Champions League).
+// Team IDs match MockLiveMatches/MockFinishedMatches so results can be joined.
+// Returns nil for any other league ID.
+func MockStandings(leagueID int) []api.LeagueTableEntry {
+	switch leagueID {
+	case 47:
+		return []api.LeagueTableEntry{
+			entry(1, 40, "Liverpool", "Liverpool", 17, 13, 3, 1, 40, 15),
+			entry(2, 42, "Arsenal", "Arsenal", 17, 11, 4, 2, 33, 14),
+			entry(3, 49, "Chelsea", "Chelsea", 17, 10, 4, 3, 35, 19),
+			entry(4, 50, "Manchester City", "Man City", 17, 9, 4, 4, 31, 22),
+			entry(5, 39, "Newcastle United", "Newcastle", 17, 8, 5, 4, 27, 20),
+			entry(6, 66, "Aston Villa", "Villa", 17, 8, 4, 5, 26, 24),
+			entry(7, 33, "Manchester United", "Man Utd", 17, 6, 4, 7, 21, 23),
+		}
+	case 87:
+		return []api.LeagueTableEntry{
+			entry(1, 529, "Barcelona", "Barcelona", 17, 13, 2, 2, 46, 17),
+			entry(2, 541, "Real Madrid", "Real Madrid", 17, 12, 4, 1, 37, 14),
+			entry(3, 531, "Athletic Bilbao", "Athletic", 17, 9, 5, 3, 27, 16),
+			entry(4, 536, "Sevilla", "Sevilla", 17, 7, 5, 5, 22, 21),
+			entry(5, 532, "Valencia", "Valencia", 17, 4, 6, 7, 17, 25),
+		}
+	case 42:
+		return []api.LeagueTableEntry{
+			entry(1, 40, "Liverpool", "Liverpool", 6, 6, 0, 0, 13, 1),
+			entry(2, 529, "Barcelona", "Barcelona", 6, 5, 0, 1, 21, 7),
+			entry(3, 42, "Arsenal", "Arsenal", 6, 4, 1, 1, 13, 3),
+			entry(4, 108, "Inter Milan", "Inter", 6, 4, 1, 1, 7, 1),
+			entry(5, 157, "Bayern Munich", "Bayern", 6, 4, 0, 2, 17, 8),
+			entry(6, 85, "Paris Saint-Germain", "PSG", 6, 3, 1, 2, 14, 9),
+			entry(7, 541, "Real Madrid", "Real Madrid", 6, 3, 0, 3, 12, 11),
+			entry(8, 50, "Manchester City", "Man City", 6, 2, 1, 3, 13, 13),
+		}
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package data
+
+import (
+	"sort"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// mockTeamMatches caps the mock overview's results and fixtures, like
+// fotmob.TeamMatches does for the real one.
+const mockTeamMatches = 5
+
+// mockTeamLeagues is the order leagues are tried as a mock team's primary
+// league: domestic leagues before the Champions League.
+var mockTeamLeagues = []int{47, 87, 42}
+
+// allMockMatches is every mock listing (live, finished and upcoming) with
+// matches listed twice kept once.
+func allMockMatches() []api.Match {
+	var lists []api.Match
+	lists = append(lists, MockLiveMatches()...)
+	lists = append(lists, MockFinishedMatches()...)
+	lists = append(lists, MockUpcomingMatches()...)
+
+	seen := make(map[int]bool)
+	matches := make([]api.Match, 0, len(lists))
+	for _, m := range lists {
+		if !seen[m.ID] {
+			seen[m.ID] = true
+			matches = append(matches, m)
+		}
+	}
+	return matches
+}
+
+// kickoff is m's kickoff time, zero when unknown.
+func kickoff(m api.Match) time.Time {
+	if m.MatchTime == nil {
+		return time.Time{}
+	}
+	return *m.MatchTime
+}
+
+// MockTeams returns the teams playing in the mock matches, by ID.
+func MockTeams() []api.Team {
+	seen := make(map[int]bool)
+	var teams []api.Team
+	for _, m := range allMockMatches() {
+		for _, t := range []api.Team{m.HomeTeam, m.AwayTeam} {
+			if !seen[t.ID] {
+				seen[t.ID] = true
+				teams = append(teams, t)
+			}
+		}
+	}
+	sort.Slice(teams, func(i, j int) bool { return teams[i].ID < teams[j].ID })
+	return teams
+}
+
+// MockTeamOverview builds a team overview from the mock matches and
+// MockStandings. The mock data has no squads. Returns nil for teams that
+// play in no mock match.
+func MockTeamOverview(teamID int) *api.TeamOverview {
+	var overview *api.TeamOverview
+	var results, fixtures []api.Match
+	for _, m := range allMockMatches() {
+		var t api.Team
+		switch teamID {
+		case m.HomeTeam.ID:
+			t = m.HomeTeam
+		case m.AwayTeam.ID:
+			t = m.AwayTeam
+		default:
+			continue
+		}
+		if overview == nil {
+			overview = &api.TeamOverview{
+				Team:     t,
+				Results:  []api.Match{},
+				Fixtures: []api.Match{},
+				Squad:    []api.SquadMember{},
+			}
+		}
+		if m.Status == api.MatchStatusFinished {
+			results = append(results, m)
+		} else {
+			fixtures = append(fixtures, m)
+		}
+	}
+	if overview == nil {
+		return nil
+	}
+
+	// Results most recent first, fixtures soonest first.
+	sort.SliceStable(results, func(i, j int) bool { return kickoff(results[i]).After(kickoff(results[j])) })
+	sort.SliceStable(fixtures, func(i, j int) bool { return kickoff(fixtures[i]).Before(kickoff(fixtures[j])) })
+	overview.Results = append(overview.Results, results[:min(len(results), mockTeamMatches)]...)
+	overview.Fixtures = append(overview.Fixtures, fixtures[:min(len(fixtures), mockTeamMatches)]...)
+
+	recent := make([]api.Match, 0, len(overview.Results))
+	for i := len(overview.Results) - 1; i >= 0; i-- {
+		recent = append(recent, overview.Results[i])
+	}
+	overview.Form = api.FormString(teamID, recent)
+
+	for _, leagueID := range mockTeamLeagues {
+		table := MockStandings(leagueID)
+		for i := range table {
+			if table[i].Team.ID == teamID {
+				overview.Standing = &table[i]
+				break
+			}
+		}
+		if overview.Standing != nil {
+			overview.League = &api.League{ID: leagueID, Name: mockLeagueName(leagueID)}
+			break
+		}
+	}
+	return overview
+}
+
+func mockLeagueName(leagueID int) string {
+	switch leagueID {
+	case 47:
+		return "Premier League"
+	case 87:
+		return "La Liga"
+	case 42:
+		return "UEFA Champions League"
+	}
+	return ""
+}
@@ -0,0 +1,131 @@
+package data
+
+import (
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// MockUpcomingMatches returns not-yet-started fixtures spread over the next week.
+// 5 matches from preferred leagues: Premier League, La Liga, Champions League
+func MockUpcomingMatches() []api.Match {
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	return []api.Match{
+		// Fixture 1: Liverpool vs Chelsea (tomorrow, Premier League)
+		{
+			ID: 3001,
+			League: api.League{
+				ID:   47,
+				Name: "Premier League",
+			},
+			HomeTeam: api.Team{
+				ID:        40,
+				Name:      "Liverpool",
+				ShortName: "Liverpool",
+			},
+			AwayTeam: api.Team{
+				ID:        49,
+				Name:      "Chelsea",
+				ShortName: "Chelsea",
+			},
+			Status:    api.MatchStatusNotStarted,
+			MatchTime: timePtr(today.AddDate(0, 0, 1).Add(17*time.Hour + 30*time.Minute)),
+			Round:     "Matchday 19",
+			PageURL:   "/matches/liverpool-vs-chelsea/mock3001",
+		},
+
+		// Fixture 2: Arsenal vs Manchester City (in 2 days, Premier League)
+		{
+			ID: 3002,
+			League: api.League{
+				ID:   47,
+				Name: "Premier League",
+			},
+			HomeTeam: api.Team{
+				ID:        42,
+				Name:      "Arsenal",
+				ShortName: "Arsenal",
+			},
+			AwayTeam: api.Team{
+				ID:        50,
+				Name:      "Manchester City",
+				ShortName: "Man City",
+			},
+			Status:    api.MatchStatusNotStarted,
+			MatchTime: timePtr(today.AddDate(0, 0, 2).Add(16 * time.Hour)),
+			Round:     "Matchday 19",
+			PageURL:   "/matches/arsenal-vs-manchester-city/mock3002",
+		},
+
+		// Fixture 3: Barcelona vs Sevilla (in 2 days, La Liga)
+		{
+			ID: 3003,
+			League: api.League{
+				ID:   87,
+				Name: "La Liga",
+			},
+			HomeTeam: api.Team{
+				ID:        529,
+				Name:      "Barcelona",
+				ShortName: "Barcelona",
+			},
+			AwayTeam: api.Team{
+				ID:        536,
+				Name:      "Sevilla",
+				ShortName: "Sevilla",
+			},
+			Status:    api.MatchStatusNotStarted,
+			MatchTime: timePtr(today.AddDate(0, 0, 2).Add(20 * time.Hour)),
+			Round:     "Matchday 18",
+			PageURL:   "/matches/barcelona-vs-sevilla/mock3003",
+		},
+
+		// Fixture 4: Bayern vs Inter (in 4 days, Champions League)
+		{
+			ID: 3004,
+			League: api.League{
+				ID:   42,
+				Name: "UEFA Champions League",
+			},
+			HomeTeam: api.Team{
+				ID:        157,
+				Name:      "Bayern Munich",
+				ShortName: "Bayern",
+			},
+			AwayTeam: api.Team{
+				ID:        108,
+				Name:      "Inter Milan",
+				ShortName: "Inter",
+			},
+			Status:    api.MatchStatusNotStarted,
+			MatchTime: timePtr(today.AddDate(0, 0, 4).Add(20 * time.Hour)),
+			Round:     "Round of 16 - 2nd Leg",
+			PageURL:   "/matches/bayern-munchen-vs-inter/mock3004",
+		},
+
+		// Fixture 5: Real Madrid vs Valencia (in 6 days, La Liga)
+		{
+			ID: 3005,
+			League: api.League{
+				ID:   87,
+				Name: "La Liga",
+			},
+			HomeTeam: api.Team{
+				ID:        541,
+				Name:      "Real Madrid",
+				ShortName: "Real Madrid",
+			},
+			AwayTeam: api.Team{
+				ID:        532,
+				Name:      "Valencia",
+				ShortName: "Valencia",
+			},
+			Status:    api.MatchStatusNotStarted,
+			MatchTime: timePtr(today.AddDate(0, 0, 6).Add(21 * time.Hour)),
+			Round:     "Matchday 19",
+			PageURL:   "/matches/real-madrid-vs-valencia/mock3005",
+		},
+	}
+}
@@ -1,6 +1,7 @@
 package data
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"slices"
@@ -161,6 +162,23 @@ type Settings struct {
 	// SelectedLeagues contains the IDs of leagues the user wants to follow.
 	// If empty, all supported leagues are used.
 	SelectedLeagues []int `yaml:"selected_leagues"`
+
+	// Provider selects the data source: "fotmob" (default) or
+	// "football-data". The --provider flag overrides it.
+	Provider string `yaml:"provider,omitempty"`
+
+	// FootballData configures the football-data.org provider.
+	FootballData FootballDataSettings `yaml:"football_data,omitempty"`
+}
+
+// FootballDataSettings configures the football-data.org provider.
+type FootballDataSettings struct {
+	// BaseURL defaults to https://api.football-data.org/v4. Point it at a
+	// local stub to run without the real service.
+	BaseURL string `yaml:"base_url,omitempty"`
+	// Token is the API key sent as X-Auth-Token. GOLAZO_FOOTBALL_DATA_TOKEN
+	// overrides it.
+	Token string `yaml:"token,omitempty"`
 }
 
 // SettingsPath returns the path to the settings file.
@@ -198,6 +216,30 @@ func LoadSettings() (*Settings, error) {
 	return &settings, nil
 }
 
+// ReadSettings reads settings.yaml like LoadSettings, but reports a malformed
+// file as an error instead of falling back to defaults. A missing file yields
+// empty settings.
+func ReadSettings() (*Settings, error) {
+	path, err := SettingsPath()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &Settings{}, nil
+		}
+		return nil, err
+	}
+
+	var settings Settings
+	if err := yaml.Unmarshal(data, &settings); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+	return &settings, nil
+}
+
 // SaveSettings writes settings to the settings.yaml file.
 func SaveSettings(settings *Settings) error {
 	path, err := SettingsPath()
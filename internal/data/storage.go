@@ -16,6 +16,16 @@ import (
 
 var appDirs = dirs.New("golazo")
 
+const (
+	// LatestVersionFileName is the version-check file in the config dir.
+	LatestVersionFileName = "latest_version.txt"
+	// VersionCheckInterval is how long a version check stays fresh.
+	VersionCheckInterval = 24 * time.Hour
+	// LiveUpdatesTTL is how long an untouched updates_<id>.json file is kept
+	// by `golazo cache prune`; by then its match is long over.
+	LiveUpdatesTTL = 7 * 24 * time.Hour
+)
+
 // ConfigDir returns the path to the golazo config directory.
 // On Linux, follows XDG Base Directory spec (~/.config/golazo).
 // On other systems (macOS, Windows), uses ~/.golazo.
@@ -88,6 +98,16 @@ func SaveLiveUpdate(matchID int, update string) error {
 	return os.WriteFile(updatesFile, data, 0644)
 }
 
+// LiveUpdateFiles returns the paths of every updates_<id>.json file written
+// by SaveLiveUpdate.
+func LiveUpdateFiles() ([]string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return nil, err
+	}
+	return filepath.Glob(filepath.Join(dir, "updates_*.json"))
+}
+
 // LiveUpdates retrieves live updates for a match.
 func LiveUpdates(matchID int) ([]string, error) {
 	dir, err := ConfigDir()
@@ -122,7 +142,7 @@ func LoadLatestVersion() (string, error) {
 		return "", err
 	}
 
-	versionFile := filepath.Join(dir, "latest_version.txt")
+	versionFile := filepath.Join(dir, LatestVersionFileName)
 	data, err := os.ReadFile(versionFile)
 	if err != nil {
 		return "", nil // Return empty if file doesn't exist
@@ -138,7 +158,7 @@ func SaveLatestVersion(version string) error {
 		return err
 	}
 
-	versionFile := filepath.Join(dir, "latest_version.txt")
+	versionFile := filepath.Join(dir, LatestVersionFileName)
 	return os.WriteFile(versionFile, []byte(strings.TrimSpace(version)), 0644)
 }
 
@@ -189,19 +209,19 @@ func CheckLatestVersion() (string, error) {
 }
 
 // ShouldCheckVersion returns true if we should check for a new version.
-// Checks if the latest_version.txt file is older than 24 hours.
+// Checks if the latest_version.txt file is older than VersionCheckInterval.
 func ShouldCheckVersion() bool {
 	dir, err := ConfigDir()
 	if err != nil {
 		return false
 	}
 
-	versionFile := filepath.Join(dir, "latest_version.txt")
+	versionFile := filepath.Join(dir, LatestVersionFileName)
 	info, err := os.Stat(versionFile)
 	if err != nil {
 		return true // File doesn't exist, should check
 	}
 
 	// Check if file is older than 24 hours
-	return time.Since(info.ModTime()) > 24*time.Hour
+	return time.Since(info.ModTime()) > VersionCheckInterval
 }
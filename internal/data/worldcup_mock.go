@@ -152,6 +152,19 @@ func mockWC2022Bronze() *api.WCMatchup {
 	}
 }
 
+// MockWorldCupTopScorers returns the leading 2022 World Cup scorers, ranked
+// by goals. Like the live stats list, only goals are populated.
+func MockWorldCupTopScorers() []api.WCTopScorer {
+	return []api.WCTopScorer{
+		{PlayerName: "Kylian Mbappé", Team: "France", Goals: 8},
+		{PlayerName: "Lionel Messi", Team: "Argentina", Goals: 7},
+		{PlayerName: "Julián Álvarez", Team: "Argentina", Goals: 4},
+		{PlayerName: "Olivier Giroud", Team: "France", Goals: 4},
+		{PlayerName: "Cody Gakpo", Team: "Netherlands", Goals: 3},
+		{PlayerName: "Gonçalo Ramos", Team: "Portugal", Goals: 3},
+	}
+}
+
 // MockWorldCupUpcoming returns a small set of synthetic World Cup fixtures
 // covering the next four days, used by --mock and as a deterministic
 // stand-in when no client is available. Kickoff times are anchored at the
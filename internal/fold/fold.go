@@ -0,0 +1,24 @@
+// Package fold normalizes names for loose matching across data sources.
+package fold
+
+import (
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Diacritics returns s with combining diacritical marks removed, so
+// "Vinícius" → "Vinicius", "Müller" → "Muller", "Türkiye" → "Turkiye"
+// (preserving the base letter instead of stripping the whole grapheme).
+// The transformer chain keeps internal buffers, so each call builds its own
+// and Diacritics is safe for concurrent use.
+func Diacritics(s string) string {
+	t := transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	out, _, err := transform.String(t, s)
+	if err != nil {
+		return s
+	}
+	return out
+}
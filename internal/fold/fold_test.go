@@ -0,0 +1,21 @@
+package fold
+
+import "testing"
+
+func TestDiacritics(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"Vinícius Júnior", "Vinicius Junior"},
+		{"Bayern München", "Bayern Munchen"},
+		{"Türkiye", "Turkiye"},
+		{"Atlético Madrid", "Atletico Madrid"},
+		{"Arsenal", "Arsenal"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := Diacritics(tt.in); got != tt.want {
+			t.Errorf("Diacritics(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,429 @@
+// Package footballdata implements api.Client on top of the football-data.org
+// v4 REST API, as an alternative to FotMob's scraped pages. The base URL is
+// configurable so a local stub can stand in for the real service.
+package footballdata
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/cassette"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/ratelimit"
+)
+
+// DefaultBaseURL is the football-data.org v4 API.
+const DefaultBaseURL = "https://api.football-data.org/v4"
+
+// DefaultRequestsPerMinute matches the free tier's quota.
+const DefaultRequestsPerMinute = 10
+
+// ErrNotFound is returned (wrapped) when the API answers 404.
+var ErrNotFound = errors.New("not found")
+
+// Client talks to a football-data.org compatible API. Safe for concurrent use.
+type Client struct {
+	baseURL     string
+	token       string
+	httpClient  *http.Client
+	rateLimiter *ratelimit.Limiter
+	logger      *slog.Logger
+}
+
+// NewClient creates a client for baseURL (DefaultBaseURL when empty). token is
+// sent as the X-Auth-Token header; the real API requires one, a local stub
+// usually does not. Requests are paced at DefaultRequestsPerMinute and go
+// through the --record/--replay cassette like FotMob's.
+func NewClient(baseURL, token string) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	return &Client{
+		baseURL:     strings.TrimSuffix(baseURL, "/"),
+		token:       token,
+		httpClient:  &http.Client{Timeout: 15 * time.Second, Transport: cassette.Wrap(http.DefaultTransport)},
+		rateLimiter: ratelimit.NewFromRate(DefaultRequestsPerMinute),
+	}
+}
+
+// SetRateLimiter replaces the limiter that spaces out requests.
+func (c *Client) SetRateLimiter(l *ratelimit.Limiter) {
+	c.rateLimiter = l
+}
+
+// RateLimitStats returns the rate limiter's counters.
+func (c *Client) RateLimitStats() []ratelimit.Stats {
+	return c.rateLimiter.Stats()
+}
+
+// SetLogger sets the debug logger for the client.
+func (c *Client) SetLogger(logger *slog.Logger) {
+	c.logger = logger
+}
+
+func (c *Client) debugLog(msg string, args ...any) {
+	if c.logger != nil {
+		c.logger.Debug(msg, args...)
+	}
+}
+
+// get fetches path (relative to the base URL) with query and decodes the JSON
+// body into out.
+func (c *Client) get(ctx context.Context, path string, query url.Values, out any) error {
+	if err := c.rateLimiter.Wait(ctx); err != nil {
+		return err
+	}
+	u := c.baseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if c.token != "" {
+		req.Header.Set("X-Auth-Token", c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("football-data %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("football-data %s: read body: %w", path, err)
+	}
+	c.debugLog("football-data request", "path", path, "status", resp.StatusCode, "bytes", len(body))
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return fmt.Errorf("football-data %s: %w", path, ErrNotFound)
+	case resp.StatusCode != http.StatusOK:
+		return fmt.Errorf("football-data %s: HTTP %d", path, resp.StatusCode)
+	}
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("football-data %s: decode: %w", path, err)
+	}
+	return nil
+}
+
+// matches fetches the matches between from and to (inclusive dates) in the
+// given leagues. Leagues the provider does not cover are dropped; if none
+// remain the result is empty without a request.
+func (c *Client) matches(ctx context.Context, from, to time.Time, leagues []int) ([]api.Match, error) {
+	codes := make([]string, 0, len(leagues))
+	for _, id := range SupportedLeagues(leagues) {
+		codes = append(codes, competitionCodes[id])
+	}
+	if len(codes) == 0 {
+		return []api.Match{}, nil
+	}
+	q := url.Values{}
+	q.Set("dateFrom", from.UTC().Format("2006-01-02"))
+	q.Set("dateTo", to.UTC().Format("2006-01-02"))
+	q.Set("competitions", strings.Join(codes, ","))
+
+	var resp fdMatchesResponse
+	if err := c.get(ctx, "/matches", q, &resp); err != nil {
+		return nil, err
+	}
+	out := make([]api.Match, 0, len(resp.Matches))
+	for _, m := range resp.Matches {
+		out = append(out, m.toAPIMatch())
+	}
+	return out, nil
+}
+
+// inTabs reports whether a match belongs to one of the tabs.
+func inTabs(m api.Match, tabs []string) bool {
+	for _, tab := range tabs {
+		switch tab {
+		case "results":
+			if m.Status == api.MatchStatusFinished {
+				return true
+			}
+		case "fixtures":
+			if m.Status == api.MatchStatusLive || m.Status == api.MatchStatusNotStarted {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MatchesByDate retrieves the active leagues' matches on date.
+func (c *Client) MatchesByDate(ctx context.Context, date time.Time) ([]api.Match, error) {
+	return c.MatchesByDateWithTabs(ctx, date, []string{"fixtures", "results"})
+}
+
+// MatchesByDateWithTabs retrieves the active leagues' matches on date for tabs.
+func (c *Client) MatchesByDateWithTabs(ctx context.Context, date time.Time, tabs []string) ([]api.Match, error) {
+	return c.MatchesByDateForLeagues(ctx, date, tabs, data.ActiveLeagueIDs())
+}
+
+// MatchesByDateForLeagues retrieves matches on date in leagueIDs for tabs.
+func (c *Client) MatchesByDateForLeagues(ctx context.Context, date time.Time, tabs []string, leagueIDs []int) ([]api.Match, error) {
+	all, err := c.matches(ctx, date, date, leagueIDs)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]api.Match, 0, len(all))
+	for _, m := range all {
+		if inTabs(m, tabs) {
+			out = append(out, m)
+		}
+	}
+	return out, nil
+}
+
+// MatchesForLeagueAndDate retrieves one league's matches on date for a tab.
+func (c *Client) MatchesForLeagueAndDate(ctx context.Context, leagueID int, date time.Time, tab string) ([]api.Match, error) {
+	return c.MatchesByDateForLeagues(ctx, date, []string{tab}, []int{leagueID})
+}
+
+// LiveAndUpcoming returns the active leagues' live matches and the ones still
+// to kick off today (local time).
+func (c *Client) LiveAndUpcoming(ctx context.Context) (live, upcoming []api.Match, err error) {
+	return c.LiveAndUpcomingForLeagues(ctx, data.ActiveLeagueIDs())
+}
+
+// LiveAndUpcomingForLeague is LiveAndUpcoming for one league.
+func (c *Client) LiveAndUpcomingForLeague(ctx context.Context, leagueID int) (live, upcoming []api.Match, err error) {
+	return c.LiveAndUpcomingForLeagues(ctx, []int{leagueID})
+}
+
+// LiveAndUpcomingForLeagues is LiveAndUpcoming over an explicit league set,
+// fetched in one request.
+func (c *Client) LiveAndUpcomingForLeagues(ctx context.Context, leagueIDs []int) (live, upcoming []api.Match, err error) {
+	now := time.Now()
+	startOfDay := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	endOfDay := startOfDay.Add(24 * time.Hour)
+
+	// A local day can span two UTC dates, and a match that kicked off late
+	// yesterday may still be running.
+	all, err := c.matches(ctx, startOfDay.Add(-24*time.Hour), endOfDay, leagueIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, m := range all {
+		switch {
+		case m.Status == api.MatchStatusLive:
+			live = append(live, m)
+		case m.Status == api.MatchStatusNotStarted && m.MatchTime != nil &&
+			!m.MatchTime.Before(startOfDay) && m.MatchTime.Before(endOfDay):
+			upcoming = append(upcoming, m)
+		}
+	}
+	return live, upcoming, nil
+}
+
+// MatchDetails retrieves one match with goals, bookings, substitutions and
+// lineups where the API plan includes them.
+func (c *Client) MatchDetails(ctx context.Context, matchID int) (*api.MatchDetails, error) {
+	var m fdMatch
+	if err := c.get(ctx, fmt.Sprintf("/matches/%d", matchID), nil, &m); err != nil {
+		return nil, err
+	}
+	return m.toAPIMatchDetails(), nil
+}
+
+// MatchDetailsForceRefresh is MatchDetails; this client keeps no cache.
+func (c *Client) MatchDetailsForceRefresh(ctx context.Context, matchID int) (*api.MatchDetails, error) {
+	return c.MatchDetails(ctx, matchID)
+}
+
+// Leagues returns the competitions the API exposes that golazo can map to
+// its league IDs.
+func (c *Client) Leagues(ctx context.Context) ([]api.League, error) {
+	var resp fdCompetitionsResponse
+	if err := c.get(ctx, "/competitions", nil, &resp); err != nil {
+		return nil, err
+	}
+	out := make([]api.League, 0, len(resp.Competitions))
+	for _, comp := range resp.Competitions {
+		id, ok := leagueIDs[comp.Code]
+		if !ok {
+			continue
+		}
+		out = append(out, api.League{
+			ID:          id,
+			Name:        comp.Name,
+			Country:     comp.Area.Name,
+			CountryCode: comp.Area.Code,
+			Logo:        comp.Emblem,
+		})
+	}
+	return out, nil
+}
+
+// LeagueMatches retrieves the current season's matches for a league.
+func (c *Client) LeagueMatches(ctx context.Context, leagueID int) ([]api.Match, error) {
+	code, err := competition(leagueID)
+	if err != nil {
+		return nil, err
+	}
+	var resp fdMatchesResponse
+	if err := c.get(ctx, "/competitions/"+code+"/matches", nil, &resp); err != nil {
+		return nil, err
+	}
+	out := make([]api.Match, 0, len(resp.Matches))
+	for _, m := range resp.Matches {
+		out = append(out, m.toAPIMatch())
+	}
+	return out, nil
+}
+
+// LeagueTable retrieves a league's overall standings. Group-stage
+// competitions return every group's table in order.
+func (c *Client) LeagueTable(ctx context.Context, leagueID int, leagueName string) ([]api.LeagueTableEntry, error) {
+	code, err := competition(leagueID)
+	if err != nil {
+		return nil, err
+	}
+	var resp fdStandingsResponse
+	if err := c.get(ctx, "/competitions/"+code+"/standings", nil, &resp); err != nil {
+		return nil, err
+	}
+	var out []api.LeagueTableEntry
+	for _, s := range resp.Standings {
+		if s.Type != "TOTAL" {
+			continue
+		}
+		for _, row := range s.Table {
+			out = append(out, api.LeagueTableEntry{
+				Position:       row.Position,
+				Team:           row.Team.toAPI(),
+				Played:         row.PlayedGames,
+				Won:            row.Won,
+				Drawn:          row.Draw,
+				Lost:           row.Lost,
+				GoalsFor:       row.GoalsFor,
+				GoalsAgainst:   row.GoalsAgainst,
+				GoalDifference: row.GoalDifference,
+				Points:         row.Points,
+			})
+		}
+	}
+	if len(out) == 0 {
+		return nil, fmt.Errorf("standings for league %d: %w", leagueID, ErrNotFound)
+	}
+	return out, nil
+}
+
+// LeagueTableWithParent is LeagueTable; competitions here have no sub-season
+// leagues.
+func (c *Client) LeagueTableWithParent(ctx context.Context, leagueID int, leagueName string, parentLeagueID int) ([]api.LeagueTableEntry, error) {
+	return c.LeagueTable(ctx, leagueID, leagueName)
+}
+
+// Commentary is not offered: the API has no live ticker.
+func (c *Client) Commentary(ctx context.Context, matchID int, sinceID string) ([]api.CommentaryEntry, error) {
+	return nil, fmt.Errorf("commentary: %w", api.ErrUnsupported)
+}
+
+// WorldCupData is not offered: the API has no bracket view.
+func (c *Client) WorldCupData(ctx context.Context, season string) (*api.WorldCupData, error) {
+	return nil, fmt.Errorf("world cup bracket: %w", api.ErrUnsupported)
+}
+
+// WorldCupTopScorers retrieves the World Cup top scorers for a season ("" for
+// the current one).
+func (c *Client) WorldCupTopScorers(ctx context.Context, season string) ([]api.WCTopScorer, error) {
+	q := url.Values{}
+	if season != "" {
+		q.Set("season", season)
+	}
+	scorers, err := c.scorers(ctx, "WC", q)
+	if err != nil {
+		return nil, err
+	}
+	out := make([]api.WCTopScorer, 0, len(scorers))
+	for _, s := range scorers {
+		scorer := api.WCTopScorer{PlayerName: s.Player.Name, Team: s.Team.Name, Goals: s.Goals}
+		if s.Assists != nil {
+			scorer.Assists = *s.Assists
+		}
+		out = append(out, scorer)
+	}
+	return out, nil
+}
+
+// LeagueTopStats serves goals and assists from the competition's scorers
+// list; the API ranks no ratings, clean sheets or cards. Assists are ranked
+// among the listed scorers. Seasons are start years, so "2024/2025" asks for
+// 2024.
+func (c *Client) LeagueTopStats(ctx context.Context, leagueID int, season, stat string) ([]api.LeagueStatEntry, error) {
+	if stat != api.StatGoals && stat != api.StatAssists {
+		return nil, fmt.Errorf("league stat %q: %w", stat, api.ErrUnsupported)
+	}
+	code, err := competition(leagueID)
+	if err != nil {
+		return nil, err
+	}
+	q := url.Values{"limit": {"50"}}
+	if len(season) >= 4 {
+		q.Set("season", season[:4])
+	}
+	scorers, err := c.scorers(ctx, code, q)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]api.LeagueStatEntry, 0, len(scorers))
+	for _, s := range scorers {
+		value := s.Goals
+		if stat == api.StatAssists {
+			if s.Assists == nil || *s.Assists == 0 {
+				continue
+			}
+			value = *s.Assists
+		}
+		out = append(out, api.LeagueStatEntry{
+			PlayerID:   s.Player.ID,
+			PlayerName: s.Player.Name,
+			TeamID:     s.Team.ID,
+			Team:       s.Team.Name,
+			Value:      float64(value),
+		})
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Value > out[j].Value })
+	for i := range out {
+		out[i].Rank = i + 1
+	}
+	return out, nil
+}
+
+// scorers fetches a competition's scorers list, ranked by goals.
+func (c *Client) scorers(ctx context.Context, code string, q url.Values) ([]fdScorer, error) {
+	var resp struct {
+		Scorers []fdScorer `json:"scorers"`
+	}
+	if err := c.get(ctx, "/competitions/"+code+"/scorers", q, &resp); err != nil {
+		return nil, err
+	}
+	return resp.Scorers, nil
+}
+
+// competition returns leagueID's competition code, or an ErrUnsupported
+// error for leagues outside the provider's coverage.
+func competition(leagueID int) (string, error) {
+	code, ok := CompetitionCode(leagueID)
+	if !ok {
+		return "", fmt.Errorf("league %d: %w", leagueID, api.ErrUnsupported)
+	}
+	return code, nil
+}
+
+var _ api.Client = (*Client)(nil)
@@ -0,0 +1,215 @@
+package footballdata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/ratelimit"
+)
+
+const matchesJSON = `{"matches":[
+ {"id":1,"utcDate":"2026-01-07T15:00:00Z","status":"FINISHED","matchday":21,
+  "area":{"name":"England","code":"ENG"},
+  "competition":{"id":2021,"name":"Premier League","code":"PL"},
+  "homeTeam":{"id":57,"name":"Arsenal FC","shortName":"Arsenal"},
+  "awayTeam":{"id":61,"name":"Chelsea FC","shortName":"Chelsea"},
+  "score":{"winner":"HOME_TEAM","fullTime":{"home":2,"away":1},"halfTime":{"home":1,"away":0}}},
+ {"id":2,"utcDate":"2026-01-07T20:00:00Z","status":"IN_PLAY","minute":67,
+  "competition":{"id":2021,"name":"Premier League","code":"PL"},
+  "homeTeam":{"id":65,"name":"Manchester City FC"},
+  "awayTeam":{"id":64,"name":"Liverpool FC","shortName":"Liverpool"},
+  "score":{"fullTime":{"home":0,"away":0}}},
+ {"id":3,"utcDate":"2026-01-07T21:00:00Z","status":"TIMED",
+  "competition":{"id":2021,"name":"Premier League","code":"PL"},
+  "homeTeam":{"id":66,"name":"Manchester United FC"},
+  "awayTeam":{"id":73,"name":"Tottenham Hotspur FC"},
+  "score":{"fullTime":{"home":null,"away":null}}}
+]}`
+
+const matchJSON = `{"id":1,"utcDate":"2026-01-07T15:00:00Z","status":"FINISHED","venue":"Emirates Stadium",
+ "competition":{"id":2021,"name":"Premier League","code":"PL"},
+ "homeTeam":{"id":57,"name":"Arsenal FC","shortName":"Arsenal","formation":"4-3-3",
+   "lineup":[{"id":1,"name":"David Raya","position":"Goalkeeper","shirtNumber":22}]},
+ "awayTeam":{"id":61,"name":"Chelsea FC","shortName":"Chelsea"},
+ "score":{"winner":"HOME_TEAM","duration":"REGULAR","fullTime":{"home":2,"away":1},"halfTime":{"home":1,"away":0}},
+ "referees":[{"name":"Michael Oliver","type":"REFEREE"}],
+ "goals":[
+  {"minute":80,"type":"OWN","team":{"id":57},"scorer":{"name":"Wesley Fofana"}},
+  {"minute":12,"type":"REGULAR","team":{"id":57},"scorer":{"name":"Bukayo Saka"},"assist":{"name":"Martin Ødegaard"}},
+  {"minute":45,"injuryTime":2,"type":"PENALTY","team":{"id":61},"scorer":{"name":"Cole Palmer"}}],
+ "bookings":[{"minute":30,"team":{"id":61},"player":{"name":"Moisés Caicedo"},"card":"YELLOW_RED"}],
+ "substitutions":[{"minute":60,"team":{"id":57},"playerOut":{"name":"Gabriel Jesus"},"playerIn":{"name":"Kai Havertz"}}]
+}`
+
+const standingsJSON = `{"standings":[
+ {"type":"TOTAL","table":[{"position":1,"team":{"id":57,"name":"Arsenal FC","shortName":"Arsenal"},
+   "playedGames":21,"won":15,"draw":4,"lost":2,"points":49,"goalsFor":45,"goalsAgainst":16,"goalDifference":29}]},
+ {"type":"HOME","table":[{"position":1,"team":{"id":57,"name":"Arsenal FC"}}]}
+]}`
+
+const scorersJSON = `{"scorers":[
+ {"player":{"id":38101,"name":"Erling Haaland"},"team":{"id":65,"name":"Manchester City FC"},"goals":19,"assists":2},
+ {"player":{"id":3754,"name":"Mohamed Salah"},"team":{"id":64,"name":"Liverpool FC"},"goals":12,"assists":8},
+ {"player":{"id":8004,"name":"Yoane Wissa"},"team":{"id":402,"name":"Brentford FC"},"goals":9,"assists":null}
+]}`
+
+// newStub serves the canned responses and records request paths and the
+// auth header.
+func newStub(t *testing.T) (*Client, *[]string) {
+	t.Helper()
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path+"?"+r.URL.RawQuery)
+		if r.Header.Get("X-Auth-Token") != "secret" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch r.URL.Path {
+		case "/v4/matches":
+			_, _ = w.Write([]byte(matchesJSON))
+		case "/v4/matches/1":
+			_, _ = w.Write([]byte(matchJSON))
+		case "/v4/competitions/PL/standings":
+			_, _ = w.Write([]byte(standingsJSON))
+		case "/v4/competitions/PL/scorers":
+			_, _ = w.Write([]byte(scorersJSON))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	c := NewClient(srv.URL+"/v4/", "secret")
+	c.SetRateLimiter(ratelimit.New(0))
+	return c, &paths
+}
+
+func TestMatchesByDateForLeagues(t *testing.T) {
+	c, paths := newStub(t)
+	date := time.Date(2026, 1, 7, 0, 0, 0, 0, time.UTC)
+
+	results, err := c.MatchesByDateForLeagues(context.Background(), date, []string{"results"}, []int{47, 9999})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := (*paths)[0]; got != "/v4/matches?competitions=PL&dateFrom=2026-01-07&dateTo=2026-01-07" {
+		t.Errorf("request = %s", got)
+	}
+	if len(results) != 1 {
+		t.Fatalf("results = %+v, want the finished match only", results)
+	}
+	m := results[0]
+	if m.League.ID != 47 || m.HomeTeam.ShortName != "Arsenal" || *m.HomeScore != 2 || *m.LiveTime != "FT" || m.Round != "21" {
+		t.Errorf("match = %+v", m)
+	}
+
+	fixtures, err := c.MatchesByDateForLeagues(context.Background(), date, []string{"fixtures"}, []int{47})
+	if err != nil || len(fixtures) != 2 {
+		t.Fatalf("fixtures = %+v (%v), want the live and scheduled matches", fixtures, err)
+	}
+	if fixtures[0].Status != api.MatchStatusLive || *fixtures[0].LiveTime != "67" || fixtures[0].HomeTeam.ShortName != "Manchester City FC" {
+		t.Errorf("live match = %+v", fixtures[0])
+	}
+	if fixtures[1].HomeScore != nil {
+		t.Error("a match that has not started should have no score")
+	}
+
+	n := len(*paths)
+	if got, err := c.MatchesByDateForLeagues(context.Background(), date, []string{"results"}, []int{9999}); err != nil || len(got) != 0 || len(*paths) != n {
+		t.Errorf("unsupported league: %+v (%v), %d requests; want empty without a request", got, err, len(*paths)-n)
+	}
+}
+
+func TestMatchDetails(t *testing.T) {
+	c, _ := newStub(t)
+	d, err := c.MatchDetails(context.Background(), 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Venue != "Emirates Stadium" || d.Referee != "Michael Oliver" || d.Winner == nil || *d.Winner != "home" ||
+		*d.HalfTimeScore.Home != 1 || d.HomeFormation != "4-3-3" || len(d.HomeStarting) != 1 || d.HomeStarting[0].Number != 22 {
+		t.Errorf("details = %+v", d)
+	}
+
+	want := []struct {
+		minute  int
+		typ     string
+		display string
+	}{
+		{12, "goal", "12'"}, {30, "card", "30'"}, {45, "goal", "45+2'"}, {60, "substitution", "60'"}, {80, "goal", "80'"},
+	}
+	if len(d.Events) != len(want) {
+		t.Fatalf("events = %+v", d.Events)
+	}
+	for i, w := range want {
+		e := d.Events[i]
+		if e.Minute != w.minute || e.Type != w.typ || e.DisplayMinute != w.display {
+			t.Errorf("event %d = %d %s %s, want %+v", i, e.Minute, e.Type, e.DisplayMinute, w)
+		}
+	}
+	if e := d.Events[0]; *e.Player != "Bukayo Saka" || *e.Assist != "Martin Ødegaard" || e.Team.Name != "Arsenal FC" {
+		t.Errorf("goal = %+v", e)
+	}
+	if e := d.Events[1]; *e.EventType != "red" || e.Team.ShortName != "Chelsea" {
+		t.Errorf("second yellow = %+v, want a red card for Chelsea", e)
+	}
+	if e := d.Events[3]; *e.Player != "Gabriel Jesus" || *e.Assist != "Kai Havertz" || *e.EventType != "sub" {
+		t.Errorf("substitution = %+v", e)
+	}
+	if e := d.Events[4]; e.OwnGoal == nil || !*e.OwnGoal {
+		t.Errorf("own goal = %+v", e)
+	}
+
+	if _, err := c.MatchDetails(context.Background(), 2); !errors.Is(err, ErrNotFound) {
+		t.Errorf("unknown match: err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestLeagueTable(t *testing.T) {
+	c, _ := newStub(t)
+	table, err := c.LeagueTableWithParent(context.Background(), 47, "Premier League", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(table) != 1 || table[0].Points != 49 || table[0].Drawn != 4 || table[0].GoalDifference != 29 {
+		t.Errorf("table = %+v, want the TOTAL standings only", table)
+	}
+
+	if _, err := c.LeagueTable(context.Background(), 9999, ""); !errors.Is(err, api.ErrUnsupported) {
+		t.Errorf("unsupported league: err = %v", err)
+	}
+	if _, err := c.WorldCupData(context.Background(), "2022"); !errors.Is(err, api.ErrUnsupported) {
+		t.Errorf("world cup: err = %v", err)
+	}
+}
+
+func TestLeagueTopStats(t *testing.T) {
+	c, paths := newStub(t)
+	assists, err := c.LeagueTopStats(context.Background(), 47, "2025/2026", api.StatAssists)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(assists) != 2 || assists[0].PlayerName != "Mohamed Salah" || assists[0].Value != 8 || assists[0].Rank != 1 || assists[1].Rank != 2 {
+		t.Errorf("assists = %+v, want Salah then Haaland, scorers without assists dropped", assists)
+	}
+	if got := (*paths)[len(*paths)-1]; got != "/v4/competitions/PL/scorers?limit=50&season=2025" {
+		t.Errorf("request = %q, want the 2025 season", got)
+	}
+
+	if _, err := c.LeagueTopStats(context.Background(), 47, "", api.StatRating); !errors.Is(err, api.ErrUnsupported) {
+		t.Errorf("rating: err = %v, want ErrUnsupported", err)
+	}
+}
+
+func TestGet_HTTPErrors(t *testing.T) {
+	c, _ := newStub(t)
+	c.token = "wrong"
+	_, err := c.MatchDetails(context.Background(), 1)
+	if err == nil || errors.Is(err, ErrNotFound) {
+		t.Errorf("403: err = %v, want a plain upstream error", err)
+	}
+}
@@ -0,0 +1,46 @@
+package footballdata
+
+// competitionCodes maps golazo league IDs (FotMob's numbering, used by
+// settings.yaml and the CLI's --league flag) to football-data.org competition
+// codes. Only these leagues are available through this provider.
+var competitionCodes = map[int]string{
+	47:  "PL",  // Premier League
+	48:  "ELC", // EFL Championship
+	87:  "PD",  // La Liga
+	54:  "BL1", // Bundesliga
+	55:  "SA",  // Serie A
+	53:  "FL1", // Ligue 1
+	57:  "DED", // Eredivisie
+	61:  "PPL", // Primeira Liga
+	42:  "CL",  // UEFA Champions League
+	50:  "EC",  // UEFA Euro
+	77:  "WC",  // FIFA World Cup
+	268: "BSA", // Brasileirão Série A
+}
+
+// leagueIDs is the reverse of competitionCodes.
+var leagueIDs = func() map[string]int {
+	m := make(map[string]int, len(competitionCodes))
+	for id, code := range competitionCodes {
+		m[code] = id
+	}
+	return m
+}()
+
+// CompetitionCode returns the football-data.org code for a golazo league ID.
+func CompetitionCode(leagueID int) (string, bool) {
+	code, ok := competitionCodes[leagueID]
+	return code, ok
+}
+
+// SupportedLeagues filters leagueIDs down to the ones this provider serves,
+// keeping their order.
+func SupportedLeagues(ids []int) []int {
+	out := make([]int, 0, len(ids))
+	for _, id := range ids {
+		if _, ok := competitionCodes[id]; ok {
+			out = append(out, id)
+		}
+	}
+	return out
+}
@@ -0,0 +1,356 @@
+package footballdata
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// Wire types for the football-data.org v4 REST API. Only the fields golazo
+// renders are decoded.
+
+type fdArea struct {
+	Name string `json:"name"`
+	Code string `json:"code"`
+}
+
+type fdCompetition struct {
+	ID     int    `json:"id"`
+	Name   string `json:"name"`
+	Code   string `json:"code"`
+	Emblem string `json:"emblem"`
+}
+
+type fdTeam struct {
+	ID        int        `json:"id"`
+	Name      string     `json:"name"`
+	ShortName string     `json:"shortName"`
+	Crest     string     `json:"crest"`
+	Formation string     `json:"formation"`
+	Lineup    []fdPlayer `json:"lineup"`
+	Bench     []fdPlayer `json:"bench"`
+}
+
+type fdPlayer struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Position    string `json:"position"`
+	ShirtNumber int    `json:"shirtNumber"`
+}
+
+// fdScorer is an entry of a competition's scorers list.
+type fdScorer struct {
+	Player  fdPlayer `json:"player"`
+	Team    fdTeam   `json:"team"`
+	Goals   int      `json:"goals"`
+	Assists *int     `json:"assists"`
+}
+
+type fdScorePair struct {
+	Home *int `json:"home"`
+	Away *int `json:"away"`
+}
+
+type fdScore struct {
+	Winner    string      `json:"winner"`   // HOME_TEAM, AWAY_TEAM, DRAW
+	Duration  string      `json:"duration"` // REGULAR, EXTRA_TIME, PENALTY_SHOOTOUT
+	FullTime  fdScorePair `json:"fullTime"`
+	HalfTime  fdScorePair `json:"halfTime"`
+	Penalties fdScorePair `json:"penalties"`
+}
+
+type fdGoal struct {
+	Minute     int       `json:"minute"`
+	InjuryTime *int      `json:"injuryTime"`
+	Type       string    `json:"type"` // REGULAR, OWN, PENALTY
+	Team       fdTeam    `json:"team"`
+	Scorer     *fdPlayer `json:"scorer"`
+	Assist     *fdPlayer `json:"assist"`
+}
+
+type fdBooking struct {
+	Minute int      `json:"minute"`
+	Team   fdTeam   `json:"team"`
+	Player fdPlayer `json:"player"`
+	Card   string   `json:"card"` // YELLOW, YELLOW_RED, RED
+}
+
+type fdSubstitution struct {
+	Minute    int      `json:"minute"`
+	Team      fdTeam   `json:"team"`
+	PlayerOut fdPlayer `json:"playerOut"`
+	PlayerIn  fdPlayer `json:"playerIn"`
+}
+
+type fdReferee struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type fdMatch struct {
+	ID            int              `json:"id"`
+	UTCDate       string           `json:"utcDate"`
+	Status        string           `json:"status"`
+	Minute        *int             `json:"minute"`
+	InjuryTime    *int             `json:"injuryTime"`
+	Matchday      int              `json:"matchday"`
+	Stage         string           `json:"stage"`
+	Area          fdArea           `json:"area"`
+	Competition   fdCompetition    `json:"competition"`
+	HomeTeam      fdTeam           `json:"homeTeam"`
+	AwayTeam      fdTeam           `json:"awayTeam"`
+	Score         fdScore          `json:"score"`
+	Venue         string           `json:"venue"`
+	Attendance    int              `json:"attendance"`
+	Referees      []fdReferee      `json:"referees"`
+	Goals         []fdGoal         `json:"goals"`
+	Bookings      []fdBooking      `json:"bookings"`
+	Substitutions []fdSubstitution `json:"substitutions"`
+}
+
+type fdMatchesResponse struct {
+	Matches []fdMatch `json:"matches"`
+}
+
+type fdCompetitionsResponse struct {
+	Competitions []struct {
+		fdCompetition
+		Area fdArea `json:"area"`
+	} `json:"competitions"`
+}
+
+type fdStandingsResponse struct {
+	Standings []struct {
+		Type  string `json:"type"` // TOTAL, HOME, AWAY
+		Table []struct {
+			Position       int    `json:"position"`
+			Team           fdTeam `json:"team"`
+			PlayedGames    int    `json:"playedGames"`
+			Won            int    `json:"won"`
+			Draw           int    `json:"draw"`
+			Lost           int    `json:"lost"`
+			Points         int    `json:"points"`
+			GoalsFor       int    `json:"goalsFor"`
+			GoalsAgainst   int    `json:"goalsAgainst"`
+			GoalDifference int    `json:"goalDifference"`
+		} `json:"table"`
+	} `json:"standings"`
+}
+
+// status maps a football-data.org match status to golazo's.
+func status(s string) api.MatchStatus {
+	switch s {
+	case "IN_PLAY", "PAUSED", "EXTRA_TIME", "PENALTY_SHOOTOUT", "LIVE":
+		return api.MatchStatusLive
+	case "FINISHED", "AWARDED":
+		return api.MatchStatusFinished
+	case "POSTPONED", "SUSPENDED":
+		return api.MatchStatusPostponed
+	case "CANCELLED":
+		return api.MatchStatusCancelled
+	default: // SCHEDULED, TIMED
+		return api.MatchStatusNotStarted
+	}
+}
+
+func (t fdTeam) toAPI() api.Team {
+	short := t.ShortName
+	if short == "" {
+		short = t.Name
+	}
+	return api.Team{ID: t.ID, Name: t.Name, ShortName: short, Logo: t.Crest}
+}
+
+func (m fdMatch) toAPIMatch() api.Match {
+	match := api.Match{
+		ID: m.ID,
+		League: api.League{
+			ID:          leagueIDs[m.Competition.Code],
+			Name:        m.Competition.Name,
+			Country:     m.Area.Name,
+			CountryCode: m.Area.Code,
+			Logo:        m.Competition.Emblem,
+		},
+		HomeTeam: m.HomeTeam.toAPI(),
+		AwayTeam: m.AwayTeam.toAPI(),
+		Status:   status(m.Status),
+	}
+	if match.League.ID == 0 {
+		match.League.ID = m.Competition.ID
+	}
+	if t, err := time.Parse(time.RFC3339, m.UTCDate); err == nil {
+		match.MatchTime = &t
+	}
+	if match.Status != api.MatchStatusNotStarted {
+		match.HomeScore = m.Score.FullTime.Home
+		match.AwayScore = m.Score.FullTime.Away
+	}
+	switch {
+	case m.Status == "PAUSED":
+		lt := "HT"
+		match.LiveTime = &lt
+	case match.Status == api.MatchStatusFinished:
+		lt := "FT"
+		match.LiveTime = &lt
+	case match.Status == api.MatchStatusLive && m.Minute != nil:
+		lt := strconv.Itoa(*m.Minute)
+		if m.InjuryTime != nil && *m.InjuryTime > 0 {
+			lt += "+" + strconv.Itoa(*m.InjuryTime)
+		}
+		match.LiveTime = &lt
+	}
+	if m.Matchday > 0 {
+		match.Round = strconv.Itoa(m.Matchday)
+	}
+	return match
+}
+
+func (m fdMatch) toAPIMatchDetails() *api.MatchDetails {
+	details := &api.MatchDetails{
+		Match:         m.toAPIMatch(),
+		Venue:         m.Venue,
+		Attendance:    m.Attendance,
+		HomeFormation: m.HomeTeam.Formation,
+		AwayFormation: m.AwayTeam.Formation,
+		MatchDuration: 90,
+	}
+	if m.Score.HalfTime.Home != nil || m.Score.HalfTime.Away != nil {
+		details.HalfTimeScore = &struct {
+			Home *int `json:"home,omitempty"`
+			Away *int `json:"away,omitempty"`
+		}{Home: m.Score.HalfTime.Home, Away: m.Score.HalfTime.Away}
+	}
+	if m.Score.Duration == "EXTRA_TIME" || m.Score.Duration == "PENALTY_SHOOTOUT" {
+		details.ExtraTime = true
+		details.MatchDuration = 120
+	}
+	if m.Score.Penalties.Home != nil || m.Score.Penalties.Away != nil {
+		details.Penalties = &struct {
+			Home *int `json:"home,omitempty"`
+			Away *int `json:"away,omitempty"`
+		}{Home: m.Score.Penalties.Home, Away: m.Score.Penalties.Away}
+	}
+	if details.Status == api.MatchStatusFinished {
+		switch m.Score.Winner {
+		case "HOME_TEAM":
+			w := "home"
+			details.Winner = &w
+		case "AWAY_TEAM":
+			w := "away"
+			details.Winner = &w
+		}
+	}
+	for _, r := range m.Referees {
+		if r.Type == "REFEREE" || r.Type == "" {
+			details.Referee = r.Name
+			break
+		}
+	}
+	details.HomeStarting = players(m.HomeTeam.Lineup)
+	details.AwayStarting = players(m.AwayTeam.Lineup)
+	details.HomeSubstitutes = players(m.HomeTeam.Bench)
+	details.AwaySubstitutes = players(m.AwayTeam.Bench)
+	for _, p := range m.HomeTeam.Lineup {
+		details.HomeLineup = append(details.HomeLineup, p.Name)
+	}
+	for _, p := range m.AwayTeam.Lineup {
+		details.AwayLineup = append(details.AwayLineup, p.Name)
+	}
+	details.Events = m.events()
+	return details
+}
+
+// events converts goals, bookings and substitutions into golazo's event
+// shape, using the same type strings as the FotMob client: "goal", "card"
+// (event type "yellow"/"red") and "substitution" (event type "sub", Player
+// going off and Assist coming on).
+func (m fdMatch) events() []api.MatchEvent {
+	teams := map[int]api.Team{m.HomeTeam.ID: m.HomeTeam.toAPI(), m.AwayTeam.ID: m.AwayTeam.toAPI()}
+	team := func(t fdTeam) api.Team {
+		if full, ok := teams[t.ID]; ok {
+			return full
+		}
+		return t.toAPI()
+	}
+	now := time.Now()
+	events := make([]api.MatchEvent, 0, len(m.Goals)+len(m.Bookings)+len(m.Substitutions))
+
+	for _, g := range m.Goals {
+		e := api.MatchEvent{
+			Minute:        g.Minute,
+			DisplayMinute: displayMinute(g.Minute, g.InjuryTime),
+			Type:          "goal",
+			Team:          team(g.Team),
+			Timestamp:     now,
+		}
+		if g.Scorer != nil {
+			e.Player = &g.Scorer.Name
+		}
+		if g.Assist != nil && g.Assist.Name != "" {
+			e.Assist = &g.Assist.Name
+		}
+		if g.Type == "OWN" {
+			own := true
+			e.OwnGoal = &own
+		}
+		if g.Type == "PENALTY" {
+			detail := "penalty"
+			e.EventType = &detail
+		}
+		events = append(events, e)
+	}
+	for _, b := range m.Bookings {
+		card := "yellow"
+		if b.Card == "RED" || b.Card == "YELLOW_RED" {
+			card = "red"
+		}
+		events = append(events, api.MatchEvent{
+			Minute:        b.Minute,
+			DisplayMinute: displayMinute(b.Minute, nil),
+			Type:          "card",
+			Team:          team(b.Team),
+			Player:        &b.Player.Name,
+			EventType:     &card,
+			Timestamp:     now,
+		})
+	}
+	for _, s := range m.Substitutions {
+		detail := "sub"
+		events = append(events, api.MatchEvent{
+			Minute:        s.Minute,
+			DisplayMinute: displayMinute(s.Minute, nil),
+			Type:          "substitution",
+			Team:          team(s.Team),
+			Player:        &s.PlayerOut.Name,
+			Assist:        &s.PlayerIn.Name,
+			EventType:     &detail,
+			Timestamp:     now,
+		})
+	}
+	for i := range events {
+		events[i].ID = i + 1
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Minute < events[j].Minute })
+	return events
+}
+
+func displayMinute(minute int, injury *int) string {
+	if injury != nil && *injury > 0 {
+		return fmt.Sprintf("%d+%d'", minute, *injury)
+	}
+	return fmt.Sprintf("%d'", minute)
+}
+
+func players(in []fdPlayer) []api.PlayerInfo {
+	if len(in) == 0 {
+		return nil
+	}
+	out := make([]api.PlayerInfo, 0, len(in))
+	for _, p := range in {
+		out = append(out, api.PlayerInfo{ID: p.ID, Name: p.Name, Number: p.ShirtNumber, Position: p.Position})
+	}
+	return out
+}
@@ -48,6 +48,7 @@ func pageCacheTestClient(t *testing.T, ttl time.Duration) (*Client, *atomic.Int3
 
 	client := &Client{
 		httpClient:    &http.Client{Transport: transport, Timeout: 5 * time.Second},
+		siteURL:       siteURL,
 		baseURL:       baseURL,
 		rateLimiter:   ratelimit.New(0),
 		cache:         NewResponseCache(cfg),
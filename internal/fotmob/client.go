@@ -3,20 +3,24 @@ package fotmob
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"slices"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/cassette"
 	"github.com/0xjuanma/golazo/internal/data"
 	"github.com/0xjuanma/golazo/internal/ratelimit"
 )
 
 const (
-	baseURL = "https://www.fotmob.com/api"
+	siteURL = "https://www.fotmob.com"
+	baseURL = siteURL + "/api"
 )
 
 // ActiveLeagues returns the league IDs to use for API calls.
@@ -33,20 +37,46 @@ var SupportedLeagues = data.AllLeagueIDs()
 // Client implements the api.Client interface for FotMob API
 type Client struct {
 	httpClient    *http.Client
+	resilient     *resilientTransport // Retries and per-host circuit breakers beneath httpClient
+	siteURL       string // Origin serving the league, match and World Cup pages
 	baseURL       string
 	rateLimiter   *ratelimit.Limiter
 	cache         *ResponseCache
 	emptyCache    *EmptyResultsCache // Persistent cache for empty league+date combinations
 	pageURLs      map[int]string     // Match ID -> page slug mapping for page-based fetching
 	pageURLsMu    sync.RWMutex
+	pageURLStore  *PageURLCache // Persistent match ID -> page slug mapping (nil if unavailable)
+	detailsStore  *DetailsStore // Persistent details of finished matches (nil if unavailable)
+	detailsFlight flightGroup[int, *api.MatchDetails] // In-flight MatchDetails fetches by match ID
+	pageFlight    flightGroup[int, json.RawMessage]   // In-flight league page fetches by league ID
+	listingFlight flightGroup[string, []api.Match]    // In-flight date listings by date, tabs and leagues
 	maxConcurrent chan struct{} // Semaphore to limit concurrent API requests
 	logger        *slog.Logger // Optional debug logger (no-op if nil)
 }
 
+// Rate limiter keys. Page HTML and the JSON API are paced in separate
+// buckets so a burst of league page fetches does not starve API calls.
+const (
+	limitKeyAPI  = "api"
+	limitKeyPage = "page"
+)
+
+// newRateLimiter returns the default limiter: one request per 200ms per key,
+// with a small burst so the first league pages of a listing go out at once.
+func newRateLimiter() *ratelimit.Limiter {
+	l := ratelimit.New(200 * time.Millisecond)
+	l.SetLimit(limitKeyPage, ratelimit.Limit{Interval: 200 * time.Millisecond, Burst: 5})
+	l.SetLimit(limitKeyAPI, ratelimit.Limit{Interval: 200 * time.Millisecond, Burst: 3})
+	return l
+}
+
 // NewClient creates a new FotMob API client with default configuration.
-// Includes minimal rate limiting (200ms between requests) for fast concurrent requests.
+// Rate limits each request kind to one per 200ms, after a burst of 5 page
+// and 3 API requests (see newRateLimiter).
+// Transient failures are retried per DefaultRetryPolicy, behind a per-host circuit breaker.
 // Uses default caching configuration for improved performance.
 // Initializes persistent empty results cache to skip known empty league+date combinations.
+// Loads persisted page slugs so match details work for IDs seen by earlier runs.
 func NewClient() *Client {
 	// Initialize empty results cache (logs error but doesn't fail)
 	emptyCache, err := NewEmptyResultsCache()
@@ -55,22 +85,74 @@ func NewClient() *Client {
 		emptyCache = nil
 	}
 
-	return &Client{
-		httpClient: &http.Client{
-			Timeout: 15 * time.Second,
-			Transport: &http.Transport{
-				MaxIdleConns:        30,
-				MaxIdleConnsPerHost: 30,
-				IdleConnTimeout:     90 * time.Second,
-			},
-		},
+	pageURLStore, err := NewPageURLCache()
+	if err != nil {
+		pageURLStore = nil
+	}
+
+	detailsStore, err := NewDetailsStore()
+	if err != nil {
+		detailsStore = nil
+	}
+
+	c := &Client{
+		siteURL:       siteURL,
 		baseURL:       baseURL,
-		rateLimiter:   ratelimit.New(200 * time.Millisecond), // Minimal delay for concurrent requests
+		rateLimiter:   newRateLimiter(),
 		cache:         NewResponseCache(DefaultCacheConfig()),
 		emptyCache:    emptyCache,
 		pageURLs:      make(map[int]string, 50),
+		pageURLStore:  pageURLStore,
+		detailsStore:  detailsStore,
 		maxConcurrent: make(chan struct{}, 10),
 	}
+	c.resilient = newResilientTransport(&http.Transport{
+		MaxIdleConns:        30,
+		MaxIdleConnsPerHost: 30,
+		IdleConnTimeout:     90 * time.Second,
+	}, DefaultRetryPolicy(), c.debugLog)
+	c.httpClient = &http.Client{
+		Timeout:   15 * time.Second,
+		Transport: cassette.Wrap(c.resilient),
+	}
+	return c
+}
+
+// SetTransport replaces the HTTP transport beneath the retry and circuit
+// breaker layer, e.g. with a cassette.Transport for record/replay. NewClient
+// already wraps its transport for the mode set by --record/--replay or
+// GOLAZO_RECORD/GOLAZO_REPLAY.
+func (c *Client) SetTransport(rt http.RoundTripper) {
+	c.resilient.next = rt
+}
+
+// SetRetryPolicy replaces the retry and circuit breaker settings. Breakers
+// keep their current state.
+func (c *Client) SetRetryPolicy(p RetryPolicy) {
+	c.resilient.mu.Lock()
+	defer c.resilient.mu.Unlock()
+	c.resilient.policy = p
+}
+
+// SetBaseURL points the client at another FotMob origin, e.g. a
+// fotmobtest.Server. site has no trailing slash; pages are fetched from it
+// and the JSON API from site+"/api".
+func (c *Client) SetBaseURL(site string) {
+	c.siteURL = strings.TrimSuffix(site, "/")
+	c.baseURL = c.siteURL + "/api"
+}
+
+// SetRateLimiter replaces the limiter that spaces out requests. The client
+// waits on the "api" key before JSON API calls and on the "page" key before
+// page HTML fetches; keys without a limit of their own use l's default.
+func (c *Client) SetRateLimiter(l *ratelimit.Limiter) {
+	c.rateLimiter = l
+}
+
+// RateLimitStats returns the rate limiter's per-key counters: requests
+// granted, requests delayed, time spent waiting and waits cancelled.
+func (c *Client) RateLimitStats() []ratelimit.Stats {
+	return c.rateLimiter.Stats()
 }
 
 // SetLogger sets the debug logger for the client.
@@ -91,6 +173,7 @@ func (c *Client) Cache() *ResponseCache {
 }
 
 // StorePageURL stores a match page URL slug for later use by MatchDetails.
+// The slug is also recorded in the persistent store; call SavePageURLs to flush it.
 func (c *Client) StorePageURL(matchID int, pageURL string) {
 	if pageURL == "" {
 		return
@@ -98,13 +181,21 @@ func (c *Client) StorePageURL(matchID int, pageURL string) {
 	c.pageURLsMu.Lock()
 	c.pageURLs[matchID] = pageURL
 	c.pageURLsMu.Unlock()
+	if c.pageURLStore != nil {
+		c.pageURLStore.Set(matchID, pageURL)
+	}
 }
 
-// getPageURL retrieves the stored page URL slug for a match ID.
+// getPageURL retrieves the stored page URL slug for a match ID, falling back
+// to slugs persisted by earlier runs.
 func (c *Client) getPageURL(matchID int) string {
 	c.pageURLsMu.RLock()
-	defer c.pageURLsMu.RUnlock()
-	return c.pageURLs[matchID]
+	slug := c.pageURLs[matchID]
+	c.pageURLsMu.RUnlock()
+	if slug == "" && c.pageURLStore != nil {
+		slug = c.pageURLStore.Get(matchID)
+	}
+	return slug
 }
 
 // SaveEmptyCache persists the empty results cache to disk.
@@ -139,18 +230,57 @@ func (c *Client) MatchesByDate(ctx context.Context, date time.Time) ([]api.Match
 // This allows optimizing API calls - e.g., only query "results" for past days.
 // Results are cached per date (cache key includes all tabs for that date).
 func (c *Client) MatchesByDateWithTabs(ctx context.Context, date time.Time, tabs []string) ([]api.Match, error) {
+	return c.matchesByDate(ctx, date, tabs, nil)
+}
+
+// MatchesByDateForLeagues is MatchesByDateWithTabs restricted to leagueIDs,
+// which need not be in the user's active selection. The per-date cache holds
+// the active-league union, so filtered queries neither read nor populate it;
+// the per-league empty results cache still applies. An empty leagueIDs is
+// the same as MatchesByDateWithTabs.
+func (c *Client) MatchesByDateForLeagues(ctx context.Context, date time.Time, tabs []string, leagueIDs []int) ([]api.Match, error) {
+	if len(leagueIDs) == 0 {
+		return c.MatchesByDateWithTabs(ctx, date, tabs)
+	}
+	return c.matchesByDate(ctx, date, tabs, leagueIDs)
+}
+
+// matchesByDate implements MatchesByDateWithTabs (leagueIDs nil: active
+// leagues, cached) and MatchesByDateForLeagues. Concurrent identical
+// listings share one fetch; each caller gets its own copy of the slice.
+func (c *Client) matchesByDate(ctx context.Context, date time.Time, tabs []string, leagueIDs []int) ([]api.Match, error) {
 	// Normalize date to UTC for consistent comparison
 	requestDateStr := date.UTC().Format("2006-01-02")
+	fullSet := leagueIDs == nil
 
 	// Check cache first (only if querying both tabs - full cache)
-	if len(tabs) == 2 {
+	if fullSet && len(tabs) == 2 {
 		if cached := c.cache.Matches(requestDateStr); cached != nil {
+			c.listingFlight.hit()
 			return cached, nil
 		}
 	}
 
+	key := fmt.Sprintf("%s|%s|%v", requestDateStr, strings.Join(tabs, ","), leagueIDs)
+	matches, shared, err := c.listingFlight.do(ctx, key, func() ([]api.Match, error) {
+		return c.fetchMatchesByDate(ctx, requestDateStr, tabs, leagueIDs)
+	})
+	if shared {
+		c.debugLog("matchesByDate: shared in-flight listing", "key", key, "shared_total", c.listingFlight.shared.Load())
+		matches = slices.Clone(matches)
+	}
+	return matches, err
+}
+
+// fetchMatchesByDate queries the league pages for matchesByDate.
+func (c *Client) fetchMatchesByDate(ctx context.Context, requestDateStr string, tabs []string, leagueIDs []int) ([]api.Match, error) {
+	fullSet := leagueIDs == nil
+
 	// Get active leagues (respects user settings)
-	activeLeagues := ActiveLeagues()
+	activeLeagues := leagueIDs
+	if fullSet {
+		activeLeagues = ActiveLeagues()
+	}
 
 	// Use a mutex to protect the shared slice
 	var mu sync.Mutex
@@ -164,6 +294,13 @@ func (c *Client) MatchesByDateWithTabs(ctx context.Context, date time.Time, tabs
 	// Track skipped leagues for logging/debugging
 	var skippedFromCache int
 
+	// A listing is only an error when no league page could be fetched because
+	// the circuit breaker is open; other failures degrade to fewer matches.
+	var (
+		fetched    int
+		circuitErr error
+	)
+
 	// Determine which statuses to include based on requested tabs
 	wantFinished := false
 	wantLive := false
@@ -178,10 +315,14 @@ func (c *Client) MatchesByDateWithTabs(ctx context.Context, date time.Time, tabs
 		}
 	}
 
+	useEmptyCache := c.emptyCache != nil && emptyCacheEligible(wantFinished, wantNotStarted, requestDateStr, time.Now().UTC().Format("2006-01-02"))
+	// Fixtures-only scans keep their own short-lived markers (see MarkEmptyFixtures)
+	fixturesOnly := wantNotStarted && !wantFinished
+
 	// Query each league by fetching its page (the old /api/leagues JSON endpoint is gone)
 	for _, leagueID := range activeLeagues {
 		// Check empty cache before spawning goroutine
-		if wantFinished && !wantLive && !wantNotStarted && c.emptyCache != nil && c.emptyCache.IsEmpty(requestDateStr, leagueID) {
+		if useEmptyCache && c.isEmptyCached(fixturesOnly, requestDateStr, leagueID) {
 			skippedFromCache++
 			continue
 		}
@@ -196,8 +337,16 @@ func (c *Client) MatchesByDateWithTabs(ctx context.Context, date time.Time, tabs
 			pageProps, err := c.fetchLeaguePage(ctx, id)
 			if err != nil {
 				// Skip this league on error - best effort aggregation
+				if errors.Is(err, ErrCircuitOpen) {
+					mu.Lock()
+					circuitErr = err
+					mu.Unlock()
+				}
 				return
 			}
+			mu.Lock()
+			fetched++
+			mu.Unlock()
 
 			var leagueResponse struct {
 				Details struct {
@@ -276,9 +425,13 @@ func (c *Client) MatchesByDateWithTabs(ctx context.Context, date time.Time, tabs
 				leagueMatches = append(leagueMatches, apiMatch)
 			}
 
-			// Mark league+date as empty if no finished matches found (results-only query)
-			if len(leagueMatches) == 0 && wantFinished && !wantLive && c.emptyCache != nil {
-				c.emptyCache.MarkEmpty(requestDateStr, id)
+			// Mark league+date as empty if nothing matched (results-only or future fixtures-only query)
+			if len(leagueMatches) == 0 && useEmptyCache {
+				if fixturesOnly {
+					c.emptyCache.MarkEmptyFixtures(requestDateStr, id)
+				} else {
+					c.emptyCache.MarkEmpty(requestDateStr, id)
+				}
 			}
 
 			// Append to shared slice with mutex protection
@@ -293,8 +446,21 @@ func (c *Client) MatchesByDateWithTabs(ctx context.Context, date time.Time, tabs
 
 	wg.Wait()
 
+	// Leagues skipped because ctx ended make the listing partial. Return the
+	// context error so it is neither cached nor shared with coalesced
+	// waiters, which refetch with their own context instead.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	if fetched == 0 && circuitErr != nil {
+		return nil, circuitErr
+	}
+
 	// Cache the results before returning
-	c.cache.SetMatches(requestDateStr, allMatches)
+	if fullSet {
+		c.cache.SetMatches(requestDateStr, allMatches)
+	}
 
 	// Persist empty results cache to disk (best-effort)
 	_ = c.SaveEmptyCache()
@@ -302,6 +468,31 @@ func (c *Client) MatchesByDateWithTabs(ctx context.Context, date time.Time, tabs
 	return allMatches, nil
 }
 
+// isEmptyCached checks the empty results cache for a results-only query, or
+// the separate fixtures markers for a fixtures-only one.
+func (c *Client) isEmptyCached(fixturesOnly bool, date string, leagueID int) bool {
+	if fixturesOnly {
+		return c.emptyCache.IsEmptyFixtures(date, leagueID)
+	}
+	return c.emptyCache.IsEmpty(date, leagueID)
+}
+
+// emptyCacheEligible reports whether a MatchesByDateWithTabs query may consult
+// and populate the empty results cache. Results-only queries (past days) are
+// always eligible. Fixtures-only queries are eligible only for dates after
+// today: today's fixtures drain into results as matches finish, so an empty
+// fixtures answer for today says nothing about today's results.
+func emptyCacheEligible(wantFinished, wantFixtures bool, requestDateStr, todayStr string) bool {
+	switch {
+	case wantFinished && !wantFixtures:
+		return true
+	case wantFixtures && !wantFinished:
+		return requestDateStr > todayStr
+	default:
+		return false
+	}
+}
+
 // MatchesForLeagueAndDate fetches matches for a single league on a specific date.
 // Used for progressive loading - allows fetching one league at a time.
 func (c *Client) MatchesForLeagueAndDate(ctx context.Context, leagueID int, date time.Time, tab string) ([]api.Match, error) {
@@ -395,7 +586,8 @@ func (c *Client) MatchesForLeagueAndDate(ctx context.Context, leagueID int, date
 }
 
 // MatchDetails retrieves detailed information about a specific match.
-// Results are cached to avoid redundant API calls.
+// Results are cached to avoid redundant API calls. Details of finished
+// matches are also kept on disk, so later processes skip the network for them.
 //
 // Uses page-based fetching (match page HTML with __NEXT_DATA__) as the primary
 // method. FotMob removed their /api/matchDetails JSON endpoint (returns 404).
@@ -403,20 +595,48 @@ func (c *Client) MatchesForLeagueAndDate(ctx context.Context, leagueID int, date
 func (c *Client) MatchDetails(ctx context.Context, matchID int) (*api.MatchDetails, error) {
 	// Check cache first
 	if cached := c.cache.Details(matchID); cached != nil {
+		c.detailsFlight.hit()
 		c.debugLog("MatchDetails: cache hit", "matchID", matchID)
 		return cached, nil
 	}
+	if c.detailsStore != nil {
+		if stored := c.detailsStore.Get(matchID); stored != nil {
+			c.detailsFlight.hit()
+			c.cache.SetDetails(matchID, stored)
+			c.debugLog("MatchDetails: disk cache hit", "matchID", matchID)
+			return stored, nil
+		}
+	}
 
-	// Apply rate limiting
-	c.rateLimiter.Wait()
+	// Prefetching, live polling and the stats view can ask for the same
+	// match at once; they share one fetch.
+	details, shared, err := c.detailsFlight.do(ctx, matchID, func() (*api.MatchDetails, error) {
+		return c.fetchMatchDetails(ctx, matchID)
+	})
+	if shared {
+		c.debugLog("MatchDetails: shared in-flight fetch", "matchID", matchID, "shared_total", c.detailsFlight.shared.Load())
+	}
+	return details, err
+}
 
+// fetchMatchDetails fetches details over the network for MatchDetails.
+func (c *Client) fetchMatchDetails(ctx context.Context, matchID int) (*api.MatchDetails, error) {
 	// Try page-based fetching first (primary method)
 	pageSlug := c.getPageURL(matchID)
 	if pageSlug != "" {
+		if err := c.rateLimiter.WaitKey(ctx, limitKeyPage); err != nil {
+			return nil, err
+		}
 		c.debugLog("MatchDetails: fetching from page", "matchID", matchID, "pageSlug", pageSlug)
-		details, err := fetchMatchDetailsFromPage(ctx, c.httpClient, pageSlug)
+		details, err := fetchMatchDetailsFromPage(ctx, c.httpClient, c.siteURL, pageSlug)
 		if err == nil && details != nil {
-			c.cache.SetDetails(matchID, details)
+			c.storeDetails(matchID, details)
+			if details.HeadToHead != nil {
+				// Keep the meetings' slugs so fetching one later skips the lookup.
+				for _, m := range details.HeadToHead.Matches {
+					c.StorePageURL(m.ID, m.PageURL)
+				}
+			}
 			c.debugLog("MatchDetails: page fetch success", "matchID", matchID, "events", len(details.Events))
 			return details, nil
 		}
@@ -433,6 +653,10 @@ func (c *Client) MatchDetails(ctx context.Context, matchID int) (*api.MatchDetai
 // This endpoint currently returns 404 (FotMob removed it). Kept as a last-resort
 // fallback in case the endpoint is restored or for match IDs without a page URL.
 func (c *Client) matchDetailsFromAPI(ctx context.Context, matchID int) (*api.MatchDetails, error) {
+	if err := c.rateLimiter.WaitKey(ctx, limitKeyAPI); err != nil {
+		return nil, err
+	}
+
 	url := fmt.Sprintf("%s/matchDetails?matchId=%d", c.baseURL, matchID)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
@@ -461,13 +685,26 @@ func (c *Client) matchDetailsFromAPI(ctx context.Context, matchID int) (*api.Mat
 	details := response.toAPIMatchDetails()
 
 	// Cache the result
-	c.cache.SetDetails(matchID, details)
+	c.storeDetails(matchID, details)
 
 	return details, nil
 }
 
-// MatchDetailsForceRefresh fetches match details, bypassing the cache.
-// Use this for polling live matches to ensure fresh data.
+// storeDetails caches details in memory and, once the match is finished,
+// on disk.
+func (c *Client) storeDetails(matchID int, details *api.MatchDetails) {
+	c.cache.SetDetails(matchID, details)
+	if c.detailsStore == nil {
+		return
+	}
+	if err := c.detailsStore.Put(matchID, details); err != nil {
+		c.debugLog("MatchDetails: disk cache write failed", "matchID", matchID, "error", err)
+	}
+}
+
+// MatchDetailsForceRefresh fetches match details, bypassing the in-memory
+// cache. Use this for polling live matches to ensure fresh data. Finished
+// matches stored on disk are still served from there, as they cannot change.
 func (c *Client) MatchDetailsForceRefresh(ctx context.Context, matchID int) (*api.MatchDetails, error) {
 	c.cache.ClearMatchDetails(matchID)
 	return c.MatchDetails(ctx, matchID)
@@ -546,14 +783,52 @@ func (c *Client) Leagues(ctx context.Context) ([]api.League, error) {
 	return []api.League{}, nil
 }
 
-// LeagueMatches retrieves matches for a specific league.
+// LeagueMatches retrieves every match of a league's season from its (cached)
+// league page, storing their page slugs.
 func (c *Client) LeagueMatches(ctx context.Context, leagueID int) ([]api.Match, error) {
-	// This would require a different endpoint structure
-	// For now, we'll return an empty slice
-	// In a real implementation, you'd use: /api/leagues?id={leagueID}
-	return []api.Match{}, nil
+	// The league page lists every match of the season in fixtures.allMatches.
+	pageProps, err := c.fetchLeaguePage(ctx, leagueID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch league %d page: %w", leagueID, err)
+	}
+
+	var leagueResponse struct {
+		Details struct {
+			ID          int    `json:"id"`
+			Name        string `json:"name"`
+			Country     string `json:"country"`
+			CountryCode string `json:"countryCode,omitempty"`
+		} `json:"details"`
+		Fixtures struct {
+			AllMatches []fotmobMatch `json:"allMatches"`
+		} `json:"fixtures"`
+	}
+	if err := json.Unmarshal(pageProps, &leagueResponse); err != nil {
+		return nil, fmt.Errorf("decode league %d response: %w", leagueID, err)
+	}
+
+	now := time.Now()
+	matches := make([]api.Match, 0, len(leagueResponse.Fixtures.AllMatches))
+	for _, m := range leagueResponse.Fixtures.AllMatches {
+		if m.League.ID == 0 {
+			m.League = league{
+				ID:          leagueResponse.Details.ID,
+				Name:        leagueResponse.Details.Name,
+				Country:     leagueResponse.Details.Country,
+				CountryCode: leagueResponse.Details.CountryCode,
+			}
+		}
+		apiMatch := m.toAPIMatch()
+		c.storeSeasonPageURL(apiMatch, now)
+		matches = append(matches, apiMatch)
+	}
+	return matches, nil
 }
 
+// ErrNoStandings is returned when a league page carries no table data (cups,
+// friendlies, or a sub-season league without its own standings).
+var ErrNoStandings = errors.New("no table data available")
+
 // parentLeagueByName maps league name patterns to their parent league IDs.
 // Some competitions have sub-leagues for different stages/seasons that don't have
 // their own standings - we detect these by name and use the parent league.
@@ -663,7 +938,7 @@ func (c *Client) fetchLeagueTable(ctx context.Context, leagueID int) ([]api.Leag
 	}
 
 	if len(tableData) == 0 {
-		return nil, fmt.Errorf("no table data available for league %d", leagueID)
+		return nil, fmt.Errorf("%w for league %d", ErrNoStandings, leagueID)
 	}
 
 	entries := make([]api.LeagueTableEntry, 0, len(tableData))
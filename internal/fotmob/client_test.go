@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"path/filepath"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -291,3 +292,108 @@ func TestResponseCache_Matches(t *testing.T) {
 		t.Errorf("cached matches len = %d, want 2", len(got))
 	}
 }
+
+func TestEmptyCacheEligible(t *testing.T) {
+	const today = "2026-03-10"
+	tests := []struct {
+		name         string
+		wantFinished bool
+		wantFixtures bool
+		date         string
+		want         bool
+	}{
+		{"results only, past day", true, false, "2026-03-08", true},
+		{"fixtures only, future day", false, true, "2026-03-12", true},
+		{"fixtures only, today", false, true, today, false},
+		{"fixtures only, past day", false, true, "2026-03-09", false},
+		{"both tabs, today", true, true, today, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := emptyCacheEligible(tt.wantFinished, tt.wantFixtures, tt.date, today); got != tt.want {
+				t.Errorf("emptyCacheEligible(%v, %v, %q) = %v, want %v", tt.wantFinished, tt.wantFixtures, tt.date, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchesByDate_FixturesMarkerDoesNotHideResults(t *testing.T) {
+	client := newTestClient("")
+	client.emptyCache = &EmptyResultsCache{
+		filePath: filepath.Join(t.TempDir(), EmptyCacheFileName),
+		data:     EmptyCacheData{Version: 1, EmptyResults: make(map[string]EmptyCacheEntry)},
+	}
+	// An earlier fixtures scan found nothing scheduled on the day...
+	client.emptyCache.MarkEmptyFixtures("2026-03-10", 47)
+
+	// ...but a match was moved onto it and has since finished.
+	finished := true
+	var page struct {
+		Details struct {
+			ID int `json:"id"`
+		} `json:"details"`
+		Fixtures struct {
+			AllMatches []fotmobMatch `json:"allMatches"`
+		} `json:"fixtures"`
+	}
+	page.Details.ID = 47
+	page.Fixtures.AllMatches = []fotmobMatch{{
+		ID:     "1",
+		Status: status{UTCTime: "2026-03-10T15:00:00Z", Started: &finished, Finished: &finished},
+	}}
+	body, _ := json.Marshal(page)
+	client.cache.SetPage(47, body)
+
+	date := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	got, err := client.MatchesByDateForLeagues(context.Background(), date, []string{"results"}, []int{47})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0].ID != 1 {
+		t.Errorf("results = %+v, want match 1 despite the fixtures marker", got)
+	}
+	if client.emptyCache.IsEmpty("2026-03-10", 47) {
+		t.Error("fixtures marker leaked into the results key")
+	}
+	if entry := client.emptyCache.data.EmptyResults["fixtures:2026-03-10:47"]; time.Until(entry.Expires) > EmptyFixturesExpiry {
+		t.Errorf("fixtures marker expires %v, want within %v", entry.Expires, EmptyFixturesExpiry)
+	}
+}
+
+func TestMatchesByDateForLeagues_OnlyFetchesRequestedLeagues(t *testing.T) {
+	client := newTestClient("")
+	finished := true
+	seed := func(leagueID int, matchID string) {
+		var page struct {
+			Details struct {
+				ID   int    `json:"id"`
+				Name string `json:"name"`
+			} `json:"details"`
+			Fixtures struct {
+				AllMatches []fotmobMatch `json:"allMatches"`
+			} `json:"fixtures"`
+		}
+		page.Details.ID = leagueID
+		page.Details.Name = "League"
+		page.Fixtures.AllMatches = []fotmobMatch{{
+			ID:     matchID,
+			Status: status{UTCTime: "2026-03-10T15:00:00Z", Started: &finished, Finished: &finished},
+		}}
+		body, _ := json.Marshal(page)
+		client.cache.SetPage(leagueID, body)
+	}
+	seed(47, "1")
+	seed(87, "2")
+
+	date := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	got, err := client.MatchesByDateForLeagues(context.Background(), date, []string{"fixtures", "results"}, []int{47})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(got) != 1 || got[0].ID != 1 || got[0].League.ID != 47 {
+		t.Errorf("matches = %+v, want only match 1 from league 47", got)
+	}
+	if cached := client.cache.Matches("2026-03-10"); cached != nil {
+		t.Errorf("filtered query populated the per-date cache: %+v", cached)
+	}
+}
@@ -0,0 +1,111 @@
+package fotmob
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+// RequestStats counts how one kind of request was served since the client
+// was created: from a cache, by a fetch of its own, or by sharing a fetch
+// another caller already had in flight.
+type RequestStats struct {
+	Kind      string
+	CacheHits int64
+	Fetches   int64
+	Shared    int64
+}
+
+// Kinds of coalesced requests reported by RequestStats.
+const (
+	requestKindDetails    = "match_details"
+	requestKindLeaguePage = "league_page"
+	requestKindListing    = "date_listing"
+)
+
+// flightGroup coalesces concurrent calls with the same key: the first caller
+// runs the fetch and later callers wait for its result instead of issuing
+// their own request. The zero value is ready to use.
+type flightGroup[K comparable, V any] struct {
+	mu    sync.Mutex
+	calls map[K]*flightCall[V]
+
+	hits    atomic.Int64
+	fetches atomic.Int64
+	shared  atomic.Int64
+}
+
+type flightCall[V any] struct {
+	done chan struct{}
+	val  V
+	err  error
+}
+
+// do returns fn's result for key, running fn only if no call for key is in
+// flight. shared reports whether the result came from another caller's call.
+//
+// fn runs with the first caller's context. If that caller gives up, waiters
+// whose own context is still live retry rather than inherit its
+// cancellation. A waiter whose context ends stops waiting immediately.
+func (g *flightGroup[K, V]) do(ctx context.Context, key K, fn func() (V, error)) (val V, shared bool, err error) {
+	for {
+		g.mu.Lock()
+		if call, ok := g.calls[key]; ok {
+			g.mu.Unlock()
+			select {
+			case <-call.done:
+			case <-ctx.Done():
+				return val, true, ctx.Err()
+			}
+			if isContextErr(call.err) && ctx.Err() == nil {
+				continue
+			}
+			g.shared.Add(1)
+			return call.val, true, call.err
+		}
+		if g.calls == nil {
+			g.calls = make(map[K]*flightCall[V])
+		}
+		call := &flightCall[V]{done: make(chan struct{})}
+		g.calls[key] = call
+		g.mu.Unlock()
+
+		g.fetches.Add(1)
+		call.val, call.err = fn()
+
+		g.mu.Lock()
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(call.done)
+		return call.val, false, call.err
+	}
+}
+
+// hit records a request served from a cache without reaching do.
+func (g *flightGroup[K, V]) hit() {
+	g.hits.Add(1)
+}
+
+func (g *flightGroup[K, V]) stats(kind string) RequestStats {
+	return RequestStats{
+		Kind:      kind,
+		CacheHits: g.hits.Load(),
+		Fetches:   g.fetches.Load(),
+		Shared:    g.shared.Load(),
+	}
+}
+
+func isContextErr(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// RequestStats reports cache hits, fetches and coalesced requests for match
+// details, league pages and date listings.
+func (c *Client) RequestStats() []RequestStats {
+	return []RequestStats{
+		c.detailsFlight.stats(requestKindDetails),
+		c.pageFlight.stats(requestKindLeaguePage),
+		c.listingFlight.stats(requestKindListing),
+	}
+}
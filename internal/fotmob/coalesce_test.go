@@ -0,0 +1,201 @@
+package fotmob
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/ratelimit"
+)
+
+// waitForCall blocks until key has a call in flight.
+func waitForCall(t *testing.T, g *flightGroup[int, string], key int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for {
+		g.mu.Lock()
+		_, ok := g.calls[key]
+		g.mu.Unlock()
+		if ok {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("no call in flight")
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestFlightGroup_SharesOneCall(t *testing.T) {
+	var g flightGroup[int, string]
+	var calls atomic.Int32
+	release := make(chan struct{})
+	fn := func() (string, error) {
+		calls.Add(1)
+		<-release
+		return "details", nil
+	}
+
+	type result struct {
+		val    string
+		shared bool
+	}
+	results := make(chan result, 3)
+	go func() {
+		v, shared, _ := g.do(context.Background(), 1, fn)
+		results <- result{v, shared}
+	}()
+	waitForCall(t, &g, 1)
+	for i := 0; i < 2; i++ {
+		go func() {
+			v, shared, _ := g.do(context.Background(), 1, fn)
+			results <- result{v, shared}
+		}()
+	}
+	time.Sleep(10 * time.Millisecond) // let the followers join
+	close(release)
+
+	sharedCount := 0
+	for i := 0; i < 3; i++ {
+		r := <-results
+		if r.val != "details" {
+			t.Errorf("val = %q", r.val)
+		}
+		if r.shared {
+			sharedCount++
+		}
+	}
+	if calls.Load() != 1 || sharedCount != 2 {
+		t.Errorf("calls = %d, shared = %d; want 1 call shared by 2 callers", calls.Load(), sharedCount)
+	}
+	g.hit()
+	if s := g.stats("x"); s.Fetches != 1 || s.Shared != 2 || s.CacheHits != 1 {
+		t.Errorf("stats = %+v", s)
+	}
+
+	// Once the call is done, the next caller fetches again.
+	if _, shared, _ := g.do(context.Background(), 1, func() (string, error) { return "again", nil }); shared {
+		t.Error("a call after completion should not be shared")
+	}
+}
+
+func TestFlightGroup_CancelledLeaderDoesNotFailWaiters(t *testing.T) {
+	var g flightGroup[int, string]
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderDone := make(chan error, 1)
+	go func() {
+		_, _, err := g.do(leaderCtx, 1, func() (string, error) {
+			<-leaderCtx.Done()
+			return "", leaderCtx.Err()
+		})
+		leaderDone <- err
+	}()
+	waitForCall(t, &g, 1)
+
+	waiterDone := make(chan string, 1)
+	go func() {
+		v, _, err := g.do(context.Background(), 1, func() (string, error) { return "retried", nil })
+		if err != nil {
+			v = err.Error()
+		}
+		waiterDone <- v
+	}()
+	time.Sleep(10 * time.Millisecond)
+	cancelLeader()
+
+	if err := <-leaderDone; !errors.Is(err, context.Canceled) {
+		t.Errorf("leader err = %v, want context.Canceled", err)
+	}
+	if got := <-waiterDone; got != "retried" {
+		t.Errorf("waiter got %q, want its own fetch after the leader gave up", got)
+	}
+}
+
+func TestFlightGroup_WaiterStopsOnItsOwnContext(t *testing.T) {
+	var g flightGroup[int, string]
+	release := make(chan struct{})
+	defer close(release)
+	go func() {
+		_, _, _ = g.do(context.Background(), 1, func() (string, error) {
+			<-release
+			return "late", nil
+		})
+	}()
+	waitForCall(t, &g, 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, _, err := g.do(ctx, 1, func() (string, error) { return "", nil }); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want the waiter's deadline", err)
+	}
+}
+
+func TestMatchesByDate_CancelledLeaderListingNotShared(t *testing.T) {
+	var requests atomic.Int32
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if requests.Add(1) == 1 {
+			// The leader's page fetch hangs until its caller gives up.
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		}
+		body := `<html><script id="__NEXT_DATA__" type="application/json">{"props":{"pageProps":{"details":{"id":47,"name":"Premier League"},"fixtures":{"allMatches":[` +
+			`{"id":"1","pageUrl":"/matches/a-vs-b/x","status":{"utcTime":"2026-03-10T15:00:00Z","started":true,"finished":true}}]}}}}</script></html>`
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    req,
+			Header:     make(http.Header),
+		}, nil
+	})
+	client := &Client{
+		httpClient:    &http.Client{Transport: transport, Timeout: 5 * time.Second},
+		siteURL:       siteURL,
+		baseURL:       baseURL,
+		rateLimiter:   ratelimit.New(0),
+		cache:         NewResponseCache(DefaultCacheConfig()),
+		pageURLs:      make(map[int]string, 10),
+		maxConcurrent: make(chan struct{}, 10),
+	}
+	date := time.Date(2026, 3, 10, 0, 0, 0, 0, time.UTC)
+	tabs := []string{"results"}
+
+	leaderCtx, cancelLeader := context.WithCancel(context.Background())
+	leaderDone := make(chan error, 1)
+	go func() {
+		_, err := client.MatchesByDateForLeagues(leaderCtx, date, tabs, []int{47})
+		leaderDone <- err
+	}()
+	deadline := time.Now().Add(time.Second)
+	for requests.Load() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("leader never fetched")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	type result struct {
+		matches []api.Match
+		err     error
+	}
+	waiterDone := make(chan result, 1)
+	go func() {
+		m, err := client.MatchesByDateForLeagues(context.Background(), date, tabs, []int{47})
+		waiterDone <- result{m, err}
+	}()
+	time.Sleep(10 * time.Millisecond) // let the waiter join the leader's listing
+	cancelLeader()
+
+	if err := <-leaderDone; !errors.Is(err, context.Canceled) {
+		t.Errorf("leader err = %v, want context.Canceled", err)
+	}
+	r := <-waiterDone
+	if r.err != nil || len(r.matches) != 1 || r.matches[0].ID != 1 {
+		t.Errorf("waiter got %+v (%v), want its own full listing", r.matches, r.err)
+	}
+}
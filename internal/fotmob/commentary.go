@@ -0,0 +1,139 @@
+package fotmob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// commentaryFeedURL is the live ticker file FotMob's match page loads
+// through /api/ltc. Only the English feed is requested.
+const commentaryFeedURL = "data.fotmob.com/webcl/ltc/gsm/%d_en.json.gz"
+
+// fotmobCommentary is the /api/ltc response.
+type fotmobCommentary struct {
+	Events []fotmobCommentaryEvent `json:"events"`
+}
+
+// fotmobCommentaryEvent is one live ticker line.
+type fotmobCommentaryEvent struct {
+	Elapsed     int    `json:"elapsed"`
+	ElapsedPlus int    `json:"elapsedPlus"`
+	Type        string `json:"type"`
+	Text        string `json:"text"`
+	IsHomeTeam  *bool  `json:"isHomeTeam"`
+}
+
+// Commentary retrieves the live commentary for a match in match order. When
+// sinceID names an entry from an earlier call, only the entries after it are
+// returned; an unknown sinceID (e.g. the feed was rewritten) returns them all.
+// Matches without commentary return no entries and no error.
+func (c *Client) Commentary(ctx context.Context, matchID int, sinceID string) ([]api.CommentaryEntry, error) {
+	if err := c.rateLimiter.WaitKey(ctx, limitKeyAPI); err != nil {
+		return nil, err
+	}
+
+	q := url.Values{}
+	q.Set("ltcUrl", fmt.Sprintf(commentaryFeedURL, matchID))
+	reqURL := c.baseURL + "/ltc?" + q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, "GET", reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create commentary request for match %d: %w", matchID, err)
+	}
+	req.Header.Set("User-Agent", "Mozilla/5.0")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch commentary for match %d: %w", matchID, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		c.debugLog("Commentary: no feed", "matchID", matchID)
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d for match %d commentary", resp.StatusCode, matchID)
+	}
+
+	var feed fotmobCommentary
+	if err := json.NewDecoder(resp.Body).Decode(&feed); err != nil {
+		return nil, fmt.Errorf("decode commentary for match %d: %w", matchID, err)
+	}
+
+	entries := feed.toAPICommentary()
+	c.debugLog("Commentary: fetched", "matchID", matchID, "entries", len(entries))
+	return entriesAfter(entries, sinceID), nil
+}
+
+// toAPICommentary converts the feed to entries in match order. FotMob lists
+// the newest line first. IDs are assigned once the entries are in match
+// order, so a repeated line keeps its occurrence number as the feed grows.
+func (f fotmobCommentary) toAPICommentary() []api.CommentaryEntry {
+	entries := make([]api.CommentaryEntry, 0, len(f.Events))
+	for _, e := range f.Events {
+		entry := api.CommentaryEntry{
+			Minute:      e.Elapsed,
+			AddedMinute: e.ElapsedPlus,
+			Type:        e.Type,
+			Text:        e.Text,
+			IsHome:      e.IsHomeTeam,
+		}
+		if e.Elapsed > 0 {
+			entry.DisplayMinute = strconv.Itoa(e.Elapsed) + "'"
+			if e.ElapsedPlus > 0 {
+				entry.DisplayMinute = fmt.Sprintf("%d+%d'", e.Elapsed, e.ElapsedPlus)
+			}
+		}
+		entries = append(entries, entry)
+	}
+	if n := len(entries); n > 1 && later(entries[0], entries[n-1]) {
+		slices.Reverse(entries)
+	}
+	occurrences := make(map[uint64]int, len(entries))
+	for i := range entries {
+		key := commentaryHash(entries[i])
+		entries[i].ID = strconv.FormatUint(key, 16) + "-" + strconv.Itoa(occurrences[key])
+		occurrences[key]++
+	}
+	return entries
+}
+
+// later reports whether a happened after b.
+func later(a, b api.CommentaryEntry) bool {
+	if a.Minute != b.Minute {
+		return a.Minute > b.Minute
+	}
+	return a.AddedMinute > b.AddedMinute
+}
+
+// commentaryHash hashes the line's content; the feed has no IDs of its own.
+// Identical lines in the same minute share a hash, so toAPICommentary adds
+// their occurrence number to the ID.
+func commentaryHash(e api.CommentaryEntry) uint64 {
+	h := fnv.New64a()
+	_, _ = fmt.Fprintf(h, "%d|%d|%s|%s", e.Minute, e.AddedMinute, e.Type, e.Text)
+	return h.Sum64()
+}
+
+// entriesAfter returns the entries following the one with sinceID, or all of
+// them when sinceID is empty or not found.
+func entriesAfter(entries []api.CommentaryEntry, sinceID string) []api.CommentaryEntry {
+	if sinceID == "" {
+		return entries
+	}
+	for i, e := range entries {
+		if e.ID == sinceID {
+			return entries[i+1:]
+		}
+	}
+	return entries
+}
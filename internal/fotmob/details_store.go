@@ -0,0 +1,305 @@
+package fotmob
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+const (
+	// DetailsStoreDirName is the cache subdirectory holding one file per
+	// finished match.
+	DetailsStoreDirName = "match-details"
+	// DetailsStoreVersion is the schema version written to every file. Files
+	// with another version are discarded on read. Bump it in the same change
+	// as any api.MatchDetails shape change, or finished matches cached by
+	// older builds are served without the new fields forever.
+	// 2: head-to-head. 3: momentum, shot map and xG timeline. 4: lineup
+	// players' match stats. 5: stoppage time in the xG timeline.
+	DetailsStoreVersion = 5
+	// DetailsStoreMaxBytes caps the store's total size. A full match page
+	// parses to roughly 20-40 KB, so this keeps a couple of seasons of
+	// browsing before the least recently used matches are evicted.
+	DetailsStoreMaxBytes = 64 << 20
+)
+
+// DetailsStore persists details of finished matches on disk, one JSON file
+// per match ID. Finished matches never change, so entries have no TTL; the
+// store is bounded by size instead and evicts the least recently used
+// matches first. Unreadable, truncated or outdated files are treated as
+// misses and removed.
+type DetailsStore struct {
+	mu       sync.Mutex
+	dir      string
+	maxBytes int64
+	entries  map[int]*storedDetails
+	total    int64
+}
+
+// storedDetails is the in-memory index entry for one file.
+type storedDetails struct {
+	size     int64
+	lastUsed time.Time
+}
+
+// detailsFile is the JSON structure of one file on disk.
+type detailsFile struct {
+	Version int               `json:"version"`
+	Saved   time.Time         `json:"saved"`
+	Details *api.MatchDetails `json:"details"`
+}
+
+// DetailsStoreDir returns the directory backing the store.
+func DetailsStoreDir() (string, error) {
+	cacheDir, err := data.CacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(cacheDir, DetailsStoreDirName), nil
+}
+
+// NewDetailsStore opens the store under the cache directory, creating it if
+// needed, and indexes the files already there.
+func NewDetailsStore() (*DetailsStore, error) {
+	dir, err := DetailsStoreDir()
+	if err != nil {
+		return nil, err
+	}
+	return openDetailsStore(dir, DetailsStoreMaxBytes)
+}
+
+// OpenDetailsStore opens the store like NewDetailsStore but fails instead of
+// creating a missing directory. Used by `golazo cache`.
+func OpenDetailsStore() (*DetailsStore, error) {
+	dir, err := DetailsStoreDir()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := os.Stat(dir); err != nil {
+		return nil, err
+	}
+	return openDetailsStore(dir, DetailsStoreMaxBytes)
+}
+
+func openDetailsStore(dir string, maxBytes int64) (*DetailsStore, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	s := &DetailsStore{
+		dir:      dir,
+		maxBytes: maxBytes,
+		entries:  make(map[int]*storedDetails),
+	}
+
+	files, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		if f.IsDir() {
+			continue
+		}
+		info, err := f.Info()
+		if err != nil {
+			continue
+		}
+		id, ok := detailsFileID(f.Name())
+		if !ok {
+			// Temp files left by an interrupted write, or strays. Recent ones
+			// may belong to another process that is still writing.
+			if time.Since(info.ModTime()) > time.Minute {
+				_ = os.Remove(filepath.Join(dir, f.Name()))
+			}
+			continue
+		}
+		s.entries[id] = &storedDetails{size: info.Size(), lastUsed: info.ModTime()}
+		s.total += info.Size()
+	}
+	return s, nil
+}
+
+// Get returns the stored details for a match, or nil if none are stored or
+// the file cannot be used. Files written by other processes since the store
+// was opened are found too.
+func (s *DetailsStore) Get(matchID int) *api.MatchDetails {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	details := s.readLocked(matchID)
+	if details == nil {
+		return nil
+	}
+	now := time.Now()
+	s.entries[matchID].lastUsed = now
+	_ = os.Chtimes(s.path(matchID), now, now) // keeps LRU order across processes
+	return details
+}
+
+// readLocked loads and validates a match's file, removing it when it is
+// corrupted, has another schema version or holds another match. Must hold mu.
+func (s *DetailsStore) readLocked(matchID int) *api.MatchDetails {
+	raw, err := os.ReadFile(s.path(matchID))
+	if err != nil {
+		s.removeLocked(matchID)
+		return nil
+	}
+	details := decodeDetailsFile(raw, matchID)
+	if details == nil {
+		s.removeLocked(matchID)
+		return nil
+	}
+	if _, ok := s.entries[matchID]; !ok {
+		s.entries[matchID] = &storedDetails{size: int64(len(raw)), lastUsed: time.Now()}
+		s.total += int64(len(raw))
+	}
+	return details
+}
+
+// decodeDetailsFile returns the details in raw, or nil if raw is corrupted,
+// has another schema version or holds another match.
+func decodeDetailsFile(raw []byte, matchID int) *api.MatchDetails {
+	var f detailsFile
+	if err := json.Unmarshal(raw, &f); err != nil || f.Version != DetailsStoreVersion ||
+		f.Details == nil || f.Details.ID != matchID {
+		return nil
+	}
+	return f.Details
+}
+
+// Put stores details for a finished match and evicts the least recently used
+// matches while the store is over its size cap. Details of matches that are
+// not finished are ignored.
+func (s *DetailsStore) Put(matchID int, details *api.MatchDetails) error {
+	if details == nil || details.Status != api.MatchStatusFinished {
+		return nil
+	}
+	raw, err := json.Marshal(detailsFile{Version: DetailsStoreVersion, Saved: time.Now(), Details: details})
+	if err != nil {
+		return fmt.Errorf("encode match %d: %w", matchID, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// Write to a temp file and rename, so a crash never leaves a torn file.
+	tmp, err := os.CreateTemp(s.dir, strconv.Itoa(matchID)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(raw); err != nil {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), s.path(matchID)); err != nil {
+		_ = os.Remove(tmp.Name())
+		return err
+	}
+
+	if old, ok := s.entries[matchID]; ok {
+		s.total -= old.size
+	}
+	s.entries[matchID] = &storedDetails{size: int64(len(raw)), lastUsed: time.Now()}
+	s.total += int64(len(raw))
+	s.evictLocked()
+	return nil
+}
+
+// Len returns the number of stored matches and their total size in bytes.
+func (s *DetailsStore) Len() (entries int, bytes int64) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return len(s.entries), s.total
+}
+
+// Stats reads every stored file without changing the store and returns how
+// many there are and how many are unusable (the ones Prune would remove
+// besides size-cap evictions). Used by `golazo cache stats`.
+func (s *DetailsStore) Stats() (total int, unusable int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id := range s.entries {
+		total++
+		raw, err := os.ReadFile(s.path(id))
+		if err != nil || decodeDetailsFile(raw, id) == nil {
+			unusable++
+		}
+	}
+	return total, unusable
+}
+
+// Prune reads every stored file, removes the ones that are corrupted or use
+// another schema version, and enforces the size cap. Returns how many files
+// were removed. Used by `golazo cache prune`.
+func (s *DetailsStore) Prune() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	before := len(s.entries)
+	for id := range s.entries {
+		_ = s.readLocked(id) // drops unusable files
+	}
+	s.evictLocked()
+	return before - len(s.entries)
+}
+
+// evictLocked removes least recently used entries until the store fits
+// maxBytes. Must hold mu.
+func (s *DetailsStore) evictLocked() {
+	if s.total <= s.maxBytes {
+		return
+	}
+	ids := make([]int, 0, len(s.entries))
+	for id := range s.entries {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		return s.entries[ids[i]].lastUsed.Before(s.entries[ids[j]].lastUsed)
+	})
+	for _, id := range ids {
+		if s.total <= s.maxBytes {
+			return
+		}
+		s.removeLocked(id)
+	}
+}
+
+// removeLocked deletes a match's file and index entry. Must hold mu.
+func (s *DetailsStore) removeLocked(matchID int) {
+	if e, ok := s.entries[matchID]; ok {
+		s.total -= e.size
+		delete(s.entries, matchID)
+	}
+	_ = os.Remove(s.path(matchID))
+}
+
+func (s *DetailsStore) path(matchID int) string {
+	return filepath.Join(s.dir, strconv.Itoa(matchID)+".json")
+}
+
+// detailsFileID parses a "<matchID>.json" file name.
+func detailsFileID(name string) (int, bool) {
+	stem, ok := strings.CutSuffix(name, ".json")
+	if !ok {
+		return 0, false
+	}
+	id, err := strconv.Atoi(stem)
+	if err != nil || id <= 0 {
+		return 0, false
+	}
+	return id, true
+}
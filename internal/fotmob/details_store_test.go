@@ -0,0 +1,168 @@
+package fotmob
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+func finishedDetails(id int) *api.MatchDetails {
+	home, away := 3, 1
+	return &api.MatchDetails{
+		Match: api.Match{
+			ID:        id,
+			Status:    api.MatchStatusFinished,
+			HomeTeam:  api.Team{ID: 8456, Name: "Manchester City"},
+			AwayTeam:  api.Team{ID: 10204, Name: "Brighton"},
+			HomeScore: &home,
+			AwayScore: &away,
+		},
+		Venue: "Etihad Stadium",
+	}
+}
+
+func TestDetailsStore_PersistsFinishedMatches(t *testing.T) {
+	dir := t.TempDir()
+	s, err := openDetailsStore(dir, DetailsStoreMaxBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := s.Put(1, finishedDetails(1)); err != nil {
+		t.Fatal(err)
+	}
+	live := finishedDetails(2)
+	live.Status = api.MatchStatusLive
+	if err := s.Put(2, live); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, err := openDetailsStore(dir, DetailsStoreMaxBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := reopened.Get(1)
+	if got == nil || got.Venue != "Etihad Stadium" || *got.HomeScore != 3 {
+		t.Errorf("Get(1) = %+v, want the stored details", got)
+	}
+	if reopened.Get(2) != nil {
+		t.Error("live match details must not be stored")
+	}
+	if n, size := reopened.Len(); n != 1 || size == 0 {
+		t.Errorf("Len = %d, %d bytes; want one entry", n, size)
+	}
+}
+
+func TestDetailsStore_DropsUnusableFiles(t *testing.T) {
+	dir := t.TempDir()
+	version := strconv.Itoa(DetailsStoreVersion)
+	files := map[string]string{
+		"1.json":             `{"version":` + version + `,"details":{"id":1,"status":"fini`,
+		"2.json":             `{"version":99,"details":{"id":2,"status":"finished"}}`,
+		"3.json":             `{"version":` + version + `,"details":{"id":4,"status":"finished"}}`,
+		"5.123.tmp":          `{}`,
+		"notes.txt":          `hello`,
+		"6.json":             `{"version":` + version + `,"details":{"id":6,"status":"finished"}}`,
+		"fresh-write.42.tmp": `{}`,
+	}
+	for name, body := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(body), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	old := time.Now().Add(-time.Hour)
+	for _, name := range []string{"5.123.tmp", "notes.txt"} {
+		_ = os.Chtimes(filepath.Join(dir, name), old, old)
+	}
+
+	s, err := openDetailsStore(dir, DetailsStoreMaxBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []int{1, 2, 3} {
+		if s.Get(id) != nil {
+			t.Errorf("Get(%d): want nil for a truncated, outdated or mismatched file", id)
+		}
+		if _, err := os.Stat(filepath.Join(dir, strconv.Itoa(id)+".json")); !os.IsNotExist(err) {
+			t.Errorf("file for %d should have been removed", id)
+		}
+	}
+	if s.Get(6) == nil {
+		t.Error("Get(6): want the valid entry")
+	}
+	for name, wantGone := range map[string]bool{"5.123.tmp": true, "notes.txt": true, "fresh-write.42.tmp": false} {
+		_, err := os.Stat(filepath.Join(dir, name))
+		if gone := os.IsNotExist(err); gone != wantGone {
+			t.Errorf("%s removed = %v, want %v", name, gone, wantGone)
+		}
+	}
+}
+
+func TestDetailsStore_EvictsLeastRecentlyUsed(t *testing.T) {
+	dir := t.TempDir()
+	probe, err := openDetailsStore(t.TempDir(), DetailsStoreMaxBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := probe.Put(1, finishedDetails(1)); err != nil {
+		t.Fatal(err)
+	}
+	_, entrySize := probe.Len()
+
+	// Room for two entries.
+	s, err := openDetailsStore(dir, 2*entrySize+entrySize/2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, id := range []int{1, 2} {
+		if err := s.Put(id, finishedDetails(id)); err != nil {
+			t.Fatal(err)
+		}
+		time.Sleep(2 * time.Millisecond) // distinct last-used times
+	}
+	if s.Get(1) == nil { // 1 is now more recently used than 2
+		t.Fatal("Get(1) before eviction")
+	}
+	time.Sleep(2 * time.Millisecond)
+	if err := s.Put(3, finishedDetails(3)); err != nil {
+		t.Fatal(err)
+	}
+
+	if s.Get(2) != nil {
+		t.Error("match 2 was least recently used and should have been evicted")
+	}
+	if s.Get(1) == nil || s.Get(3) == nil {
+		t.Error("matches 1 and 3 should remain")
+	}
+	if n, size := s.Len(); n != 2 || size > 2*entrySize+entrySize/2 {
+		t.Errorf("Len = %d, %d bytes; want 2 entries within the cap", n, size)
+	}
+}
+
+func TestDetailsStore_Prune(t *testing.T) {
+	dir := t.TempDir()
+	s, err := openDetailsStore(dir, DetailsStoreMaxBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Put(1, finishedDetails(1)); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "2.json"), []byte("{"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	s, err = openDetailsStore(dir, DetailsStoreMaxBytes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removed := s.Prune(); removed != 1 {
+		t.Errorf("Prune removed %d, want the corrupted file only", removed)
+	}
+	if n, _ := s.Len(); n != 1 {
+		t.Errorf("Len = %d after prune, want 1", n)
+	}
+}
@@ -0,0 +1,62 @@
+package fotmob
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Ping requests the FotMob home page and returns the HTTP status code.
+// Statuses of 400 and above are returned together with an error. Used by
+// `golazo doctor`.
+func (c *Client) Ping(ctx context.Context) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.siteURL+"/", nil)
+	if err != nil {
+		return 0, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/131.0.0.0 Safari/537.36")
+	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
+
+	if err := c.rateLimiter.WaitKey(ctx, limitKeyPage); err != nil {
+		return 0, err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("fetch fotmob.com: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return resp.StatusCode, fmt.Errorf("fotmob.com returned status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// CheckLeaguePage fetches a league page, bypassing the response cache, and
+// verifies that its __NEXT_DATA__ still carries the fixtures list every
+// match listing is built from. Returns the number of matches found. An error
+// here usually means FotMob changed its page shape.
+func (c *Client) CheckLeaguePage(ctx context.Context, leagueID int) (int, error) {
+	if err := c.rateLimiter.WaitKey(ctx, limitKeyPage); err != nil {
+		return 0, err
+	}
+
+	pageProps, err := fetchLeagueFromPage(ctx, c.httpClient, c.siteURL, leagueID, "")
+	if err != nil {
+		return 0, err
+	}
+
+	var page struct {
+		Fixtures *struct {
+			AllMatches []json.RawMessage `json:"allMatches"`
+		} `json:"fixtures"`
+	}
+	if err := json.Unmarshal(pageProps, &page); err != nil {
+		return 0, fmt.Errorf("decode league page props: %w", err)
+	}
+	if page.Fixtures == nil {
+		return 0, fmt.Errorf("league page props have no fixtures")
+	}
+	return len(page.Fixtures.AllMatches), nil
+}
@@ -16,6 +16,13 @@ const (
 	EmptyCacheFileName = "empty-results.json"
 	// EmptyCacheExpiry is the duration after which empty results expire (7 days).
 	EmptyCacheExpiry = 7 * 24 * time.Hour
+	// EmptyFixturesExpiry is the shorter lifetime of empty fixtures markers:
+	// fixtures get added and moved, so "nothing scheduled" goes stale fast.
+	EmptyFixturesExpiry = 24 * time.Hour
+
+	// fixturesKeyPrefix keeps fixtures markers apart from results markers,
+	// so a future date scanned for fixtures never hides its later results.
+	fixturesKeyPrefix = "fixtures:"
 )
 
 // EmptyResultsCache stores date+league combinations that returned 0 matches.
@@ -29,7 +36,7 @@ type EmptyResultsCache struct {
 // EmptyCacheData is the JSON structure stored on disk.
 type EmptyCacheData struct {
 	Version      int                        `json:"version"`
-	EmptyResults map[string]EmptyCacheEntry `json:"empty_results"` // key: "YYYY-MM-DD:leagueID"
+	EmptyResults map[string]EmptyCacheEntry `json:"empty_results"` // key: "YYYY-MM-DD:leagueID", or "fixtures:YYYY-MM-DD:leagueID"
 }
 
 // EmptyCacheEntry represents a cached empty result with expiration.
@@ -41,6 +48,20 @@ type EmptyCacheEntry struct {
 // It loads existing data from the config directory if available.
 // On Linux, uses XDG spec (~/.config/golazo). On other systems, uses ~/.golazo.
 func NewEmptyResultsCache() (*EmptyResultsCache, error) {
+	cache, err := OpenEmptyResultsCache()
+	if err != nil {
+		return nil, err
+	}
+
+	// Clean up expired entries on startup
+	cache.cleanExpired()
+
+	return cache, nil
+}
+
+// OpenEmptyResultsCache loads the cache like NewEmptyResultsCache but keeps
+// expired entries, so Stats reports them. Used by `golazo cache`.
+func OpenEmptyResultsCache() (*EmptyResultsCache, error) {
 	configDir, err := data.ConfigDir()
 	if err != nil {
 		return nil, err
@@ -63,18 +84,24 @@ func NewEmptyResultsCache() (*EmptyResultsCache, error) {
 		}
 	}
 
-	// Clean up expired entries on startup
-	cache.cleanExpired()
-
 	return cache, nil
 }
 
-// IsEmpty checks if a league+date combination is cached as empty.
+// IsEmpty checks if a league+date combination is cached as having no results.
 func (c *EmptyResultsCache) IsEmpty(date string, leagueID int) bool {
+	return c.isEmptyKey(c.makeKey(date, leagueID))
+}
+
+// IsEmptyFixtures checks if a league+date combination is cached as having no
+// fixtures.
+func (c *EmptyResultsCache) IsEmptyFixtures(date string, leagueID int) bool {
+	return c.isEmptyKey(fixturesKeyPrefix + c.makeKey(date, leagueID))
+}
+
+func (c *EmptyResultsCache) isEmptyKey(key string) bool {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
 
-	key := c.makeKey(date, leagueID)
 	entry, exists := c.data.EmptyResults[key]
 	if !exists {
 		return false
@@ -88,14 +115,23 @@ func (c *EmptyResultsCache) IsEmpty(date string, leagueID int) bool {
 	return true
 }
 
-// MarkEmpty marks a league+date combination as having no matches.
+// MarkEmpty marks a league+date combination as having no results.
 func (c *EmptyResultsCache) MarkEmpty(date string, leagueID int) {
+	c.markKey(c.makeKey(date, leagueID), EmptyCacheExpiry)
+}
+
+// MarkEmptyFixtures marks a league+date combination as having no fixtures,
+// for EmptyFixturesExpiry.
+func (c *EmptyResultsCache) MarkEmptyFixtures(date string, leagueID int) {
+	c.markKey(fixturesKeyPrefix+c.makeKey(date, leagueID), EmptyFixturesExpiry)
+}
+
+func (c *EmptyResultsCache) markKey(key string, ttl time.Duration) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	key := c.makeKey(date, leagueID)
 	c.data.EmptyResults[key] = EmptyCacheEntry{
-		Expires: time.Now().Add(EmptyCacheExpiry),
+		Expires: time.Now().Add(ttl),
 	}
 }
 
@@ -122,8 +158,14 @@ func (c *EmptyResultsCache) load() error {
 	return json.Unmarshal(data, &c.data)
 }
 
+// Prune removes expired entries and returns how many were dropped. The
+// caller persists the result with Save.
+func (c *EmptyResultsCache) Prune() int {
+	return c.cleanExpired()
+}
+
 // cleanExpired removes expired entries from the cache.
-func (c *EmptyResultsCache) cleanExpired() {
+func (c *EmptyResultsCache) cleanExpired() (removed int) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
@@ -131,8 +173,10 @@ func (c *EmptyResultsCache) cleanExpired() {
 	for key, entry := range c.data.EmptyResults {
 		if now.After(entry.Expires) {
 			delete(c.data.EmptyResults, key)
+			removed++
 		}
 	}
+	return removed
 }
 
 // makeKey creates a cache key from date and league ID.
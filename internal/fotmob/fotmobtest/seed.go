@@ -0,0 +1,477 @@
+package fotmobtest
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+// IDs and dates of the seeded payloads, for assertions.
+const (
+	// SeedLeagueID is the league whose page carries every seeded match and
+	// the seeded table.
+	SeedLeagueID = 47
+	// SeedDate is the UTC day of the seeded live and finished matches.
+	SeedDate = "2026-01-07"
+	// SeedLiveMatchID is the live match with a seeded match page.
+	SeedLiveMatchID = 4813580
+	// SeedLiveMatchSlug is the page slug of SeedLiveMatchID.
+	SeedLiveMatchSlug = "/matches/everton-vs-wolverhampton-wanderers/2o21"
+	// SeedWorldCupSeason is the season served for the current World Cup.
+	SeedWorldCupSeason = "2022"
+	// SeedTeamID is the team whose page carries a squad. Every team in the
+	// seeded matches has a page with its fixtures.
+	SeedTeamID = 8456
+	// SeedPlayerID is the player with a seeded player page, a member of
+	// SeedTeamID's squad.
+	SeedPlayerID = 30005
+)
+
+//go:embed mock_data.json
+var mockDataJSON []byte
+
+// mockData is the hand-written shape of mock_data.json. It uses numeric IDs
+// and flat events; seed converts it to the page shapes fotmob.com serves.
+type mockData struct {
+	Matches      []mockMatch `json:"matches"`
+	MatchDetails struct {
+		mockMatch
+		Events []mockEvent `json:"events"`
+	} `json:"matchDetails"`
+	LeagueTable struct {
+		Data struct {
+			Table []mockTableRow `json:"table"`
+		} `json:"data"`
+	} `json:"leagueTable"`
+}
+
+type mockMatch struct {
+	ID      int             `json:"id"`
+	Round   string          `json:"round"`
+	PageURL string          `json:"pageUrl"`
+	Home    mockTeam        `json:"home"`
+	Away    mockTeam        `json:"away"`
+	Status  json.RawMessage `json:"status"`
+	League  struct {
+		ID          int    `json:"id"`
+		Name        string `json:"name"`
+		Country     string `json:"country"`
+		CountryCode string `json:"countryCode"`
+	} `json:"league"`
+}
+
+type mockTeam struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	ShortName string `json:"shortName"`
+}
+
+type mockEvent struct {
+	ID            int    `json:"id"`
+	Minute        int    `json:"minute"`
+	DisplayMinute string `json:"display_minute"`
+	Type          string `json:"type"`
+	TeamID        int    `json:"teamId"`
+	Player        string `json:"player"`
+	Assist        string `json:"assist"`
+	EventType     string `json:"eventType"`
+}
+
+type mockTableRow struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	ShortName      string `json:"shortName"`
+	Rank           int    `json:"rank"`
+	Played         int    `json:"played"`
+	Wins           int    `json:"wins"`
+	Draws          int    `json:"draws"`
+	Losses         int    `json:"losses"`
+	GoalsFor       int    `json:"goalsFor"`
+	GoalsAgainst   int    `json:"goalsAgainst"`
+	GoalDifference int    `json:"goalDifference"`
+	Points         int    `json:"points"`
+}
+
+// seed loads mock_data.json into league and match pages and the bundled 2022
+// World Cup data into the World Cup page.
+func (s *Server) seed() error {
+	var mock mockData
+	if err := json.Unmarshal(mockDataJSON, &mock); err != nil {
+		return fmt.Errorf("parse mock_data.json: %w", err)
+	}
+
+	byLeague := make(map[int][]map[string]any)
+	details := make(map[int]map[string]any)
+	teams := make(map[int]mockTeam)
+	teamLeague := make(map[int]mockMatch)
+	teamFixtures := make(map[int][]map[string]any)
+	for _, m := range mock.Matches {
+		for _, t := range []mockTeam{m.Home, m.Away} {
+			teams[t.ID] = t
+			teamLeague[t.ID] = m
+			teamFixtures[t.ID] = append(teamFixtures[t.ID], teamFixture(m))
+		}
+		byLeague[m.League.ID] = append(byLeague[m.League.ID], leagueMatch(m))
+		details[m.League.ID] = map[string]any{
+			"id":          m.League.ID,
+			"name":        m.League.Name,
+			"country":     m.League.Country,
+			"countryCode": m.League.CountryCode,
+		}
+	}
+	for id, matches := range byLeague {
+		page := map[string]any{
+			"details":  details[id],
+			"fixtures": map[string]any{"allMatches": matches},
+		}
+		if id == SeedLeagueID {
+			page["table"] = []any{map[string]any{
+				"data": map[string]any{"table": map[string]any{"all": tableRows(mock.LeagueTable.Data.Table)}},
+			}}
+			page["stats"] = s.seedStatLists(id)
+		}
+		s.SetLeaguePage(id, page)
+	}
+
+	for id, t := range teams {
+		page := map[string]any{
+			"details": map[string]any{
+				"id":                id,
+				"name":              t.Name,
+				"shortName":         t.ShortName,
+				"country":           teamLeague[id].League.Country,
+				"primaryLeagueId":   teamLeague[id].League.ID,
+				"primaryLeagueName": teamLeague[id].League.Name,
+			},
+			"fixtures": map[string]any{"allFixtures": map[string]any{"fixtures": teamFixtures[id]}},
+		}
+		if id == SeedTeamID {
+			page["squad"] = seedSquad
+		}
+		s.SetTeamPage(id, map[string]any{"fallback": map[string]any{"team-" + strconv.Itoa(id): page}})
+	}
+	s.SetPlayerPage(SeedPlayerID, map[string]any{"data": seedPlayer})
+
+	md := mock.MatchDetails
+	s.SetMatchPage(md.PageURL, matchPage(md.mockMatch, md.Events))
+
+	wc := WorldCupPage(data.MockWorldCupData(), s.URL+TopScorersPath)
+	s.SetWorldCupPage("", wc)
+	s.SetWorldCupPage(SeedWorldCupSeason, wc)
+	s.SetTopScorers(TopScorerStats(data.MockWorldCupTopScorers()))
+	return nil
+}
+
+// leagueMatch converts a mock match to a fixtures.allMatches entry. FotMob
+// sends match and team IDs as strings.
+func leagueMatch(m mockMatch) map[string]any {
+	return map[string]any{
+		"id":      strconv.Itoa(m.ID),
+		"round":   m.Round,
+		"pageUrl": m.PageURL,
+		"home":    map[string]any{"id": strconv.Itoa(m.Home.ID), "name": m.Home.Name, "shortName": m.Home.ShortName},
+		"away":    map[string]any{"id": strconv.Itoa(m.Away.ID), "name": m.Away.Name, "shortName": m.Away.ShortName},
+		"status":  m.Status,
+		"league":  m.League,
+	}
+}
+
+// teamFixture converts a mock match to a team page fixtures entry, which
+// unlike league listings carries numeric IDs.
+func teamFixture(m mockMatch) map[string]any {
+	return map[string]any{
+		"id":         m.ID,
+		"pageUrl":    m.PageURL,
+		"home":       m.Home,
+		"away":       m.Away,
+		"status":     m.Status,
+		"tournament": map[string]any{"leagueId": m.League.ID, "name": m.League.Name},
+	}
+}
+
+// seedSquad is SeedTeamID's squad, one member per group.
+var seedSquad = map[string]any{"squad": []map[string]any{
+	{"title": "coach", "members": []map[string]any{{"id": 30001, "name": "Pep Guardiola", "cname": "Spain", "age": 55}}},
+	{"title": "keepers", "members": []map[string]any{{"id": 30002, "name": "Ederson", "shirtNumber": "31", "cname": "Brazil", "age": 32}}},
+	{"title": "defenders", "members": []map[string]any{{"id": 30003, "name": "Ruben Dias", "shirtNumber": 3, "cname": "Portugal", "age": 28}}},
+	{"title": "midfielders", "members": []map[string]any{{"id": 30004, "name": "Rodri", "shirtNumber": 16, "cname": "Spain", "age": 30}}},
+	{"title": "attackers", "members": []map[string]any{{"id": 30005, "name": "Erling Haaland", "shirtNumber": 9, "cname": "Norway", "age": 26}}},
+}}
+
+// seedPlayer is SeedPlayerID's player page data.
+var seedPlayer = map[string]any{
+	"id":                  SeedPlayerID,
+	"name":                "Erling Haaland",
+	"primaryTeam":         map[string]any{"teamId": SeedTeamID, "teamName": "Manchester City"},
+	"positionDescription": map[string]any{"primaryPosition": map[string]any{"label": "Striker"}},
+	"playerInformation": []map[string]any{
+		{"title": "Height", "value": map[string]any{"fallback": "195 cm"}},
+		{"title": "Shirt", "value": map[string]any{"fallback": "9", "numberValue": 9}},
+		{"title": "Age", "value": map[string]any{"fallback": "26", "numberValue": 26}},
+		{"title": "Country", "value": map[string]any{"fallback": "Norway"}},
+	},
+	"mainLeague": map[string]any{
+		"leagueId":   SeedLeagueID,
+		"leagueName": "Premier League",
+		"season":     "2025/2026",
+		"stats": []map[string]any{
+			{"title": "Goals", "localizedTitleId": "goals", "value": 20},
+			{"title": "Assists", "localizedTitleId": "assists", "value": 4},
+			{"title": "Rating", "localizedTitleId": "rating", "value": "7.85"},
+		},
+	},
+}
+
+func tableRows(rows []mockTableRow) []map[string]any {
+	out := make([]map[string]any, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, map[string]any{
+			"id":          r.ID,
+			"name":        r.Name,
+			"shortName":   r.ShortName,
+			"idx":         r.Rank,
+			"played":      r.Played,
+			"wins":        r.Wins,
+			"draws":       r.Draws,
+			"losses":      r.Losses,
+			"scoresStr":   fmt.Sprintf("%d-%d", r.GoalsFor, r.GoalsAgainst),
+			"goalConDiff": r.GoalDifference,
+			"pts":         r.Points,
+		})
+	}
+	return out
+}
+
+// matchPage converts a mock match and its flat events to match page props.
+// Substitutions come as separate "out" and "in" events in the mock and are
+// paired into one FotMob Substitution event with swap [in, out].
+func matchPage(m mockMatch, events []mockEvent) map[string]any {
+	var status struct {
+		Score struct {
+			Home int `json:"home"`
+			Away int `json:"away"`
+		} `json:"score"`
+	}
+	_ = json.Unmarshal(m.Status, &status)
+
+	var out []map[string]any
+	subOut := make(map[string]string) // minute/team -> player going off
+	for _, e := range events {
+		ev := map[string]any{
+			"eventId": e.ID,
+			"time":    e.Minute,
+			"timeStr": strings.TrimSuffix(e.DisplayMinute, "'"),
+			"isHome":  e.TeamID == m.Home.ID,
+		}
+		switch e.Type {
+		case "goal":
+			ev["type"] = "Goal"
+			ev["player"] = map[string]any{"name": e.Player}
+			if e.Assist != "" {
+				ev["assistInput"] = e.Assist
+			}
+		case "card":
+			ev["type"] = "Card"
+			ev["player"] = map[string]any{"name": e.Player}
+			if e.EventType != "" {
+				ev["card"] = strings.ToUpper(e.EventType[:1]) + e.EventType[1:] // "yellow" -> "Yellow"
+			}
+		case "substitution":
+			key := fmt.Sprintf("%d/%d", e.Minute, e.TeamID)
+			if e.EventType == "out" {
+				subOut[key] = e.Player
+				continue
+			}
+			ev["type"] = "Substitution"
+			ev["swap"] = []map[string]string{{"name": e.Player}, {"name": subOut[key]}}
+		default:
+			continue
+		}
+		out = append(out, ev)
+	}
+
+	return map[string]any{
+		"header": map[string]any{
+			"teams": []map[string]any{
+				{"id": m.Home.ID, "name": m.Home.Name, "score": status.Score.Home},
+				{"id": m.Away.ID, "name": m.Away.Name, "score": status.Score.Away},
+			},
+			"status": m.Status,
+		},
+		"general": map[string]any{
+			"matchId":    strconv.Itoa(m.ID),
+			"matchRound": m.Round,
+			"homeTeam":   map[string]any{"id": m.Home.ID, "name": m.Home.Name},
+			"awayTeam":   map[string]any{"id": m.Away.ID, "name": m.Away.Name},
+			"leagueId":   m.League.ID,
+			"leagueName": m.League.Name,
+		},
+		"content": map[string]any{
+			"matchFacts": map[string]any{
+				"events": map[string]any{"events": out},
+			},
+			"h2h": headToHead(m),
+		},
+	}
+}
+
+// headToHead is a match page's h2h section: a fixed summary and two past
+// meetings of m's teams, one at each venue, a year and two years back.
+func headToHead(m mockMatch) map[string]any {
+	meeting := func(id int, home, away mockTeam, utcTime, score string) map[string]any {
+		return map[string]any{
+			"matchUrl": fmt.Sprintf("/matches/meeting/%d#%d", id, id),
+			"home":     home,
+			"away":     away,
+			"league":   m.League,
+			"status":   map[string]any{"utcTime": utcTime, "started": true, "finished": true, "scoreStr": score},
+		}
+	}
+	return map[string]any{
+		"summary": []int{3, 1, 2},
+		"matches": []map[string]any{
+			meeting(m.ID-1000, m.Away, m.Home, "2025-01-07T15:00:00.000Z", "1 - 1"),
+			meeting(m.ID-2000, m.Home, m.Away, "2024-01-07T15:00:00.000Z", "2 - 0"),
+		},
+	}
+}
+
+// WorldCupPage renders wc as World Cup overview page props: groups as
+// "Grp. X" tables, knockout rounds and the bronze final as playoff
+// matchups, and a goals stat linking to topScorersURL. Penalty shootout
+// scores are not part of the page shape and are dropped.
+func WorldCupPage(wc *api.WorldCupData, topScorersURL string) map[string]any {
+	tables := make([]map[string]any, 0, len(wc.Groups))
+	for _, g := range wc.Groups {
+		rows := make([]map[string]any, 0, len(g.Teams))
+		for _, e := range g.Teams {
+			rows = append(rows, map[string]any{
+				"id":          e.Team.ID,
+				"name":        e.Team.Name,
+				"shortName":   e.Team.ShortName,
+				"idx":         e.Position,
+				"played":      e.Played,
+				"wins":        e.Won,
+				"draws":       e.Drawn,
+				"losses":      e.Lost,
+				"scoresStr":   fmt.Sprintf("%d-%d", e.GoalsFor, e.GoalsAgainst),
+				"goalConDiff": e.GoalDifference,
+				"pts":         e.Points,
+			})
+		}
+		tables = append(tables, map[string]any{
+			"leagueId":   g.ID,
+			"leagueName": "Grp. " + g.Letter,
+			"table":      map[string]any{"all": rows},
+		})
+	}
+
+	rounds := make([]map[string]any, 0, len(wc.KnockoutRounds))
+	for _, r := range wc.KnockoutRounds {
+		matchups := make([]map[string]any, 0, len(r.Matchups))
+		for _, m := range r.Matchups {
+			matchups = append(matchups, playoffMatchup(m))
+		}
+		rounds = append(rounds, map[string]any{"stage": r.Stage, "matchups": matchups})
+	}
+	var special []map[string]any
+	if wc.BronzeFinal != nil {
+		special = append(special, map[string]any{
+			"stage":    "bronze",
+			"matchups": []map[string]any{playoffMatchup(*wc.BronzeFinal)},
+		})
+	}
+
+	return map[string]any{
+		"table":    []any{map[string]any{"data": map[string]any{"composite": true, "tables": tables}}},
+		"playoff":  map[string]any{"rounds": rounds, "special": special},
+		"overview": map[string]any{"selectedSeason": wc.Season},
+		"stats": map[string]any{"players": []map[string]any{
+			{"header": "Top scorer", "name": "goals", "fetchAllUrl": topScorersURL},
+		}},
+	}
+}
+
+func playoffMatchup(m api.WCMatchup) map[string]any {
+	raw := map[string]any{
+		"homeTeam":          m.HomeTeam,
+		"homeTeamId":        m.HomeTeamID,
+		"homeTeamShortName": m.HomeShort,
+		"awayTeam":          m.AwayTeam,
+		"awayTeamId":        m.AwayTeamID,
+		"awayTeamShortName": m.AwayShort,
+		"tbdTeam1":          m.TBDHome,
+		"tbdTeam2":          m.TBDAway,
+	}
+	if m.HomeScore == nil || m.AwayScore == nil {
+		return raw
+	}
+
+	winner := 0
+	if m.WinnerID != nil {
+		winner = *m.WinnerID
+	}
+	reason := ""
+	if m.IsPenalties {
+		// Shootouts carry the winner on the matchup, not per team.
+		raw["winner"] = winner
+		reason = "penalties_short"
+		winner = 0
+	}
+	raw["matches"] = []map[string]any{{
+		"home":   map[string]any{"score": *m.HomeScore, "winner": winner != 0 && winner == m.HomeTeamID},
+		"away":   map[string]any{"score": *m.AwayScore, "winner": winner != 0 && winner == m.AwayTeamID},
+		"status": map[string]any{"finished": true, "reason": map[string]any{"shortKey": reason}},
+	}}
+	return raw
+}
+
+// TopScorerStats renders scorers in the data.fotmob.com stat list shape.
+func TopScorerStats(scorers []api.WCTopScorer) map[string]any {
+	entries := make([]api.LeagueStatEntry, 0, len(scorers))
+	for i, sc := range scorers {
+		entries = append(entries, api.LeagueStatEntry{Rank: i + 1, PlayerName: sc.PlayerName, Team: sc.Team, Value: float64(sc.Goals)})
+	}
+	return StatList(entries)
+}
+
+// StatList renders entries in the data.fotmob.com stat list shape.
+func StatList(entries []api.LeagueStatEntry) map[string]any {
+	list := make([]map[string]any, 0, len(entries))
+	for _, e := range entries {
+		list = append(list, map[string]any{
+			"ParticipantName": e.PlayerName,
+			"ParticiantId":    e.PlayerID,
+			"TeamId":          e.TeamID,
+			"TeamName":        e.Team,
+			"StatValue":       e.Value,
+			"Rank":            e.Rank,
+		})
+	}
+	return map[string]any{"TopLists": []map[string]any{{"StatList": list}}}
+}
+
+// seedStatLists serves leagueID's mock stat lists and returns the league
+// page stats section linking them.
+func (s *Server) seedStatLists(leagueID int) map[string]any {
+	// api.TopStats by FotMob's stat list names.
+	names := []struct{ stat, name, header string }{
+		{api.StatGoals, "goals", "Top scorer"},
+		{api.StatAssists, "goal_assist", "Assists"},
+		{api.StatRating, "rating", "FotMob rating"},
+		{api.StatCleanSheets, "clean_sheet", "Clean sheets"},
+		{api.StatYellowCards, "yellow_card", "Yellow cards"},
+		{api.StatRedCards, "red_card", "Red cards"},
+	}
+	var links []map[string]any
+	for _, n := range names {
+		s.SetStatList(leagueID, n.name, StatList(data.MockLeagueTopStats(leagueID, n.stat)))
+		links = append(links, map[string]any{"header": n.header, "name": n.name, "fetchAllUrl": s.URL + StatListPath(leagueID, n.name)})
+	}
+	return map[string]any{"players": links}
+}
@@ -0,0 +1,383 @@
+// Package fotmobtest provides a fake FotMob server for integration tests.
+// It serves league pages, match pages and the World Cup overview page as
+// Next.js HTML with an embedded __NEXT_DATA__ script, the same way
+// fotmob.com does, so tests exercise the real fetch, extraction, caching and
+// concurrency paths of fotmob.Client without network access.
+//
+// The server starts seeded: league and match pages come from mock_data.json
+// and the World Cup page from the bundled 2022 dataset. Tests can replace any
+// payload and inject latency, HTTP errors or malformed HTML per path.
+package fotmobtest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/fotmob"
+	"github.com/0xjuanma/golazo/internal/ratelimit"
+)
+
+// Paths served by the fake, mirroring fotmob.com.
+const (
+	LeaguePathPrefix = "/leagues/"
+	MatchPathPrefix  = "/matches/"
+	WorldCupPath     = "/leagues/77/overview/world-cup"
+	TopScorersPath   = "/stats/77/goals.json"
+	StatsPathPrefix  = "/stats/"
+	CommentaryPath   = "/api/ltc"
+	TeamPathPrefix   = "/teams/"
+	PlayerPathPrefix = "/players/"
+)
+
+// Fault is a failure injected into responses for a path prefix.
+type Fault struct {
+	// Latency delays the response. A request cancelled while waiting gets
+	// no response.
+	Latency time.Duration
+	// Status, when non-zero, replaces the response with an empty body and
+	// this status code (e.g. 403, 404, 500).
+	Status int
+	// Malformed serves the page with its __NEXT_DATA__ JSON cut short.
+	Malformed bool
+}
+
+// Server is a fake fotmob.com. The zero value is not usable; call New.
+type Server struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	leagues    map[int]json.RawMessage    // league ID -> pageProps
+	matches    map[string]json.RawMessage // page slug -> pageProps
+	worldCup   map[string]json.RawMessage // season ("" = current) -> pageProps
+	statLists  map[string]json.RawMessage // stat list path -> data.fotmob.com stat list
+	commentary map[int]json.RawMessage    // match ID -> live ticker feed
+	teams      map[int]json.RawMessage    // team ID -> pageProps
+	players    map[int]json.RawMessage    // player ID -> pageProps
+	faults     map[string]Fault
+	requests   map[string]int
+	inFlight   int
+	maxFlight  int
+}
+
+// New starts a seeded server and closes it when the test ends.
+//
+// New also points HOME, XDG_CONFIG_HOME and XDG_CACHE_HOME at a temp dir, so
+// the persistent caches loaded by fotmob.NewClient start empty and never
+// touch the real user directories. Tests that call New cannot be parallel.
+func New(t testing.TB) *Server {
+	t.Helper()
+	tmp := t.TempDir()
+	t.Setenv("HOME", tmp)
+	t.Setenv("XDG_CONFIG_HOME", tmp)
+	t.Setenv("XDG_CACHE_HOME", filepath.Join(tmp, "cache"))
+
+	s := &Server{
+		leagues:    make(map[int]json.RawMessage),
+		matches:    make(map[string]json.RawMessage),
+		worldCup:   make(map[string]json.RawMessage),
+		statLists:  make(map[string]json.RawMessage),
+		commentary: make(map[int]json.RawMessage),
+		teams:      make(map[int]json.RawMessage),
+		players:    make(map[int]json.RawMessage),
+		faults:     make(map[string]Fault),
+		requests:   make(map[string]int),
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	t.Cleanup(s.Close)
+
+	if err := s.seed(); err != nil {
+		t.Fatalf("fotmobtest: seed: %v", err)
+	}
+	return s
+}
+
+// Client returns a fotmob.NewClient pointed at the server. Its rate limiter
+// is replaced with a zero-interval one and its retry backoff and breaker
+// cooldown shrunk to milliseconds so tests are not slowed down; the response
+// cache, persistent caches and concurrency cap are the real ones.
+func (s *Server) Client() *fotmob.Client {
+	c := fotmob.NewClient()
+	c.SetBaseURL(s.URL)
+	c.SetRateLimiter(ratelimit.New(0))
+	policy := fotmob.DefaultRetryPolicy()
+	policy.BaseDelay = time.Millisecond
+	policy.MaxDelay = 10 * time.Millisecond
+	policy.BreakerCooldown = 50 * time.Millisecond
+	c.SetRetryPolicy(policy)
+	return c
+}
+
+// SetLeaguePage serves pageProps (any JSON-marshalable value) at
+// /leagues/{id}.
+func (s *Server) SetLeaguePage(leagueID int, pageProps any) {
+	raw := mustMarshal(pageProps)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.leagues[leagueID] = raw
+}
+
+// SetMatchPage serves pageProps at the match page slug, e.g.
+// "/matches/liverpool-vs-arsenal/2tmaz7". A "#matchID" fragment is ignored.
+func (s *Server) SetMatchPage(slug string, pageProps any) {
+	raw := mustMarshal(pageProps)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.matches[stripFragment(slug)] = raw
+}
+
+// SetWorldCupPage serves pageProps for a World Cup season. season "" is the
+// page served when the client asks for the current season.
+func (s *Server) SetWorldCupPage(season string, pageProps any) {
+	raw := mustMarshal(pageProps)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.worldCup[season] = raw
+}
+
+// SetTopScorers serves stats (the data.fotmob.com stat list shape) at
+// TopScorersPath, which the seeded World Cup pages link to.
+func (s *Server) SetTopScorers(stats any) {
+	s.SetStatList(77, "goals", stats)
+}
+
+// SetStatList serves stats (the data.fotmob.com stat list shape) at
+// StatListPath(leagueID, name), e.g. name "goal_assist".
+func (s *Server) SetStatList(leagueID int, name string, stats any) {
+	raw := mustMarshal(stats)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.statLists[StatListPath(leagueID, name)] = raw
+}
+
+// StatListPath is the path of a league's stat list, which league pages link
+// to from their stats section.
+func StatListPath(leagueID int, name string) string {
+	return fmt.Sprintf("%s%d/%s.json", StatsPathPrefix, leagueID, name)
+}
+
+// SetCommentary serves feed (the {"events": [...]} live ticker shape) as the
+// commentary for matchID at CommentaryPath. Matches without one get a 404,
+// as on fotmob.com.
+func (s *Server) SetCommentary(matchID int, feed any) {
+	raw := mustMarshal(feed)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.commentary[matchID] = raw
+}
+
+// SetTeamPage serves pageProps at /teams/{id}/overview.
+func (s *Server) SetTeamPage(teamID int, pageProps any) {
+	raw := mustMarshal(pageProps)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.teams[teamID] = raw
+}
+
+// SetPlayerPage serves pageProps at /players/{id}.
+func (s *Server) SetPlayerPage(playerID int, pageProps any) {
+	raw := mustMarshal(pageProps)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.players[playerID] = raw
+}
+
+// RemoveLeaguePage makes /leagues/{id} return 404.
+func (s *Server) RemoveLeaguePage(leagueID int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.leagues, leagueID)
+}
+
+// Inject applies f to every request whose path starts with pathPrefix. Use
+// "/" for all requests. When several prefixes match, the longest wins.
+func (s *Server) Inject(pathPrefix string, f Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults[pathPrefix] = f
+}
+
+// ClearFaults removes every injected fault.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = make(map[string]Fault)
+}
+
+// Requests returns how many requests the server received for paths starting
+// with pathPrefix.
+func (s *Server) Requests(pathPrefix string) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	n := 0
+	for path, count := range s.requests {
+		if strings.HasPrefix(path, pathPrefix) {
+			n += count
+		}
+	}
+	return n
+}
+
+// MaxInFlight returns the highest number of requests the server handled at
+// once since it started.
+func (s *Server) MaxInFlight() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.maxFlight
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	s.requests[r.URL.Path]++
+	s.inFlight++
+	if s.inFlight > s.maxFlight {
+		s.maxFlight = s.inFlight
+	}
+	fault := s.faultFor(r.URL.Path)
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		s.inFlight--
+		s.mu.Unlock()
+	}()
+
+	if fault.Latency > 0 {
+		select {
+		case <-time.After(fault.Latency):
+		case <-r.Context().Done():
+			return
+		}
+	}
+	if fault.Status != 0 {
+		w.WriteHeader(fault.Status)
+		return
+	}
+
+	path := r.URL.Path
+	switch {
+	case path == "/":
+		writeHTML(w, "<!DOCTYPE html><html><head><title>FotMob</title></head><body></body></html>")
+	case strings.HasPrefix(path, StatsPathPrefix):
+		s.serveJSON(w, func() json.RawMessage { return s.statLists[path] })
+	case path == CommentaryPath:
+		id := commentaryMatchID(r.URL.Query().Get("ltcUrl"))
+		s.serveJSON(w, func() json.RawMessage { return s.commentary[id] })
+	case path == WorldCupPath:
+		season := r.URL.Query().Get("season")
+		s.servePage(w, fault, func() json.RawMessage { return s.worldCup[season] })
+	case strings.HasPrefix(path, LeaguePathPrefix):
+		id, err := strconv.Atoi(strings.TrimPrefix(path, LeaguePathPrefix))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		s.servePage(w, fault, func() json.RawMessage { return s.leagues[id] })
+	case strings.HasPrefix(path, MatchPathPrefix):
+		s.servePage(w, fault, func() json.RawMessage { return s.matches[path] })
+	case strings.HasPrefix(path, TeamPathPrefix) && strings.HasSuffix(path, "/overview"):
+		id, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(path, TeamPathPrefix), "/overview"))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		s.servePage(w, fault, func() json.RawMessage { return s.teams[id] })
+	case strings.HasPrefix(path, PlayerPathPrefix):
+		id, err := strconv.Atoi(strings.TrimPrefix(path, PlayerPathPrefix))
+		if err != nil {
+			http.NotFound(w, r)
+			return
+		}
+		s.servePage(w, fault, func() json.RawMessage { return s.players[id] })
+	default:
+		// Includes /api/matchDetails, which fotmob.com no longer serves.
+		http.NotFound(w, r)
+	}
+}
+
+// faultFor returns the fault with the longest prefix of path. Callers hold mu.
+func (s *Server) faultFor(path string) Fault {
+	var best Fault
+	bestLen := -1
+	for prefix, f := range s.faults {
+		if strings.HasPrefix(path, prefix) && len(prefix) > bestLen {
+			best, bestLen = f, len(prefix)
+		}
+	}
+	return best
+}
+
+func (s *Server) servePage(w http.ResponseWriter, fault Fault, lookup func() json.RawMessage) {
+	s.mu.Lock()
+	props := lookup()
+	s.mu.Unlock()
+	if props == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	writeHTML(w, NextDataPage(props, fault.Malformed))
+}
+
+func (s *Server) serveJSON(w http.ResponseWriter, lookup func() json.RawMessage) {
+	s.mu.Lock()
+	body := lookup()
+	s.mu.Unlock()
+	if body == nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(body)
+}
+
+// NextDataPage renders pageProps as a Next.js page with a __NEXT_DATA__
+// script. malformed cuts the script's JSON short, as a truncated or
+// reshaped page would.
+func NextDataPage(pageProps json.RawMessage, malformed bool) string {
+	data := fmt.Sprintf(`{"props":{"pageProps":%s},"page":"/","buildId":"fotmobtest"}`, pageProps)
+	if malformed {
+		data = data[:len(data)/2]
+	}
+	return `<!DOCTYPE html><html><head><title>FotMob</title></head><body><div id="__next"></div>` +
+		`<script id="__NEXT_DATA__" type="application/json">` + data + `</script></body></html>`
+}
+
+func writeHTML(w http.ResponseWriter, html string) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	_, _ = w.Write([]byte(html))
+}
+
+// commentaryMatchID extracts the match ID from an ltcUrl such as
+// "data.fotmob.com/webcl/ltc/gsm/4506424_en.json.gz".
+func commentaryMatchID(ltcURL string) int {
+	name := ltcURL[strings.LastIndex(ltcURL, "/")+1:]
+	if i := strings.Index(name, "_"); i >= 0 {
+		name = name[:i]
+	}
+	id, _ := strconv.Atoi(name)
+	return id
+}
+
+func stripFragment(slug string) string {
+	if i := strings.Index(slug, "#"); i >= 0 {
+		return slug[:i]
+	}
+	return slug
+}
+
+func mustMarshal(v any) json.RawMessage {
+	if raw, ok := v.(json.RawMessage); ok {
+		return raw
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		panic(fmt.Sprintf("fotmobtest: marshal payload: %v", err))
+	}
+	return raw
+}
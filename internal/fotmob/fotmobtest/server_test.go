@@ -0,0 +1,483 @@
+package fotmobtest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+)
+
+func seedDate(t *testing.T) time.Time {
+	t.Helper()
+	d, err := time.Parse("2006-01-02", SeedDate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestClient_ListsAndDetailsFromPages(t *testing.T) {
+	srv := New(t)
+	client := srv.Client()
+	ctx := context.Background()
+
+	matches, err := client.MatchesByDateForLeagues(ctx, seedDate(t), []string{"fixtures", "results"}, []int{SeedLeagueID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(matches) != 2 {
+		t.Fatalf("matches on %s = %d, want the live and the finished one", SeedDate, len(matches))
+	}
+	for _, m := range matches {
+		if m.League.Name != "Premier League" || m.HomeTeam.ID == 0 || m.PageURL == "" {
+			t.Errorf("match %d not fully parsed: %+v", m.ID, m)
+		}
+	}
+
+	// The listing stored the page slug, so details come from the match page.
+	details, err := client.MatchDetails(ctx, SeedLiveMatchID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if details.Status != api.MatchStatusLive || *details.HomeScore != 2 || *details.AwayScore != 1 {
+		t.Errorf("details = %s %d-%d, want live 2-1", details.Status, *details.HomeScore, *details.AwayScore)
+	}
+	var goals, subs int
+	for _, e := range details.Events {
+		switch e.Type {
+		case "goal":
+			goals++
+		case "substitution":
+			subs++
+			if *e.Player != "Pedro Neto" || *e.Assist != "Fábio Silva" {
+				t.Errorf("substitution off/on = %s/%s", *e.Player, *e.Assist)
+			}
+		}
+	}
+	if goals != 4 || subs != 1 {
+		t.Errorf("goals/subs = %d/%d, want 4/1", goals, subs)
+	}
+	if h := details.HeadToHead; h == nil || h.HomeWins != 3 || len(h.Matches) != 2 || h.Matches[0].ID != SeedLiveMatchID-1000 {
+		t.Errorf("head to head = %+v, want the seeded summary and 2 meetings", h)
+	}
+
+	table, err := client.LeagueTable(ctx, SeedLeagueID, "Premier League")
+	if err != nil || len(table) != 5 || table[0].Team.Name != "Liverpool" || table[0].GoalsFor != 45 {
+		t.Errorf("table = %+v (%v)", table, err)
+	}
+
+	// League page and match page were each fetched once; the rest hit the cache.
+	if _, err := client.MatchDetails(ctx, SeedLiveMatchID); err != nil {
+		t.Fatal(err)
+	}
+	if n := srv.Requests(LeaguePathPrefix); n != 1 {
+		t.Errorf("league page requests = %d, want 1", n)
+	}
+	if n := srv.Requests(SeedLiveMatchSlug); n != 1 {
+		t.Errorf("match page requests = %d, want 1", n)
+	}
+}
+
+func TestClient_EmptyResultsPersistAcrossClients(t *testing.T) {
+	srv := New(t)
+	ctx := context.Background()
+	quiet := seedDate(t).AddDate(0, 0, -1)
+
+	matches, err := srv.Client().MatchesByDateForLeagues(ctx, quiet, []string{"results"}, []int{SeedLeagueID})
+	if err != nil || len(matches) != 0 {
+		t.Fatalf("matches = %d (%v), want none", len(matches), err)
+	}
+	if _, err := srv.Client().MatchesByDateForLeagues(ctx, quiet, []string{"results"}, []int{SeedLeagueID}); err != nil {
+		t.Fatal(err)
+	}
+	if n := srv.Requests(LeaguePathPrefix); n != 1 {
+		t.Errorf("league page requests = %d, want 1: the second client should skip the known-empty day", n)
+	}
+}
+
+func TestClient_FinishedDetailsPersistAcrossClients(t *testing.T) {
+	srv := New(t)
+	ctx := context.Background()
+
+	var mock mockData
+	if err := json.Unmarshal(mockDataJSON, &mock); err != nil {
+		t.Fatal(err)
+	}
+	var finished mockMatch
+	for _, m := range mock.Matches {
+		var status struct {
+			Finished bool `json:"finished"`
+		}
+		if json.Unmarshal(m.Status, &status) == nil && status.Finished {
+			finished = m
+		}
+	}
+	if finished.ID == 0 {
+		t.Fatal("mock data has no finished match")
+	}
+	srv.SetMatchPage(finished.PageURL, matchPage(finished, nil))
+
+	first := srv.Client()
+	if _, err := first.MatchesForLeagueAndDate(ctx, SeedLeagueID, seedDate(t), "results"); err != nil {
+		t.Fatal(err)
+	}
+	details, err := first.MatchDetails(ctx, finished.ID)
+	if err != nil || details.Status != api.MatchStatusFinished {
+		t.Fatalf("details = %+v (%v), want the finished match", details, err)
+	}
+
+	// A new client, as in a fresh CLI run, reads the details from disk.
+	again, err := srv.Client().MatchDetails(ctx, finished.ID)
+	if err != nil || again.HomeTeam.Name != details.HomeTeam.Name || *again.HomeScore != *details.HomeScore {
+		t.Fatalf("second client details = %+v (%v)", again, err)
+	}
+	if n := srv.Requests(stripFragment(finished.PageURL)); n != 1 {
+		t.Errorf("match page requests = %d, want 1", n)
+	}
+
+	// Live matches are never persisted.
+	for i := 0; i < 2; i++ {
+		live := srv.Client()
+		if _, err := live.MatchesForLeagueAndDate(ctx, SeedLeagueID, seedDate(t), "fixtures"); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := live.MatchDetails(ctx, SeedLiveMatchID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if n := srv.Requests(SeedLiveMatchSlug); n != 2 {
+		t.Errorf("live match page requests = %d, want one per client", n)
+	}
+}
+
+func TestClient_InjectedFaults(t *testing.T) {
+	srv := New(t)
+	ctx := context.Background()
+	date := seedDate(t)
+
+	for _, status := range []int{http.StatusForbidden, http.StatusNotFound, http.StatusInternalServerError} {
+		srv.Inject(LeaguePathPrefix, Fault{Status: status})
+		_, err := srv.Client().MatchesForLeagueAndDate(ctx, SeedLeagueID, date, "results")
+		if err == nil || !strings.Contains(err.Error(), strconv.Itoa(status)) {
+			t.Errorf("status %d: err = %v", status, err)
+		}
+	}
+
+	srv.Inject(LeaguePathPrefix, Fault{Malformed: true})
+	if _, err := srv.Client().MatchesForLeagueAndDate(ctx, SeedLeagueID, date, "results"); err == nil || !strings.Contains(err.Error(), "__NEXT_DATA__") {
+		t.Errorf("malformed page: err = %v", err)
+	}
+
+	// A failing match page falls back to /api/matchDetails, which is gone.
+	srv.ClearFaults()
+	srv.Inject(MatchPathPrefix, Fault{Status: http.StatusInternalServerError})
+	client := srv.Client()
+	if _, err := client.MatchesForLeagueAndDate(ctx, SeedLeagueID, date, "fixtures"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.MatchDetails(ctx, SeedLiveMatchID); err == nil {
+		t.Error("match page 500: want an error")
+	}
+	if srv.Requests("/api/matchDetails") != 1 {
+		t.Error("match page failure should fall back to the API endpoint")
+	}
+
+	srv.Inject("/", Fault{Latency: time.Second})
+	short, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+	defer cancel()
+	if _, err := client.Ping(short); err == nil {
+		t.Error("latency past the deadline: want an error")
+	}
+}
+
+func TestClient_CircuitBreaker(t *testing.T) {
+	srv := New(t)
+	leagues := make([]int, 0, 6)
+	for id := 1000; id < 1006; id++ {
+		srv.SetLeaguePage(id, map[string]any{"details": map[string]any{"id": id}, "fixtures": map[string]any{"allMatches": []any{}}})
+		leagues = append(leagues, id)
+	}
+	client := srv.Client()
+	ctx := context.Background()
+
+	srv.Inject(LeaguePathPrefix, Fault{Status: http.StatusServiceUnavailable})
+	_, err := client.MatchesByDateForLeagues(ctx, seedDate(t), []string{"results"}, leagues)
+	if !errors.Is(err, fotmob.ErrCircuitOpen) {
+		t.Fatalf("every league failing with 503: err = %v, want ErrCircuitOpen", err)
+	}
+	// Five failures open the breaker; the remaining leagues never hit the server.
+	if n := srv.Requests(LeaguePathPrefix); n >= len(leagues)*3 {
+		t.Errorf("league requests = %d, want the breaker to short-circuit", n)
+	}
+
+	// Once the cooldown passes, a successful probe closes the breaker.
+	srv.ClearFaults()
+	time.Sleep(60 * time.Millisecond)
+	if _, err := client.MatchesByDateForLeagues(ctx, seedDate(t), []string{"results"}, leagues); err != nil {
+		t.Fatalf("after recovery: %v", err)
+	}
+}
+
+func TestClient_ConcurrencyCap(t *testing.T) {
+	srv := New(t)
+	leagues := make([]int, 0, 14)
+	for id := 1000; id < 1014; id++ {
+		srv.SetLeaguePage(id, map[string]any{"details": map[string]any{"id": id}, "fixtures": map[string]any{"allMatches": []any{}}})
+		leagues = append(leagues, id)
+	}
+	srv.Inject(LeaguePathPrefix, Fault{Latency: 100 * time.Millisecond})
+
+	if _, err := srv.Client().MatchesByDateForLeagues(context.Background(), seedDate(t), []string{"fixtures", "results"}, leagues); err != nil {
+		t.Fatal(err)
+	}
+	if n := srv.Requests(LeaguePathPrefix); n != len(leagues) {
+		t.Errorf("league requests = %d, want %d", n, len(leagues))
+	}
+	if got := srv.MaxInFlight(); got > 10 || got < 2 {
+		t.Errorf("max in flight = %d, want concurrent requests capped at 10", got)
+	}
+}
+
+func TestClient_CoalescesConcurrentRequests(t *testing.T) {
+	srv := New(t)
+	client := srv.Client()
+	ctx := context.Background()
+	leagues := []int{SeedLeagueID}
+	srv.Inject(LeaguePathPrefix, Fault{Latency: 100 * time.Millisecond})
+	srv.Inject(MatchPathPrefix, Fault{Latency: 100 * time.Millisecond})
+
+	const callers = 5
+	run := func(call func() error) {
+		t.Helper()
+		errs := make(chan error, callers)
+		for i := 0; i < callers; i++ {
+			go func() { errs <- call() }()
+		}
+		for i := 0; i < callers; i++ {
+			if err := <-errs; err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	run(func() error {
+		matches, err := client.MatchesByDateForLeagues(ctx, seedDate(t), []string{"fixtures", "results"}, leagues)
+		if err == nil && len(matches) == 0 {
+			err = errors.New("no matches")
+		}
+		return err
+	})
+	if n := srv.Requests(LeaguePathPrefix); n != 1 {
+		t.Errorf("league requests = %d, want 1 shared fetch", n)
+	}
+
+	run(func() error {
+		_, err := client.MatchDetails(ctx, SeedLiveMatchID)
+		return err
+	})
+	if n := srv.Requests(MatchPathPrefix); n != 1 {
+		t.Errorf("match requests = %d, want 1 shared fetch", n)
+	}
+
+	for _, s := range client.RequestStats() {
+		switch s.Kind {
+		case "date_listing", "match_details":
+			if s.Fetches != 1 || s.Shared != callers-1 {
+				t.Errorf("%s stats = %+v, want 1 fetch shared by %d callers", s.Kind, s, callers-1)
+			}
+		}
+	}
+}
+
+func TestClient_WorldCup(t *testing.T) {
+	srv := New(t)
+	client := srv.Client()
+	ctx := context.Background()
+
+	wc, err := client.WorldCupData(ctx, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if wc.Season != SeedWorldCupSeason || len(wc.Groups) != 8 || wc.Champion == nil || wc.Champion.Name != "Argentina" {
+		t.Errorf("world cup = season %q, %d groups, champion %+v", wc.Season, len(wc.Groups), wc.Champion)
+	}
+	if wc.BronzeFinal == nil || wc.BronzeFinal.HomeTeam != "Croatia" {
+		t.Errorf("bronze final = %+v", wc.BronzeFinal)
+	}
+
+	scorers, err := client.WorldCupTopScorers(ctx, SeedWorldCupSeason)
+	if err != nil || len(scorers) == 0 || scorers[0].PlayerName != "Kylian Mbappé" || scorers[0].Goals != 8 {
+		t.Errorf("scorers = %+v (%v)", scorers, err)
+	}
+
+	if _, err := client.WorldCupData(ctx, "1930"); err == nil {
+		t.Error("unseeded season: want an error")
+	}
+}
+
+func TestClient_Commentary(t *testing.T) {
+	srv := New(t)
+	client := srv.Client()
+	ctx := context.Background()
+
+	// Newest first, as on fotmob.com.
+	srv.SetCommentary(2001, map[string]any{"events": []map[string]any{
+		{"elapsed": 45, "elapsedPlus": 2, "type": "goal", "text": "Goal! Arsenal 1, Chelsea 0.", "isHomeTeam": true},
+		{"elapsed": 12, "type": "yellow-card", "text": "Caicedo is booked.", "isHomeTeam": false},
+		{"elapsed": 0, "type": "comment", "text": "Lineups are announced."},
+	}})
+
+	all, err := client.Commentary(ctx, 2001, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 3 || all[0].Text != "Lineups are announced." || all[0].DisplayMinute != "" ||
+		all[2].DisplayMinute != "45+2'" || all[2].IsHome == nil || !*all[2].IsHome {
+		t.Fatalf("commentary = %+v, want match order", all)
+	}
+
+	newer, err := client.Commentary(ctx, 2001, all[1].ID)
+	if err != nil || len(newer) != 1 || newer[0].ID != all[2].ID {
+		t.Errorf("since %q = %+v (%v), want the goal only", all[1].ID, newer, err)
+	}
+	if got, _ := client.Commentary(ctx, 2001, all[2].ID); len(got) != 0 {
+		t.Errorf("since the newest entry = %+v, want none", got)
+	}
+	if got, _ := client.Commentary(ctx, 2001, "gone"); len(got) != 3 {
+		t.Errorf("unknown sinceID = %d entries, want all 3", len(got))
+	}
+
+	if got, err := client.Commentary(ctx, 2002, ""); err != nil || got != nil {
+		t.Errorf("match without commentary = %+v, %v; want none and no error", got, err)
+	}
+
+	// Identical lines in the same minute get distinct IDs, stable as the
+	// feed grows.
+	corner := map[string]any{"elapsed": 30, "type": "corner", "text": "Corner, Arsenal."}
+	srv.SetCommentary(2003, map[string]any{"events": []map[string]any{corner, corner}})
+	first, err := client.Commentary(ctx, 2003, "")
+	if err != nil || len(first) != 2 || first[0].ID == first[1].ID {
+		t.Fatalf("repeated lines = %+v (%v), want two distinct IDs", first, err)
+	}
+	srv.SetCommentary(2003, map[string]any{"events": []map[string]any{corner, corner, corner}})
+	if got, _ := client.Commentary(ctx, 2003, first[1].ID); len(got) != 1 || got[0].ID == first[0].ID || got[0].ID == first[1].ID {
+		t.Errorf("since the second corner = %+v, want only the third", got)
+	}
+}
+
+func TestClient_Team(t *testing.T) {
+	srv := New(t)
+	client := srv.Client()
+	ctx := context.Background()
+
+	team, err := client.Team(ctx, SeedTeamID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if team.Team.Name != "Manchester City" || team.League == nil || team.League.ID != SeedLeagueID {
+		t.Errorf("team = %+v, league %+v", team.Team, team.League)
+	}
+	if len(team.Results) != 1 || team.Results[0].ID != 4813582 || team.Form != "W" {
+		t.Errorf("results = %+v, form %q; want the 3-1 win", team.Results, team.Form)
+	}
+	if len(team.Fixtures) != 0 {
+		t.Errorf("fixtures = %+v, want none", team.Fixtures)
+	}
+	if team.Standing == nil || team.Standing.Position != 2 {
+		t.Errorf("standing = %+v, want 2nd", team.Standing)
+	}
+	if len(team.Squad) != 5 || team.Squad[0].Position != api.PositionCoach || team.Squad[0].Number != 0 ||
+		team.Squad[1].Position != api.PositionGoalkeeper || team.Squad[1].Number != 31 ||
+		team.Squad[4].Name != "Erling Haaland" || team.Squad[4].Position != api.PositionForward {
+		t.Errorf("squad = %+v", team.Squad)
+	}
+
+	// Liverpool have only the upcoming match against Arsenal.
+	lfc, err := client.Team(ctx, 8650)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lfc.Fixtures) != 1 || lfc.Fixtures[0].Status != api.MatchStatusNotStarted || len(lfc.Results) != 0 || lfc.Form != "" {
+		t.Errorf("liverpool = fixtures %+v, results %+v, form %q", lfc.Fixtures, lfc.Results, lfc.Form)
+	}
+	season, err := client.LeagueMatches(ctx, SeedLeagueID)
+	if err != nil || len(season) != 3 || season[0].League.ID != SeedLeagueID {
+		t.Errorf("league matches = %+v (%v), want the 3 seeded matches", season, err)
+	}
+
+	if _, err := client.Team(ctx, 1); !errors.Is(err, fotmob.ErrTeamNotFound) {
+		t.Errorf("unknown team: err = %v, want ErrTeamNotFound", err)
+	}
+}
+
+func TestClient_Player(t *testing.T) {
+	srv := New(t)
+	client := srv.Client()
+	ctx := context.Background()
+
+	p, err := client.Player(ctx, SeedPlayerID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Name != "Erling Haaland" || p.Team == nil || p.Team.ID != SeedTeamID || p.Position != "Striker" ||
+		p.Number != 9 || p.Age != 26 || p.HeightCM != 195 || p.Country != "Norway" {
+		t.Errorf("profile = %+v", p)
+	}
+	if p.League == nil || p.League.ID != SeedLeagueID || p.Season != "2025/2026" {
+		t.Errorf("league = %+v, season %q", p.League, p.Season)
+	}
+	want := []api.PlayerSeasonStat{
+		{Key: "goals", Label: "Goals", Value: 20},
+		{Key: "assists", Label: "Assists", Value: 4},
+		{Key: "rating", Label: "Rating", Value: 7.85},
+	}
+	if len(p.Stats) != len(want) {
+		t.Fatalf("stats = %+v", p.Stats)
+	}
+	for i := range want {
+		if p.Stats[i] != want[i] {
+			t.Errorf("stats[%d] = %+v, want %+v", i, p.Stats[i], want[i])
+		}
+	}
+
+	if _, err := client.Player(ctx, 1); !errors.Is(err, fotmob.ErrPlayerNotFound) {
+		t.Errorf("unknown player: err = %v, want ErrPlayerNotFound", err)
+	}
+}
+
+func TestClient_LeagueTopStats(t *testing.T) {
+	srv := New(t)
+	client := srv.Client()
+	ctx := context.Background()
+
+	assists, err := client.LeagueTopStats(ctx, SeedLeagueID, "", api.StatAssists)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := data.MockLeagueTopStats(SeedLeagueID, api.StatAssists)
+	if len(assists) != len(want) || assists[0] != want[0] {
+		t.Errorf("assists = %+v, want %+v", assists, want)
+	}
+
+	// A past season is fetched from its own page, which here is the same one.
+	rating, err := client.LeagueTopStats(ctx, SeedLeagueID, "2024/2025", api.StatRating)
+	if err != nil || len(rating) == 0 || rating[0].Value != 7.92 {
+		t.Errorf("rating = %+v (%v)", rating, err)
+	}
+
+	// The World Cup page only links goals.
+	if _, err := client.LeagueTopStats(ctx, 77, SeedWorldCupSeason, api.StatAssists); !errors.Is(err, fotmob.ErrNoLeagueStats) {
+		t.Errorf("world cup assists: err = %v, want ErrNoLeagueStats", err)
+	}
+	if _, err := client.LeagueTopStats(ctx, SeedLeagueID, "", "tackles"); !errors.Is(err, api.ErrUnsupported) {
+		t.Errorf("unknown stat: err = %v, want ErrUnsupported", err)
+	}
+}
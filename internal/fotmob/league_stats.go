@@ -0,0 +1,156 @@
+package fotmob
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// ErrNoLeagueStats is returned (wrapped) by LeagueTopStats when the league
+// page does not link the requested stat list, e.g. for cups or seasons
+// FotMob has no player stats for.
+var ErrNoLeagueStats = errors.New("no stat list available")
+
+// fotmobStatNames maps api.TopStats to FotMob's stat list names.
+var fotmobStatNames = map[string]string{
+	api.StatGoals:       "goals",
+	api.StatAssists:     "goal_assist",
+	api.StatRating:      "rating",
+	api.StatCleanSheets: "clean_sheet",
+	api.StatYellowCards: "yellow_card",
+	api.StatRedCards:    "red_card",
+}
+
+// fotmobStatLinks is the stats section of a league page: one entry per stat
+// list, each linking the full ranking on data.fotmob.com.
+type fotmobStatLinks struct {
+	Stats struct {
+		Players []struct {
+			Header      string `json:"header"` // e.g. "Top scorer"
+			Name        string `json:"name"`   // e.g. "goals"
+			FetchAllURL string `json:"fetchAllUrl"`
+		} `json:"players"`
+	} `json:"stats"`
+}
+
+// fotmobStatList is the shape of the data.fotmob.com stat list endpoint.
+type fotmobStatList struct {
+	TopLists []fotmobTopList `json:"TopLists"`
+}
+
+type fotmobTopList struct {
+	StatList []fotmobStatEntry `json:"StatList"`
+}
+
+type fotmobStatEntry struct {
+	ParticipantName string  `json:"ParticipantName"`
+	ParticipantID   int     `json:"ParticiantId"` // sic, FotMob's spelling
+	TeamID          int     `json:"TeamId"`
+	TeamName        string  `json:"TeamName"`
+	StatValue       float64 `json:"StatValue"`
+	Rank            int     `json:"Rank"`
+}
+
+// LeagueTopStats fetches a league's ranked player list for stat (one of
+// api.TopStats) in season ("" for the current one). The league page links
+// each stat list; one more call to data.fotmob.com fetches the full ranking.
+func (c *Client) LeagueTopStats(ctx context.Context, leagueID int, season, stat string) ([]api.LeagueStatEntry, error) {
+	name, ok := fotmobStatNames[stat]
+	if !ok {
+		return nil, fmt.Errorf("league stat %q: %w", stat, api.ErrUnsupported)
+	}
+
+	pageProps, err := c.leagueStatsPage(ctx, leagueID, season)
+	if err != nil {
+		return nil, fmt.Errorf("fetch league %d page for stats: %w", leagueID, err)
+	}
+	var links fotmobStatLinks
+	if err := json.Unmarshal(pageProps, &links); err != nil {
+		return nil, fmt.Errorf("parse league %d page stats: %w", leagueID, err)
+	}
+
+	fetchURL := ""
+	for _, p := range links.Stats.Players {
+		if p.Name == name {
+			fetchURL = p.FetchAllURL
+			break
+		}
+	}
+	if fetchURL == "" {
+		return nil, fmt.Errorf("%w: %s for league %d", ErrNoLeagueStats, stat, leagueID)
+	}
+
+	if err := c.rateLimiter.WaitKey(ctx, limitKeyAPI); err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, "GET", fetchURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create %s stats request: %w", stat, err)
+	}
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/131.0.0.0 Safari/537.36")
+	req.Header.Set("Referer", "https://www.fotmob.com/")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch %s stats: %w", stat, err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s stats endpoint returned status %d", stat, resp.StatusCode)
+	}
+
+	var statList fotmobStatList
+	if err := json.NewDecoder(resp.Body).Decode(&statList); err != nil {
+		return nil, fmt.Errorf("decode %s stats response: %w", stat, err)
+	}
+	return parseStatList(statList), nil
+}
+
+// leagueStatsPage returns the page props of the league page linking the stat
+// lists. The World Cup keeps its own overview page, which is also where its
+// past tournaments live.
+func (c *Client) leagueStatsPage(ctx context.Context, leagueID int, season string) (json.RawMessage, error) {
+	switch {
+	case leagueID == api.WCFotMobLeagueID:
+		if err := c.rateLimiter.WaitKey(ctx, limitKeyPage); err != nil {
+			return nil, err
+		}
+		return fetchWorldCupPage(ctx, c.httpClient, c.siteURL, season)
+	case season == "":
+		return c.fetchLeaguePage(ctx, leagueID)
+	default:
+		if err := c.rateLimiter.WaitKey(ctx, limitKeyPage); err != nil {
+			return nil, err
+		}
+		return fetchLeagueFromPage(ctx, c.httpClient, c.siteURL, leagueID, season)
+	}
+}
+
+// parseStatList converts a raw stat list into ranked API entries.
+func parseStatList(statList fotmobStatList) []api.LeagueStatEntry {
+	if len(statList.TopLists) == 0 {
+		return nil
+	}
+	raw := statList.TopLists[0].StatList
+	entries := make([]api.LeagueStatEntry, 0, len(raw))
+	for i, e := range raw {
+		rank := e.Rank
+		if rank == 0 {
+			rank = i + 1
+		}
+		entries = append(entries, api.LeagueStatEntry{
+			Rank:       rank,
+			PlayerID:   e.ParticipantID,
+			PlayerName: e.ParticipantName,
+			TeamID:     e.TeamID,
+			Team:       e.TeamName,
+			Value:      e.StatValue,
+		})
+	}
+	return entries
+}
@@ -125,10 +125,15 @@ func matchTitles(matches []api.Match) []string {
 // concurrently using the status-only classifier. Best-effort aggregation: a
 // league that errors is skipped, the rest still return.
 func (c *Client) LiveAndUpcoming(ctx context.Context) (live, upcoming []api.Match, err error) {
-	activeLeagues := ActiveLeagues()
+	return c.LiveAndUpcomingForLeagues(ctx, ActiveLeagues())
+}
+
+// LiveAndUpcomingForLeagues is LiveAndUpcoming over an explicit league set,
+// which need not be in the user's active selection.
+func (c *Client) LiveAndUpcomingForLeagues(ctx context.Context, leagueIDs []int) (live, upcoming []api.Match, err error) {
 	var mu sync.Mutex
 	var wg sync.WaitGroup
-	for _, leagueID := range activeLeagues {
+	for _, leagueID := range leagueIDs {
 		wg.Add(1)
 		go func(id int) {
 			defer wg.Done()
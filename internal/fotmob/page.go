@@ -20,12 +20,12 @@ import (
 // The match page URL uses a slug format from the leagues endpoint pageUrl field
 // (e.g., "/matches/wolverhampton-wanderers-vs-arsenal/2t3bl7").
 // The embedded __NEXT_DATA__ contains the same data structure as the old API.
-func fetchMatchDetailsFromPage(ctx context.Context, httpClient *http.Client, pageSlug string) (*api.MatchDetails, error) {
+func fetchMatchDetailsFromPage(ctx context.Context, httpClient *http.Client, site, pageSlug string) (*api.MatchDetails, error) {
 	if pageSlug == "" {
 		return nil, fmt.Errorf("empty page slug")
 	}
 
-	url := "https://www.fotmob.com" + pageSlug
+	url := site + pageSlug
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
@@ -70,8 +70,8 @@ func fetchMatchDetailsFromPage(ctx context.Context, httpClient *http.Client, pag
 // This replaces the old /api/leagues?id={id}&tab={tab} endpoint, which FotMob
 // removed (returns 404). The league page at /leagues/{id} contains the same data
 // in its __NEXT_DATA__ script tag, including all season matches in fixtures.allMatches.
-func fetchLeagueFromPage(ctx context.Context, httpClient *http.Client, leagueID int) (json.RawMessage, error) {
-	url := fmt.Sprintf("https://www.fotmob.com/leagues/%d", leagueID)
+func fetchLeagueFromPage(ctx context.Context, httpClient *http.Client, site string, leagueID int, season string) (json.RawMessage, error) {
+	url := leaguePageURL(site, leagueID, season)
 
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
@@ -104,21 +104,46 @@ func fetchLeagueFromPage(ctx context.Context, httpClient *http.Client, leagueID
 	return pageProps, nil
 }
 
+// leaguePageURL returns a league page on site, for season or the current
+// season when empty. FotMob URLs spell season "2024/2025" as "2024-2025".
+func leaguePageURL(site string, leagueID int, season string) string {
+	url := fmt.Sprintf("%s/leagues/%d", site, leagueID)
+	if season != "" {
+		url += "?season=" + strings.ReplaceAll(season, "/", "-")
+	}
+	return url
+}
+
 // fetchLeaguePage returns the cached league-page JSON body for the given
 // league if it's still fresh; otherwise applies the rate limiter, fetches the
 // page over HTTP, stores the body in the cache, and returns it.
 //
-// Callers must NOT call rateLimiter.Wait() themselves — this helper owns the
-// wait so cache hits avoid the rate-limit delay entirely.
+// Callers must NOT call rateLimiter.WaitKey() themselves — this helper owns the
+// wait so cache hits avoid the rate-limit delay entirely. Concurrent misses
+// for the same league share one fetch.
 func (c *Client) fetchLeaguePage(ctx context.Context, leagueID int) (json.RawMessage, error) {
 	if cached := c.cache.Page(leagueID); cached != nil {
+		c.pageFlight.hit()
 		c.debugLog("league page: cache hit", "leagueID", leagueID)
 		return cached, nil
 	}
 
-	c.rateLimiter.Wait()
+	body, shared, err := c.pageFlight.do(ctx, leagueID, func() (json.RawMessage, error) {
+		return c.fetchLeaguePageUncached(ctx, leagueID)
+	})
+	if shared {
+		c.debugLog("league page: shared in-flight fetch", "leagueID", leagueID, "shared_total", c.pageFlight.shared.Load())
+	}
+	return body, err
+}
+
+// fetchLeaguePageUncached fetches a league page over HTTP and caches it.
+func (c *Client) fetchLeaguePageUncached(ctx context.Context, leagueID int) (json.RawMessage, error) {
+	if err := c.rateLimiter.WaitKey(ctx, limitKeyPage); err != nil {
+		return nil, err
+	}
 
-	body, err := fetchLeagueFromPage(ctx, c.httpClient, leagueID)
+	body, err := fetchLeagueFromPage(ctx, c.httpClient, c.siteURL, leagueID, "")
 	if err != nil {
 		return nil, err
 	}
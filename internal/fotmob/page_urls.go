@@ -0,0 +1,306 @@
+package fotmob
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+)
+
+const (
+	// PageURLCacheFileName is the name of the cache file for match page slugs.
+	PageURLCacheFileName = "page-urls.json"
+	// PageURLCacheExpiry is how long a match ID -> slug mapping is kept (30 days).
+	// Slugs never change for a given match, so this only bounds file growth.
+	PageURLCacheExpiry = 30 * 24 * time.Hour
+	// PageURLCacheMaxEntries caps the number of slugs kept on disk. Scans of
+	// full-season league pages only persist slugs within
+	// pageURLPersistWindow, so a couple of dozen active leagues fit.
+	PageURLCacheMaxEntries = 5000
+
+	// pageURLPersistWindow bounds which slugs from a full-season league page
+	// (~400 matches) are persisted: those kicking off within this long of
+	// now, which is what later listings and lookups ask for. The rest stay
+	// in memory for the process.
+	pageURLPersistWindow = 14 * 24 * time.Hour
+)
+
+// ErrPageURLNotFound is returned by ResolvePageURL when none of the scanned
+// league pages list the requested match.
+var ErrPageURLNotFound = errors.New("match page url not found")
+
+// PageURLCache persists match ID -> page slug mappings so that one-shot CLI
+// invocations can fetch match details for IDs seen by an earlier process.
+type PageURLCache struct {
+	mu       sync.RWMutex
+	filePath string
+	data     PageURLCacheData
+}
+
+// PageURLCacheData is the JSON structure stored on disk.
+type PageURLCacheData struct {
+	Version  int                  `json:"version"`
+	PageURLs map[int]PageURLEntry `json:"page_urls"` // key: match ID
+}
+
+// PageURLEntry is a single cached slug with the time it was last seen.
+type PageURLEntry struct {
+	PageURL string    `json:"page_url"`
+	Seen    time.Time `json:"seen"`
+}
+
+// NewPageURLCache creates a new cache instance backed by the cache directory.
+// Existing data is loaded if present; a missing or corrupted file starts fresh.
+func NewPageURLCache() (*PageURLCache, error) {
+	cache, err := OpenPageURLCache()
+	if err != nil {
+		return nil, err
+	}
+
+	cache.cleanExpired()
+
+	return cache, nil
+}
+
+// OpenPageURLCache loads the cache like NewPageURLCache but keeps expired
+// entries, so Stats reports them. Used by `golazo cache`.
+func OpenPageURLCache() (*PageURLCache, error) {
+	cacheDir, err := data.CacheDir()
+	if err != nil {
+		return nil, err
+	}
+
+	cache := &PageURLCache{
+		filePath: filepath.Join(cacheDir, PageURLCacheFileName),
+		data: PageURLCacheData{
+			Version:  1,
+			PageURLs: make(map[int]PageURLEntry),
+		},
+	}
+
+	if err := cache.load(); err != nil {
+		cache.data = PageURLCacheData{
+			Version:  1,
+			PageURLs: make(map[int]PageURLEntry),
+		}
+	}
+
+	return cache, nil
+}
+
+// Get returns the cached slug for a match, or "" if unknown or expired.
+func (c *PageURLCache) Get(matchID int) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	entry, ok := c.data.PageURLs[matchID]
+	if !ok || time.Since(entry.Seen) > PageURLCacheExpiry {
+		return ""
+	}
+	return entry.PageURL
+}
+
+// Set records a slug for a match and refreshes its last-seen time.
+func (c *PageURLCache) Set(matchID int, pageURL string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.data.PageURLs[matchID] = PageURLEntry{PageURL: pageURL, Seen: time.Now()}
+}
+
+// Save persists the cache to disk, evicting the least recently seen entries
+// beyond PageURLCacheMaxEntries first.
+func (c *PageURLCache) Save() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.evictOverflowLocked()
+
+	data, err := json.Marshal(c.data)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(c.filePath, data, 0644)
+}
+
+// Stats returns statistics about the cache.
+func (c *PageURLCache) Stats() (total int, expired int) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, entry := range c.data.PageURLs {
+		total++
+		if time.Since(entry.Seen) > PageURLCacheExpiry {
+			expired++
+		}
+	}
+	return
+}
+
+// load reads the cache from disk.
+func (c *PageURLCache) load() error {
+	data, err := os.ReadFile(c.filePath)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(data, &c.data); err != nil {
+		return err
+	}
+	if c.data.PageURLs == nil {
+		c.data.PageURLs = make(map[int]PageURLEntry)
+	}
+	return nil
+}
+
+// Prune removes expired entries and returns how many were dropped. The
+// caller persists the result with Save.
+func (c *PageURLCache) Prune() int {
+	return c.cleanExpired()
+}
+
+// cleanExpired removes expired entries from the cache.
+func (c *PageURLCache) cleanExpired() (removed int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for id, entry := range c.data.PageURLs {
+		if time.Since(entry.Seen) > PageURLCacheExpiry {
+			delete(c.data.PageURLs, id)
+			removed++
+		}
+	}
+	return removed
+}
+
+// evictOverflowLocked drops the least recently seen entries until the cache
+// is within PageURLCacheMaxEntries. Entries seen at the same time (one scan)
+// are dropped lowest match ID first, so older matches go before newer ones
+// and the outcome does not depend on map order. Must hold write lock.
+func (c *PageURLCache) evictOverflowLocked() {
+	overflow := len(c.data.PageURLs) - PageURLCacheMaxEntries
+	if overflow <= 0 {
+		return
+	}
+
+	ids := make([]int, 0, len(c.data.PageURLs))
+	for id := range c.data.PageURLs {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		a, b := c.data.PageURLs[ids[i]].Seen, c.data.PageURLs[ids[j]].Seen
+		if !a.Equal(b) {
+			return a.Before(b)
+		}
+		return ids[i] < ids[j]
+	})
+	for _, id := range ids[:overflow] {
+		delete(c.data.PageURLs, id)
+	}
+}
+
+// NormalizePageURL turns a FotMob match page reference into the slug form
+// expected by MatchDetails ("/matches/home-vs-away/abc123"). It accepts the
+// bare slug, the slug without a leading slash, or a full fotmob.com URL.
+// Returns "" if the input does not look like a match page.
+func NormalizePageURL(raw string) string {
+	s := strings.TrimSpace(raw)
+	for _, prefix := range []string{"https://www.fotmob.com", "http://www.fotmob.com", "https://fotmob.com", "http://fotmob.com"} {
+		s = strings.TrimPrefix(s, prefix)
+	}
+	if s != "" && !strings.HasPrefix(s, "/") {
+		s = "/" + s
+	}
+	if !strings.HasPrefix(s, "/matches/") {
+		return ""
+	}
+	return s
+}
+
+// SavePageURLs persists every page slug seen by this client to disk so later
+// processes can resolve match IDs without re-scanning league pages.
+func (c *Client) SavePageURLs() error {
+	if c.pageURLStore == nil {
+		return nil
+	}
+	return c.pageURLStore.Save()
+}
+
+// ResolvePageURL returns the page slug for a match, scanning the given league
+// pages when the slug is not already known in memory or on disk. Each scanned
+// page carries a full season of fixtures, so every slug found along the way is
+// stored too — subsequent lookups for the same leagues are free. Only the
+// requested match and those near today are persisted (see storeSeasonPageURL).
+//
+// Returns ErrPageURLNotFound if none of the leagues list the match.
+func (c *Client) ResolvePageURL(ctx context.Context, matchID int, leagueIDs []int) (string, error) {
+	if slug := c.getPageURL(matchID); slug != "" {
+		return slug, nil
+	}
+
+	var wg sync.WaitGroup
+	for _, leagueID := range leagueIDs {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			c.maxConcurrent <- struct{}{}
+			defer func() { <-c.maxConcurrent }()
+
+			pageProps, err := c.fetchLeaguePage(ctx, id)
+			if err != nil {
+				c.debugLog("resolve page url: league page fetch failed", "leagueID", id, "err", err)
+				return
+			}
+			var leagueResponse struct {
+				Fixtures struct {
+					AllMatches []fotmobMatch `json:"allMatches"`
+				} `json:"fixtures"`
+			}
+			if err := json.Unmarshal(pageProps, &leagueResponse); err != nil {
+				c.debugLog("resolve page url: league page decode failed", "leagueID", id, "err", err)
+				return
+			}
+			now := time.Now()
+			for _, m := range leagueResponse.Fixtures.AllMatches {
+				c.storeSeasonPageURL(m.toAPIMatch(), now)
+			}
+		}(leagueID)
+	}
+	wg.Wait()
+
+	if slug := c.getPageURL(matchID); slug != "" {
+		c.debugLog("resolve page url: found", "matchID", matchID, "pageSlug", slug)
+		c.StorePageURL(matchID, slug)
+		return slug, nil
+	}
+	return "", fmt.Errorf("%w for match %d in %d league(s)", ErrPageURLNotFound, matchID, len(leagueIDs))
+}
+
+// storeSeasonPageURL stores the slug of a match read from a full-season league
+// page. It is always kept in memory, but only persisted when the match kicks
+// off within pageURLPersistWindow of now, so one scan of many leagues does not
+// overflow PageURLCacheMaxEntries.
+func (c *Client) storeSeasonPageURL(m api.Match, now time.Time) {
+	if m.MatchTime != nil {
+		if d := m.MatchTime.Sub(now); d <= pageURLPersistWindow && d >= -pageURLPersistWindow {
+			c.StorePageURL(m.ID, m.PageURL)
+			return
+		}
+	}
+	if m.PageURL == "" {
+		return
+	}
+	c.pageURLsMu.Lock()
+	c.pageURLs[m.ID] = m.PageURL
+	c.pageURLsMu.Unlock()
+}
@@ -0,0 +1,199 @@
+package fotmob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/ratelimit"
+)
+
+func TestNormalizePageURL(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"/matches/arsenal-vs-chelsea/2t3bl7", "/matches/arsenal-vs-chelsea/2t3bl7"},
+		{"matches/arsenal-vs-chelsea/2t3bl7", "/matches/arsenal-vs-chelsea/2t3bl7"},
+		{"https://www.fotmob.com/matches/arsenal-vs-chelsea/2t3bl7#4506263", "/matches/arsenal-vs-chelsea/2t3bl7#4506263"},
+		{"  /matches/a-vs-b/x  ", "/matches/a-vs-b/x"},
+		{"/leagues/47", ""},
+		{"https://example.com/matches/a-vs-b/x", ""},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if got := NormalizePageURL(tt.in); got != tt.want {
+			t.Errorf("NormalizePageURL(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestPageURLCache_PersistsAcrossInstances(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	first, err := NewPageURLCache()
+	if err != nil {
+		t.Fatalf("NewPageURLCache: %v", err)
+	}
+	first.Set(42, "/matches/a-vs-b/x")
+	if err := first.Save(); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	second, err := NewPageURLCache()
+	if err != nil {
+		t.Fatalf("NewPageURLCache: %v", err)
+	}
+	if got := second.Get(42); got != "/matches/a-vs-b/x" {
+		t.Errorf("Get(42) after reload = %q, want slug", got)
+	}
+	if got := second.Get(43); got != "" {
+		t.Errorf("Get(43) = %q, want empty", got)
+	}
+}
+
+func TestPageURLCache_ExpiredEntriesDropped(t *testing.T) {
+	cache := &PageURLCache{data: PageURLCacheData{PageURLs: map[int]PageURLEntry{
+		1: {PageURL: "/matches/old/x", Seen: time.Now().Add(-PageURLCacheExpiry - time.Hour)},
+		2: {PageURL: "/matches/new/y", Seen: time.Now()},
+	}}}
+
+	if got := cache.Get(1); got != "" {
+		t.Errorf("expired entry returned %q", got)
+	}
+	total, expired := cache.Stats()
+	if total != 2 || expired != 1 {
+		t.Errorf("Stats() = (%d, %d), want (2, 1)", total, expired)
+	}
+	cache.cleanExpired()
+	if total, _ := cache.Stats(); total != 1 {
+		t.Errorf("after cleanExpired total = %d, want 1", total)
+	}
+}
+
+func TestResolvePageURL_ScansLeaguePages(t *testing.T) {
+	var hits atomic.Int32
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		hits.Add(1)
+		leagueID := extractIDFromPath(req.URL.Path)
+		body := fmt.Sprintf(
+			`<html><script id="__NEXT_DATA__" type="application/json">{"props":{"pageProps":{"fixtures":{"allMatches":[{"id":"%d01","pageUrl":"/matches/home-vs-away/l%d","status":{"utcTime":"2026-03-10T20:00:00Z"}}]}}}}</script></html>`,
+			leagueID, leagueID,
+		)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    req,
+			Header:     make(http.Header),
+		}, nil
+	})
+	client := &Client{
+		httpClient:    &http.Client{Transport: transport, Timeout: 5 * time.Second},
+		siteURL:       siteURL,
+		baseURL:       baseURL,
+		rateLimiter:   ratelimit.New(0),
+		cache:         NewResponseCache(DefaultCacheConfig()),
+		pageURLs:      make(map[int]string, 10),
+		maxConcurrent: make(chan struct{}, 10),
+	}
+
+	slug, err := client.ResolvePageURL(context.Background(), 8701, []int{47, 87})
+	if err != nil {
+		t.Fatalf("ResolvePageURL: %v", err)
+	}
+	if slug != "/matches/home-vs-away/l87" {
+		t.Errorf("slug = %q, want /matches/home-vs-away/l87", slug)
+	}
+	// Every slug on scanned pages is stored, so the sibling league's match
+	// resolves without another fetch.
+	before := hits.Load()
+	if slug, err := client.ResolvePageURL(context.Background(), 4701, []int{47, 87}); err != nil || slug == "" {
+		t.Errorf("second lookup = (%q, %v), want stored slug", slug, err)
+	}
+	if hits.Load() != before {
+		t.Errorf("second lookup hit the network %d more times", hits.Load()-before)
+	}
+
+	if _, err := client.ResolvePageURL(context.Background(), 999, []int{47}); !errors.Is(err, ErrPageURLNotFound) {
+		t.Errorf("unknown match err = %v, want ErrPageURLNotFound", err)
+	}
+}
+
+func TestResolvePageURL_PersistsOnlyNearbySlugs(t *testing.T) {
+	now := time.Now().UTC()
+	kickoff := func(days int) string { return now.AddDate(0, 0, days).Format(time.RFC3339) }
+	transport := roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		body := fmt.Sprintf(
+			`<html><script id="__NEXT_DATA__" type="application/json">{"props":{"pageProps":{"fixtures":{"allMatches":[`+
+				`{"id":"1","pageUrl":"/matches/a-vs-b/old","status":{"utcTime":%q}},`+
+				`{"id":"2","pageUrl":"/matches/a-vs-b/near","status":{"utcTime":%q}},`+
+				`{"id":"3","pageUrl":"/matches/a-vs-b/late","status":{"utcTime":%q}}]}}}}</script></html>`,
+			kickoff(-90), kickoff(2), kickoff(90),
+		)
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    req,
+			Header:     make(http.Header),
+		}, nil
+	})
+	store := &PageURLCache{data: PageURLCacheData{PageURLs: make(map[int]PageURLEntry)}}
+	client := &Client{
+		httpClient:    &http.Client{Transport: transport, Timeout: 5 * time.Second},
+		siteURL:       siteURL,
+		baseURL:       baseURL,
+		rateLimiter:   ratelimit.New(0),
+		cache:         NewResponseCache(DefaultCacheConfig()),
+		pageURLs:      make(map[int]string, 10),
+		pageURLStore:  store,
+		maxConcurrent: make(chan struct{}, 10),
+	}
+
+	if _, err := client.ResolvePageURL(context.Background(), 1, []int{47}); err != nil {
+		t.Fatalf("ResolvePageURL: %v", err)
+	}
+	// The requested match and the one near today are persisted; the rest of
+	// the season stays in memory only.
+	for id, want := range map[int]string{1: "/matches/a-vs-b/old", 2: "/matches/a-vs-b/near", 3: ""} {
+		if got := store.Get(id); got != want {
+			t.Errorf("store.Get(%d) = %q, want %q", id, got, want)
+		}
+	}
+	if got := client.getPageURL(3); got != "/matches/a-vs-b/late" {
+		t.Errorf("in-memory slug for 3 = %q, want it kept", got)
+	}
+}
+
+func TestPageURLCache_EvictionIsDeterministic(t *testing.T) {
+	seen := time.Now()
+	cache := &PageURLCache{
+		filePath: filepath.Join(t.TempDir(), PageURLCacheFileName),
+		data:     PageURLCacheData{PageURLs: make(map[int]PageURLEntry)},
+	}
+	// One scan: every entry shares the same Seen time.
+	for id := 1; id <= PageURLCacheMaxEntries+10; id++ {
+		cache.data.PageURLs[id] = PageURLEntry{PageURL: "/matches/x", Seen: seen}
+	}
+	if err := cache.Save(); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if total, _ := cache.Stats(); total != PageURLCacheMaxEntries {
+		t.Fatalf("total = %d, want %d", total, PageURLCacheMaxEntries)
+	}
+	for id := 1; id <= 10; id++ {
+		if cache.Get(id) != "" {
+			t.Errorf("match %d survived eviction; lowest IDs should go first", id)
+		}
+	}
+	if cache.Get(PageURLCacheMaxEntries+10) == "" {
+		t.Error("newest match was evicted")
+	}
+}
@@ -0,0 +1,176 @@
+package fotmob
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// ErrPlayerNotFound is returned (wrapped) by Player for IDs without a player
+// page.
+var ErrPlayerNotFound = errors.New("player not found")
+
+// fotmobPlayerPage is the player data in a player page's props. FotMob nests
+// it under data; older pages had it at the top level.
+type fotmobPlayerPage struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	PrimaryTeam *struct {
+		TeamID   int    `json:"teamId"`
+		TeamName string `json:"teamName"`
+	} `json:"primaryTeam,omitempty"`
+	PositionDescription struct {
+		PrimaryPosition struct {
+			Label string `json:"label"` // e.g. "Striker"
+		} `json:"primaryPosition"`
+	} `json:"positionDescription"`
+	PlayerInformation []struct {
+		Title string `json:"title"` // "Height", "Shirt", "Age", "Country", ...
+		Value struct {
+			Fallback    any      `json:"fallback"` // Display value, e.g. "194 cm"
+			NumberValue *float64 `json:"numberValue,omitempty"`
+		} `json:"value"`
+	} `json:"playerInformation"`
+	MainLeague *struct {
+		LeagueID   int    `json:"leagueId"`
+		LeagueName string `json:"leagueName"`
+		Season     string `json:"season"`
+		Stats      []struct {
+			Title            string `json:"title"`
+			LocalizedTitleID string `json:"localizedTitleId"`
+			Value            any    `json:"value"` // number, or a numeric string for ratings
+		} `json:"stats"`
+	} `json:"mainLeague,omitempty"`
+}
+
+// Player retrieves a player's profile and season stats in their main league
+// from their player page.
+func (c *Client) Player(ctx context.Context, playerID int) (*api.PlayerProfile, error) {
+	if err := c.rateLimiter.WaitKey(ctx, limitKeyPage); err != nil {
+		return nil, err
+	}
+
+	pageProps, err := fetchPlayerFromPage(ctx, c.httpClient, c.siteURL, playerID)
+	if err != nil {
+		return nil, err
+	}
+	page, err := decodePlayerPage(pageProps, playerID)
+	if err != nil {
+		return nil, err
+	}
+	return page.toAPIPlayerProfile(), nil
+}
+
+// fetchPlayerFromPage fetches a player page and extracts its page props,
+// like fetchTeamFromPage does for teams.
+func fetchPlayerFromPage(ctx context.Context, httpClient *http.Client, site string, playerID int) (json.RawMessage, error) {
+	url := fmt.Sprintf("%s/players/%d", site, playerID)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create player page request: %w", err)
+	}
+
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/131.0.0.0 Safari/537.36")
+	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch player page: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %d", ErrPlayerNotFound, playerID)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("player page returned status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read player page body: %w", err)
+	}
+
+	pageProps, err := extractPageProps(string(body))
+	if err != nil {
+		return nil, fmt.Errorf("extract player page props: %w", err)
+	}
+	return pageProps, nil
+}
+
+// decodePlayerPage reads the player data from page props, preferring data
+// over the top level.
+func decodePlayerPage(pageProps json.RawMessage, playerID int) (fotmobPlayerPage, error) {
+	var page fotmobPlayerPage
+	var wrapper struct {
+		Data json.RawMessage `json:"data"`
+	}
+	raw := pageProps
+	if err := json.Unmarshal(pageProps, &wrapper); err == nil && len(wrapper.Data) > 0 && string(wrapper.Data) != "null" {
+		raw = wrapper.Data
+	}
+	if err := json.Unmarshal(raw, &page); err != nil {
+		return page, fmt.Errorf("decode player page for player %d: %w", playerID, err)
+	}
+	if page.ID == 0 {
+		return page, fmt.Errorf("%w: %d (no player data in page)", ErrPlayerNotFound, playerID)
+	}
+	return page, nil
+}
+
+// toAPIPlayerProfile converts the page.
+func (p fotmobPlayerPage) toAPIPlayerProfile() *api.PlayerProfile {
+	profile := &api.PlayerProfile{
+		ID:       p.ID,
+		Name:     p.Name,
+		Position: p.PositionDescription.PrimaryPosition.Label,
+		Stats:    []api.PlayerSeasonStat{},
+	}
+	if p.PrimaryTeam != nil && p.PrimaryTeam.TeamID > 0 {
+		profile.Team = &api.Team{ID: p.PrimaryTeam.TeamID, Name: p.PrimaryTeam.TeamName}
+	}
+
+	for _, info := range p.PlayerInformation {
+		display := formatStatValue(info.Value.Fallback)
+		number := 0
+		if info.Value.NumberValue != nil {
+			number = int(*info.Value.NumberValue)
+		} else {
+			// Display values carry units, e.g. "194 cm".
+			_, _ = fmt.Sscanf(display, "%d", &number)
+		}
+		switch strings.ToLower(info.Title) {
+		case "height":
+			profile.HeightCM = number
+		case "shirt":
+			profile.Number = number
+		case "age":
+			profile.Age = number
+		case "country":
+			profile.Country = display
+		}
+	}
+
+	if l := p.MainLeague; l != nil {
+		if l.LeagueID > 0 {
+			profile.League = &api.League{ID: l.LeagueID, Name: l.LeagueName}
+		}
+		profile.Season = l.Season
+		for _, s := range l.Stats {
+			key := s.LocalizedTitleID
+			if key == "" {
+				key = strings.ReplaceAll(strings.ToLower(s.Title), " ", "_")
+			}
+			value, _ := statFloat(s.Value)
+			profile.Stats = append(profile.Stats, api.PlayerSeasonStat{Key: key, Label: s.Title, Value: value})
+		}
+	}
+	return profile
+}
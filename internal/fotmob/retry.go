@@ -0,0 +1,286 @@
+package fotmob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrCircuitOpen is returned (wrapped) for requests short-circuited by an
+// open circuit breaker: the host answered with repeated 429/5xx responses and
+// the client is backing off until a probe request succeeds.
+var ErrCircuitOpen = errors.New("circuit breaker open")
+
+// RetryPolicy configures how the client retries transient failures and when
+// it stops calling a struggling host altogether.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts per request, including the
+	// first. 1 disables retries.
+	MaxAttempts int
+	// BaseDelay is the backoff before the first retry; it doubles for each
+	// further attempt. The actual delay is jittered between half and all of it.
+	BaseDelay time.Duration
+	// MaxDelay caps the backoff and any Retry-After the server sends.
+	MaxDelay time.Duration
+	// BreakerThreshold is the number of consecutive 429/5xx responses from one
+	// host that opens its circuit breaker.
+	BreakerThreshold int
+	// BreakerCooldown is how long an open breaker short-circuits requests
+	// before letting a single half-open probe through.
+	BreakerCooldown time.Duration
+}
+
+// DefaultRetryPolicy returns the policy NewClient uses: three attempts with
+// 500ms, then 1s backoff, and a breaker that opens after five consecutive
+// 429/5xx responses and probes again after 30s.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:      3,
+		BaseDelay:        500 * time.Millisecond,
+		MaxDelay:         5 * time.Second,
+		BreakerThreshold: 5,
+		BreakerCooldown:  30 * time.Second,
+	}
+}
+
+// backoff returns the jittered delay after the given failed attempt (1-based).
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.BaseDelay << (attempt - 1)
+	if d <= 0 || d > p.MaxDelay {
+		d = p.MaxDelay
+	}
+	if d <= 0 {
+		return 0
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int64N(int64(d-half)+1))
+}
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case breakerOpen:
+		return "open"
+	case breakerHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// circuitBreaker tracks consecutive 429/5xx responses from one host.
+type circuitBreaker struct {
+	state    breakerState
+	failures int
+	openedAt time.Time
+	probing  bool // a half-open probe is in flight
+}
+
+// outcome classifies a finished attempt for the breaker.
+type outcome int
+
+const (
+	outcomeSuccess outcome = iota // the host answered normally (incl. 404)
+	outcomeFailure                // 429 or 5xx
+	outcomeNeutral                // no answer (network error, cancellation)
+)
+
+// resilientTransport retries transient failures with exponential backoff and
+// keeps a circuit breaker per host. NewClient installs it beneath the
+// cassette layer, so recordings hold final results and replays skip it.
+type resilientTransport struct {
+	next   http.RoundTripper
+	policy RetryPolicy
+	now    func() time.Time
+	sleep  func(ctx context.Context, d time.Duration) error
+	log    func(msg string, args ...any)
+
+	mu       sync.Mutex
+	breakers map[string]*circuitBreaker
+}
+
+func newResilientTransport(next http.RoundTripper, policy RetryPolicy, log func(msg string, args ...any)) *resilientTransport {
+	return &resilientTransport{
+		next:     next,
+		policy:   policy,
+		now:      time.Now,
+		sleep:    sleepCtx,
+		log:      log,
+		breakers: make(map[string]*circuitBreaker),
+	}
+}
+
+func sleepCtx(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *resilientTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := req.URL.Host
+	t.mu.Lock()
+	policy := t.policy
+	t.mu.Unlock()
+	// Requests with a body can only be replayed if it can be rewound.
+	retryable := req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+
+	for attempt := 1; ; attempt++ {
+		if retryIn, ok := t.allow(host); !ok {
+			return nil, fmt.Errorf("%w for %s after repeated 429/5xx responses; next probe in %s",
+				ErrCircuitOpen, host, retryIn.Round(time.Second))
+		}
+
+		attemptReq := req
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		result := outcomeNeutral
+		if err == nil {
+			result = outcomeSuccess
+			if isTransientStatus(resp.StatusCode) {
+				result = outcomeFailure
+			}
+		}
+		t.record(host, result)
+
+		transient := result == outcomeFailure || (err != nil && req.Context().Err() == nil)
+		if !transient || !retryable || attempt >= policy.MaxAttempts {
+			return resp, err
+		}
+
+		wait := policy.backoff(attempt)
+		reason := ""
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = resp.Status
+			if ra, ok := retryAfter(resp.Header.Get("Retry-After"), t.now()); ok {
+				wait = min(ra, policy.MaxDelay)
+			}
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+			_ = resp.Body.Close()
+		}
+		t.debug("fotmob retry", "url", req.URL.String(), "attempt", attempt, "reason", reason, "wait", wait)
+		if err := t.sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// isTransientStatus reports whether a response status is worth retrying and
+// counts against the circuit breaker.
+func isTransientStatus(code int) bool {
+	return code == http.StatusTooManyRequests || code >= http.StatusInternalServerError
+}
+
+// retryAfter parses a Retry-After header given in seconds or as an HTTP date.
+func retryAfter(v string, now time.Time) (time.Duration, bool) {
+	if v == "" {
+		return 0, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+	if at, err := http.ParseTime(v); err == nil {
+		return max(at.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+// allow reports whether a request to host may proceed. While the breaker is
+// open it returns the time left until the next probe.
+func (t *resilientTransport) allow(host string) (time.Duration, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	b := t.breakers[host]
+	if b == nil {
+		return 0, true
+	}
+	switch b.state {
+	case breakerOpen:
+		elapsed := t.now().Sub(b.openedAt)
+		if elapsed < t.policy.BreakerCooldown {
+			return t.policy.BreakerCooldown - elapsed, false
+		}
+		b.state, b.probing = breakerHalfOpen, true
+		t.debug("fotmob circuit breaker", "host", host, "state", b.state, "action", "probing")
+		return 0, true
+	case breakerHalfOpen:
+		if b.probing {
+			return t.policy.BreakerCooldown, false
+		}
+		b.probing = true
+		return 0, true
+	}
+	return 0, true
+}
+
+// record feeds an attempt's outcome to host's breaker.
+func (t *resilientTransport) record(host string, result outcome) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	b := t.breakers[host]
+	if b == nil {
+		if result != outcomeFailure {
+			return
+		}
+		b = &circuitBreaker{}
+		t.breakers[host] = b
+	}
+
+	switch result {
+	case outcomeSuccess:
+		if b.state != breakerClosed {
+			t.debug("fotmob circuit breaker", "host", host, "state", breakerClosed, "after_failures", b.failures)
+		}
+		b.state, b.failures, b.probing = breakerClosed, 0, false
+	case outcomeFailure:
+		b.failures++
+		switch {
+		case b.state == breakerHalfOpen:
+			b.state, b.openedAt, b.probing = breakerOpen, t.now(), false
+			t.debug("fotmob circuit breaker", "host", host, "state", b.state, "reason", "probe failed", "failures", b.failures)
+		case b.state == breakerClosed && b.failures >= t.policy.BreakerThreshold:
+			b.state, b.openedAt = breakerOpen, t.now()
+			t.debug("fotmob circuit breaker", "host", host, "state", b.state, "failures", b.failures, "cooldown", t.policy.BreakerCooldown)
+		}
+	case outcomeNeutral:
+		// No verdict on the host; let another probe through.
+		b.probing = false
+	}
+}
+
+func (t *resilientTransport) debug(msg string, args ...any) {
+	if t.log != nil {
+		t.log(msg, args...)
+	}
+}
@@ -0,0 +1,248 @@
+package fotmob
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeClock drives a resilientTransport without real sleeps.
+type fakeClock struct {
+	mu    sync.Mutex
+	now   time.Time
+	slept []time.Duration
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Sleep(_ context.Context, d time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.slept = append(c.slept, d)
+	c.now = c.now.Add(d)
+	return nil
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// statusServer answers with the next status from statuses, then 200.
+func statusServer(t *testing.T, header http.Header, statuses ...int) (*httptest.Server, *int) {
+	t.Helper()
+	var mu sync.Mutex
+	hits := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		i := hits
+		hits++
+		mu.Unlock()
+		if i < len(statuses) {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(statuses[i])
+			return
+		}
+		_, _ = w.Write([]byte("ok"))
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &hits
+}
+
+func newTestTransport(policy RetryPolicy) (*resilientTransport, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2026, 1, 7, 12, 0, 0, 0, time.UTC)}
+	tr := newResilientTransport(http.DefaultTransport, policy, nil)
+	tr.now = clock.Now
+	tr.sleep = clock.Sleep
+	return tr, clock
+}
+
+func get(t *testing.T, tr http.RoundTripper, url string) (*http.Response, error) {
+	t.Helper()
+	resp, err := (&http.Client{Transport: tr}).Get(url)
+	if resp != nil {
+		_ = resp.Body.Close()
+	}
+	return resp, err
+}
+
+func TestResilientTransport_RetriesTransientStatus(t *testing.T) {
+	srv, hits := statusServer(t, nil, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	tr, clock := newTestTransport(DefaultRetryPolicy())
+
+	resp, err := get(t, tr, srv.URL)
+	if err != nil || resp.StatusCode != http.StatusOK {
+		t.Fatalf("resp = %v (%v), want 200 after retries", resp, err)
+	}
+	if *hits != 3 {
+		t.Errorf("hits = %d, want 3", *hits)
+	}
+	if len(clock.slept) != 2 {
+		t.Fatalf("slept %v, want two backoffs", clock.slept)
+	}
+	if d := clock.slept[0]; d < 250*time.Millisecond || d > 500*time.Millisecond {
+		t.Errorf("first backoff = %s, want within [250ms, 500ms]", d)
+	}
+	if d := clock.slept[1]; d < 500*time.Millisecond || d > time.Second {
+		t.Errorf("second backoff = %s, want within [500ms, 1s]", d)
+	}
+}
+
+func TestResilientTransport_GivesUpAfterMaxAttempts(t *testing.T) {
+	srv, hits := statusServer(t, nil, 500, 500, 500, 500)
+	tr, _ := newTestTransport(DefaultRetryPolicy())
+
+	resp, err := get(t, tr, srv.URL)
+	if err != nil || resp.StatusCode != http.StatusInternalServerError {
+		t.Fatalf("resp = %v (%v), want the last 500", resp, err)
+	}
+	if *hits != 3 {
+		t.Errorf("hits = %d, want MaxAttempts", *hits)
+	}
+}
+
+func TestResilientTransport_NoRetryOnClientError(t *testing.T) {
+	srv, hits := statusServer(t, nil, http.StatusNotFound)
+	tr, clock := newTestTransport(DefaultRetryPolicy())
+
+	if resp, err := get(t, tr, srv.URL); err != nil || resp.StatusCode != http.StatusNotFound {
+		t.Fatalf("resp = %v (%v), want 404", resp, err)
+	}
+	if *hits != 1 || len(clock.slept) != 0 {
+		t.Errorf("hits = %d, slept %v: 404 must not be retried", *hits, clock.slept)
+	}
+}
+
+func TestResilientTransport_HonoursRetryAfter(t *testing.T) {
+	srv, _ := statusServer(t, http.Header{"Retry-After": {"2"}}, http.StatusTooManyRequests)
+	tr, clock := newTestTransport(DefaultRetryPolicy())
+
+	if _, err := get(t, tr, srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	if len(clock.slept) != 1 || clock.slept[0] != 2*time.Second {
+		t.Errorf("slept %v, want the server's 2s", clock.slept)
+	}
+
+	srv, _ = statusServer(t, http.Header{"Retry-After": {"3600"}}, http.StatusTooManyRequests)
+	clock.slept = nil
+	if _, err := get(t, tr, srv.URL); err != nil {
+		t.Fatal(err)
+	}
+	if len(clock.slept) != 1 || clock.slept[0] != DefaultRetryPolicy().MaxDelay {
+		t.Errorf("slept %v, want Retry-After capped at MaxDelay", clock.slept)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 7, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		in   string
+		want time.Duration
+		ok   bool
+	}{
+		{"", 0, false},
+		{"5", 5 * time.Second, true},
+		{now.Add(90 * time.Second).Format(http.TimeFormat), 90 * time.Second, true},
+		{now.Add(-time.Minute).Format(http.TimeFormat), 0, true},
+		{"soon", 0, false},
+	}
+	for _, tt := range tests {
+		got, ok := retryAfter(tt.in, now)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("retryAfter(%q) = %s, %v; want %s, %v", tt.in, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestResilientTransport_CircuitBreaker(t *testing.T) {
+	var mu sync.Mutex
+	status := http.StatusBadGateway
+	hits := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		hits++
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+	setStatus := func(s int) {
+		mu.Lock()
+		defer mu.Unlock()
+		status, hits = s, 0
+	}
+
+	policy := DefaultRetryPolicy()
+	policy.MaxAttempts = 1
+	policy.BreakerThreshold = 3
+	tr, clock := newTestTransport(policy)
+
+	for i := 0; i < 3; i++ {
+		if _, err := get(t, tr, srv.URL); err != nil {
+			t.Fatalf("request %d: %v", i, err)
+		}
+	}
+	setStatus(http.StatusOK)
+	_, err := get(t, tr, srv.URL)
+	if !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("after %d failures err = %v, want ErrCircuitOpen", policy.BreakerThreshold, err)
+	}
+	if hits != 0 {
+		t.Errorf("open breaker let %d requests through", hits)
+	}
+
+	// After the cooldown a failing probe reopens the breaker.
+	setStatus(http.StatusServiceUnavailable)
+	clock.Advance(policy.BreakerCooldown)
+	if resp, err := get(t, tr, srv.URL); err != nil || resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("probe = %v (%v), want the 503", resp, err)
+	}
+	if _, err := get(t, tr, srv.URL); !errors.Is(err, ErrCircuitOpen) {
+		t.Errorf("after a failed probe err = %v, want ErrCircuitOpen", err)
+	}
+
+	// A successful probe closes it again.
+	setStatus(http.StatusOK)
+	clock.Advance(policy.BreakerCooldown)
+	for i := 0; i < 2; i++ {
+		if resp, err := get(t, tr, srv.URL); err != nil || resp.StatusCode != http.StatusOK {
+			t.Fatalf("request %d after recovery = %v (%v)", i, resp, err)
+		}
+	}
+	if hits != 2 {
+		t.Errorf("hits after recovery = %d, want 2", hits)
+	}
+}
+
+func TestResilientTransport_HalfOpenAllowsOneProbe(t *testing.T) {
+	policy := DefaultRetryPolicy()
+	policy.BreakerThreshold = 1
+	tr, clock := newTestTransport(policy)
+	tr.record("fotmob.test", outcomeFailure)
+
+	clock.Advance(policy.BreakerCooldown)
+	if _, ok := tr.allow("fotmob.test"); !ok {
+		t.Fatal("first request after the cooldown should probe")
+	}
+	if _, ok := tr.allow("fotmob.test"); ok {
+		t.Error("a second request must wait for the probe")
+	}
+	if _, ok := tr.allow("other.test"); !ok {
+		t.Error("breakers are per host")
+	}
+	tr.record("fotmob.test", outcomeSuccess)
+	if _, ok := tr.allow("fotmob.test"); !ok {
+		t.Error("successful probe should close the breaker")
+	}
+}
@@ -0,0 +1,260 @@
+package fotmob
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/0xjuanma/golazo/internal/api"
+)
+
+// TeamMatches is how many recent results and upcoming fixtures Team returns.
+const TeamMatches = 5
+
+// ErrTeamNotFound is returned (wrapped) by Team for IDs without a team page.
+var ErrTeamNotFound = errors.New("team not found")
+
+// fotmobTeamPage is the team data in a team page's props. FotMob nests it
+// under fallback["team-{id}"]; older pages had it at the top level.
+type fotmobTeamPage struct {
+	Details struct {
+		ID                int    `json:"id"`
+		Name              string `json:"name"`
+		ShortName         string `json:"shortName"`
+		Country           string `json:"country"`
+		PrimaryLeagueID   int    `json:"primaryLeagueId"`
+		PrimaryLeagueName string `json:"primaryLeagueName"`
+	} `json:"details"`
+	Squad struct {
+		Squad []struct {
+			Title   string              `json:"title"` // "coach", "keepers", "defenders", "midfielders", "attackers"
+			Members []fotmobSquadMember `json:"members"`
+		} `json:"squad"`
+	} `json:"squad"`
+	Fixtures struct {
+		AllFixtures struct {
+			Fixtures []fotmobTeamFixture `json:"fixtures"`
+		} `json:"allFixtures"`
+	} `json:"fixtures"`
+}
+
+type fotmobSquadMember struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	ShirtNumber any    `json:"shirtNumber"` // int or string, absent for coaches
+	CName       string `json:"cname"`       // Country name
+	Age         int    `json:"age"`
+}
+
+// fotmobTeamFixture is a season fixture on the team page. Unlike league
+// listings, IDs are numbers here.
+type fotmobTeamFixture struct {
+	ID      int    `json:"id"`
+	PageURL string `json:"pageUrl"`
+	Home    struct {
+		ID        int    `json:"id"`
+		Name      string `json:"name"`
+		ShortName string `json:"shortName"`
+	} `json:"home"`
+	Away struct {
+		ID        int    `json:"id"`
+		Name      string `json:"name"`
+		ShortName string `json:"shortName"`
+	} `json:"away"`
+	Status     status `json:"status"`
+	Tournament struct {
+		LeagueID int    `json:"leagueId"`
+		Name     string `json:"name"`
+	} `json:"tournament"`
+}
+
+// squadPositions maps FotMob squad group titles to api positions.
+var squadPositions = map[string]string{
+	"coach":       api.PositionCoach,
+	"keepers":     api.PositionGoalkeeper,
+	"defenders":   api.PositionDefender,
+	"midfielders": api.PositionMidfielder,
+	"attackers":   api.PositionForward,
+}
+
+// Team retrieves a team's squad, its last TeamMatches results and next
+// TeamMatches fixtures, its form and its row in the primary league table.
+// The table is read from the (cached) league page; a league without one
+// leaves Standing nil. Match page slugs seen in the fixtures are stored for
+// MatchDetails.
+func (c *Client) Team(ctx context.Context, teamID int) (*api.TeamOverview, error) {
+	if err := c.rateLimiter.WaitKey(ctx, limitKeyPage); err != nil {
+		return nil, err
+	}
+
+	pageProps, err := fetchTeamFromPage(ctx, c.httpClient, c.siteURL, teamID)
+	if err != nil {
+		return nil, err
+	}
+	page, err := decodeTeamPage(pageProps, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	overview := page.toAPITeamOverview()
+	for _, m := range append(overview.Results, overview.Fixtures...) {
+		c.StorePageURL(m.ID, m.PageURL)
+	}
+
+	if overview.League != nil {
+		table, err := c.fetchLeagueTable(ctx, overview.League.ID)
+		if err != nil {
+			c.debugLog("Team: no league table", "teamID", teamID, "leagueID", overview.League.ID, "error", err)
+		}
+		for i := range table {
+			if table[i].Team.ID == teamID {
+				overview.Standing = &table[i]
+				break
+			}
+		}
+	}
+	return overview, nil
+}
+
+// fetchTeamFromPage fetches a team page and extracts its page props, like
+// fetchLeagueFromPage does for leagues.
+func fetchTeamFromPage(ctx context.Context, httpClient *http.Client, site string, teamID int) (json.RawMessage, error) {
+	url := fmt.Sprintf("%s/teams/%d/overview", site, teamID)
+
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("create team page request: %w", err)
+	}
+
+	req.Header.Set("User-Agent", "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/131.0.0.0 Safari/537.36")
+	req.Header.Set("Accept", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8")
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetch team page: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: %d", ErrTeamNotFound, teamID)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("team page returned status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("read team page body: %w", err)
+	}
+
+	pageProps, err := extractPageProps(string(body))
+	if err != nil {
+		return nil, fmt.Errorf("extract team page props: %w", err)
+	}
+	return pageProps, nil
+}
+
+// decodeTeamPage reads the team data from page props, preferring
+// fallback["team-{id}"] over the top level.
+func decodeTeamPage(pageProps json.RawMessage, teamID int) (fotmobTeamPage, error) {
+	var page fotmobTeamPage
+	var wrapper struct {
+		Fallback map[string]json.RawMessage `json:"fallback"`
+	}
+	raw := pageProps
+	if err := json.Unmarshal(pageProps, &wrapper); err == nil {
+		if nested, ok := wrapper.Fallback["team-"+strconv.Itoa(teamID)]; ok {
+			raw = nested
+		}
+	}
+	if err := json.Unmarshal(raw, &page); err != nil {
+		return page, fmt.Errorf("decode team page for team %d: %w", teamID, err)
+	}
+	if page.Details.ID == 0 {
+		return page, fmt.Errorf("%w: %d (no team details in page)", ErrTeamNotFound, teamID)
+	}
+	return page, nil
+}
+
+// toAPITeamOverview converts the page; Standing is filled in by Team.
+func (p fotmobTeamPage) toAPITeamOverview() *api.TeamOverview {
+	teamID := p.Details.ID
+	overview := &api.TeamOverview{
+		Team:     api.Team{ID: teamID, Name: p.Details.Name, ShortName: p.Details.ShortName},
+		Country:  p.Details.Country,
+		Results:  []api.Match{},
+		Fixtures: []api.Match{},
+		Squad:    []api.SquadMember{},
+	}
+	if p.Details.PrimaryLeagueID > 0 {
+		overview.League = &api.League{ID: p.Details.PrimaryLeagueID, Name: p.Details.PrimaryLeagueName}
+	}
+
+	for _, group := range p.Squad.Squad {
+		position, ok := squadPositions[group.Title]
+		if !ok {
+			position = group.Title
+		}
+		for _, m := range group.Members {
+			overview.Squad = append(overview.Squad, api.SquadMember{
+				ID:       m.ID,
+				Name:     m.Name,
+				Number:   parseInt(formatStatValue(m.ShirtNumber)),
+				Position: position,
+				Age:      m.Age,
+				Country:  m.CName,
+			})
+		}
+	}
+
+	var results, fixtures []api.Match
+	for _, f := range p.Fixtures.AllFixtures.Fixtures {
+		m := f.toAPIMatch()
+		switch m.Status {
+		case api.MatchStatusFinished:
+			results = append(results, m)
+		case api.MatchStatusNotStarted, api.MatchStatusLive:
+			fixtures = append(fixtures, m)
+		}
+	}
+	sortByKickoff(results)
+	sortByKickoff(fixtures)
+
+	// Form reads oldest first over the same window as Results.
+	recent := results[max(len(results)-TeamMatches, 0):]
+	overview.Form = api.FormString(teamID, recent)
+	for i := len(recent) - 1; i >= 0; i-- {
+		overview.Results = append(overview.Results, recent[i])
+	}
+	overview.Fixtures = append(overview.Fixtures, fixtures[:min(len(fixtures), TeamMatches)]...)
+	return overview
+}
+
+// toAPIMatch converts the fixture through fotmobMatch, which owns the
+// status and score rules.
+func (f fotmobTeamFixture) toAPIMatch() api.Match {
+	return fotmobMatch{
+		ID:      strconv.Itoa(f.ID),
+		Home:    team{ID: strconv.Itoa(f.Home.ID), Name: f.Home.Name, ShortName: f.Home.ShortName},
+		Away:    team{ID: strconv.Itoa(f.Away.ID), Name: f.Away.Name, ShortName: f.Away.ShortName},
+		Status:  f.Status,
+		League:  league{ID: f.Tournament.LeagueID, Name: f.Tournament.Name},
+		PageURL: f.PageURL,
+	}.toAPIMatch()
+}
+
+// sortByKickoff orders matches by kickoff time, undated ones last.
+func sortByKickoff(matches []api.Match) {
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i].MatchTime, matches[j].MatchTime
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return a.Before(*b)
+	})
+}
@@ -3,6 +3,7 @@ package fotmob
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -237,9 +238,90 @@ type fotmobMatchDetails struct {
 			HomeTeam *fotmobNewLineup   `json:"homeTeam,omitempty"`
 			AwayTeam *fotmobNewLineup   `json:"awayTeam,omitempty"`
 		} `json:"lineup,omitempty"`
+		Momentum json.RawMessage `json:"momentum,omitempty"` // fotmobMomentum, or false when unavailable
+		Shotmap  json.RawMessage `json:"shotmap,omitempty"`  // fotmobShotmap, or false when unavailable
+
+		// Per-player stats keyed by player ID (fotmobPlayerStats), or null
+		// before kickoff
+		PlayerStats json.RawMessage `json:"playerStats,omitempty"`
+		H2H         json.RawMessage `json:"h2h,omitempty"` // fotmobH2H, or false when unavailable
 	} `json:"content"`
 }
 
+// fotmobMomentum is content.momentum
+type fotmobMomentum struct {
+	Main struct {
+		Data []struct {
+			Minute float64 `json:"minute"`
+			Value  float64 `json:"value"`
+		} `json:"data"`
+	} `json:"main"`
+}
+
+// fotmobShotmap is content.shotmap
+type fotmobShotmap struct {
+	Shots []fotmobShot `json:"shots"`
+}
+
+// fotmobShot is one entry of content.shotmap.shots
+type fotmobShot struct {
+	EventType             string   `json:"eventType"` // "Goal", "AttemptSaved", "Miss", "Post"
+	TeamID                int      `json:"teamId"`
+	PlayerID              int      `json:"playerId"`
+	PlayerName            string   `json:"playerName"`
+	X                     float64  `json:"x"`
+	Y                     float64  `json:"y"`
+	Min                   int      `json:"min"`
+	MinAdded              int      `json:"minAdded"`
+	IsBlocked             bool     `json:"isBlocked"`
+	IsOwnGoal             bool     `json:"isOwnGoal"`
+	ExpectedGoals         *float64 `json:"expectedGoals"`
+	ExpectedGoalsOnTarget *float64 `json:"expectedGoalsOnTarget"`
+	ShotType              string   `json:"shotType"`
+	Situation             string   `json:"situation"`
+}
+
+// fotmobPlayerStats is one player's entry of content.playerStats: stat
+// groups ("top_stats", "attack", "duels", ...) of stats keyed by title
+type fotmobPlayerStats struct {
+	ID    int `json:"id"`
+	Stats []struct {
+		Key   string `json:"key"`
+		Stats map[string]struct {
+			Key  string `json:"key"`
+			Stat struct {
+				Value any `json:"value"`
+				Total any `json:"total,omitempty"` // Attempts, for fraction stats like accurate_passes
+			} `json:"stat"`
+		} `json:"stats"`
+	} `json:"stats"`
+}
+
+// fotmobH2H is content.h2h: the previous meetings of the two teams
+type fotmobH2H struct {
+	Summary []int            `json:"summary"` // [home team wins, draws, away team wins]
+	Matches []fotmobH2HMatch `json:"matches"`
+}
+
+// fotmobH2HMatch is one meeting in content.h2h.matches. Team IDs may be
+// numbers or strings.
+type fotmobH2HMatch struct {
+	MatchURL string `json:"matchUrl"` // "/matches/{slug}#{matchID}"
+	Home     struct {
+		ID   any    `json:"id"`
+		Name string `json:"name"`
+	} `json:"home"`
+	Away struct {
+		ID   any    `json:"id"`
+		Name string `json:"name"`
+	} `json:"away"`
+	League struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	} `json:"league"`
+	Status status `json:"status"`
+}
+
 // fotmobStatCategory represents a category of match statistics
 type fotmobStatCategory struct {
 	Title string           `json:"title"`
@@ -284,6 +366,9 @@ type fotmobNewPlayerInfo struct {
 	Performance *struct {
 		Rating       json.Number `json:"rating"`
 		FantasyScore string      `json:"fantasyScore,omitempty"`
+		Events       []struct {
+			Type string `json:"type"` // "goal", "assist", "yellowCard", "redCard", ...
+		} `json:"events,omitempty"`
 	} `json:"performance,omitempty"`
 }
 
@@ -462,9 +547,17 @@ func (m fotmobMatchDetails) toAPIMatchDetails() *api.MatchDetails {
 	// Parse match statistics
 	details.Statistics = m.parseStatistics()
 
+	// Parse momentum, shot map and xG
+	details.Momentum = m.parseMomentum()
+	details.Shots = m.parseShots()
+	details.XGTimeline = xgTimeline(details.Shots)
+	details.HomeXG, details.AwayXG = m.expectedGoals(details.Shots)
+
 	// Parse lineup information
 	m.parseLineups(details)
 
+	details.HeadToHead = m.parseHeadToHead(details.ID)
+
 	// Parse highlight video if available
 	if m.Content.MatchFacts.Highlights != nil {
 		details.Highlight = &api.MatchHighlight{
@@ -653,6 +746,175 @@ func (m fotmobMatchDetails) parseStatistics() []api.MatchStatistic {
 	return stats
 }
 
+// parseMomentum extracts the per-minute momentum graph.
+func (m fotmobMatchDetails) parseMomentum() []api.MomentumPoint {
+	var momentum fotmobMomentum
+	if json.Unmarshal(m.Content.Momentum, &momentum) != nil {
+		return nil
+	}
+	var points []api.MomentumPoint
+	for _, p := range momentum.Main.Data {
+		points = append(points, api.MomentumPoint{Minute: p.Minute, Value: int(math.Round(p.Value))})
+	}
+	return points
+}
+
+// parseHeadToHead extracts the previous meetings, most recent first,
+// leaving out matchID itself. The record is FotMob's summary, which counts
+// more meetings than it lists, or else a tally of the listed ones.
+func (m fotmobMatchDetails) parseHeadToHead(matchID int) *api.HeadToHead {
+	var h2h fotmobH2H
+	if json.Unmarshal(m.Content.H2H, &h2h) != nil || (len(h2h.Matches) == 0 && len(h2h.Summary) != 3) {
+		return nil
+	}
+	var matches []api.Match
+	for _, hm := range h2h.Matches {
+		id := ""
+		if i := strings.LastIndex(hm.MatchURL, "#"); i >= 0 {
+			id = hm.MatchURL[i+1:]
+		}
+		match := fotmobMatch{
+			ID:      id,
+			Home:    team{ID: formatStatValue(hm.Home.ID), Name: hm.Home.Name},
+			Away:    team{ID: formatStatValue(hm.Away.ID), Name: hm.Away.Name},
+			Status:  hm.Status,
+			League:  league{ID: hm.League.ID, Name: hm.League.Name},
+			PageURL: hm.MatchURL,
+		}.toAPIMatch()
+		if match.ID != matchID {
+			matches = append(matches, match)
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		a, b := matches[i].MatchTime, matches[j].MatchTime
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return a.After(*b)
+	})
+
+	h := api.NewHeadToHead(m.General.HomeTeam.ID, matches)
+	if len(h2h.Summary) == 3 {
+		h.HomeWins, h.Draws, h.AwayWins = h2h.Summary[0], h2h.Summary[1], h2h.Summary[2]
+	}
+	return h
+}
+
+// parseShots extracts the shot map in match order.
+func (m fotmobMatchDetails) parseShots() []api.Shot {
+	var shotmap fotmobShotmap
+	if json.Unmarshal(m.Content.Shotmap, &shotmap) != nil {
+		return nil
+	}
+	var shots []api.Shot
+	for _, s := range shotmap.Shots {
+		shot := api.Shot{
+			Minute:      s.Min,
+			AddedMinute: s.MinAdded,
+			TeamID:      s.TeamID,
+			IsHome:      s.TeamID == m.General.HomeTeam.ID,
+			PlayerID:    s.PlayerID,
+			Player:      s.PlayerName,
+			XGOT:        s.ExpectedGoalsOnTarget,
+			Outcome:     shotOutcome(s),
+			ShotType:    s.ShotType,
+			Situation:   s.Situation,
+			X:           s.X,
+			Y:           s.Y,
+			OwnGoal:     s.IsOwnGoal,
+		}
+		if s.ExpectedGoals != nil {
+			shot.XG = *s.ExpectedGoals
+		}
+		shots = append(shots, shot)
+	}
+	sort.SliceStable(shots, func(i, j int) bool {
+		if shots[i].Minute != shots[j].Minute {
+			return shots[i].Minute < shots[j].Minute
+		}
+		return shots[i].AddedMinute < shots[j].AddedMinute
+	})
+	return shots
+}
+
+// shotOutcome maps a FotMob eventType onto the api.ShotOutcome constants.
+func shotOutcome(s fotmobShot) string {
+	switch s.EventType {
+	case "Goal":
+		return api.ShotOutcomeGoal
+	case "AttemptSaved":
+		if s.IsBlocked {
+			return api.ShotOutcomeBlocked
+		}
+		return api.ShotOutcomeSaved
+	case "Post":
+		return api.ShotOutcomePost
+	default:
+		return api.ShotOutcomeMissed
+	}
+}
+
+// xgTimeline accumulates each side's xG shot by shot. Own goals carry no xG
+// for either side.
+func xgTimeline(shots []api.Shot) []api.XGPoint {
+	var (
+		points     []api.XGPoint
+		home, away float64
+	)
+	for _, s := range shots {
+		if s.OwnGoal {
+			continue
+		}
+		if s.IsHome {
+			home += s.XG
+		} else {
+			away += s.XG
+		}
+		points = append(points, api.XGPoint{Minute: s.Minute, AddedMinute: s.AddedMinute, HomeXG: round2(home), AwayXG: round2(away)})
+	}
+	return points
+}
+
+// expectedGoals returns the match xG from the "expected_goals" stat, falling
+// back to the shot map total.
+func (m fotmobMatchDetails) expectedGoals(shots []api.Shot) (home, away *float64) {
+	for _, category := range m.Content.Stats.Periods.All.Stats {
+		for _, stat := range category.Stats {
+			if stat.Key != "expected_goals" || len(stat.Stats) < 2 {
+				continue
+			}
+			h, okH := statFloat(stat.Stats[0])
+			a, okA := statFloat(stat.Stats[1])
+			if okH && okA {
+				return &h, &a
+			}
+		}
+	}
+	timeline := xgTimeline(shots)
+	if len(timeline) == 0 {
+		return nil, nil
+	}
+	last := timeline[len(timeline)-1]
+	return &last.HomeXG, &last.AwayXG
+}
+
+// statFloat reads a numeric stat value that may be encoded as a string.
+func statFloat(val any) (float64, bool) {
+	switch v := val.(type) {
+	case float64:
+		return v, true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func round2(f float64) float64 {
+	return math.Round(f*100) / 100
+}
+
 // formatStatValue converts a stat value (can be int, float, or string) to string
 func formatStatValue(val any) string {
 	switch v := val.(type) {
@@ -674,16 +936,18 @@ func formatStatValue(val any) string {
 // parseLineups extracts lineup information from FotMob response
 // Supports both old format (lineup.lineup[]) and new format (lineup.homeTeam/awayTeam)
 func (m fotmobMatchDetails) parseLineups(details *api.MatchDetails) {
+	stats := m.parsePlayerStats()
+
 	// Try new format first (homeTeam/awayTeam structure)
 	if m.Content.Lineup.HomeTeam != nil {
 		details.HomeFormation = m.Content.Lineup.HomeTeam.Formation
-		details.HomeStarting = convertNewLineupPlayers(m.Content.Lineup.HomeTeam.Starters)
-		details.HomeSubstitutes = convertNewLineupPlayers(m.Content.Lineup.HomeTeam.Subs)
+		details.HomeStarting = convertNewLineupPlayers(m.Content.Lineup.HomeTeam.Starters, stats)
+		details.HomeSubstitutes = convertNewLineupPlayers(m.Content.Lineup.HomeTeam.Subs, stats)
 	}
 	if m.Content.Lineup.AwayTeam != nil {
 		details.AwayFormation = m.Content.Lineup.AwayTeam.Formation
-		details.AwayStarting = convertNewLineupPlayers(m.Content.Lineup.AwayTeam.Starters)
-		details.AwaySubstitutes = convertNewLineupPlayers(m.Content.Lineup.AwayTeam.Subs)
+		details.AwayStarting = convertNewLineupPlayers(m.Content.Lineup.AwayTeam.Starters, stats)
+		details.AwaySubstitutes = convertNewLineupPlayers(m.Content.Lineup.AwayTeam.Subs, stats)
 	}
 
 	// If new format didn't provide data, try old format
@@ -711,6 +975,7 @@ func (m fotmobMatchDetails) parseLineups(details *api.MatchDetails) {
 					if p.Rating != nil {
 						player.Rating = p.Rating.Num
 					}
+					player.Stats = stats[p.ID]
 					starting = append(starting, player)
 				}
 			}
@@ -727,6 +992,7 @@ func (m fotmobMatchDetails) parseLineups(details *api.MatchDetails) {
 				if p.Rating != nil {
 					player.Rating = p.Rating.Num
 				}
+				player.Stats = stats[p.ID]
 				substitutes = append(substitutes, player)
 			}
 
@@ -741,8 +1007,10 @@ func (m fotmobMatchDetails) parseLineups(details *api.MatchDetails) {
 	}
 }
 
-// convertNewLineupPlayers converts new format player info to API format
-func convertNewLineupPlayers(players []fotmobNewPlayerInfo) []api.PlayerInfo {
+// convertNewLineupPlayers converts new format player info to API format,
+// attaching each player's match stats and counting their cards from the
+// performance events.
+func convertNewLineupPlayers(players []fotmobNewPlayerInfo, stats map[int]*api.PlayerMatchStats) []api.PlayerInfo {
 	result := make([]api.PlayerInfo, 0, len(players))
 	for _, p := range players {
 		var number int
@@ -752,15 +1020,77 @@ func convertNewLineupPlayers(players []fotmobNewPlayerInfo) []api.PlayerInfo {
 			ID:     p.ID,
 			Name:   p.Name,
 			Number: number,
+			Stats:  stats[p.ID],
 		}
 		if p.Performance != nil {
 			player.Rating = string(p.Performance.Rating)
+			if player.Stats != nil {
+				for _, e := range p.Performance.Events {
+					switch e.Type {
+					case "yellowCard":
+						player.Stats.YellowCards++
+					case "redCard", "yellowRedCard":
+						player.Stats.RedCards++
+					}
+				}
+			}
 		}
 		result = append(result, player)
 	}
 	return result
 }
 
+// parsePlayerStats extracts content.playerStats by player ID. Cards are not
+// part of it; the lineup's performance events carry them.
+func (m fotmobMatchDetails) parsePlayerStats() map[int]*api.PlayerMatchStats {
+	var raw map[string]fotmobPlayerStats
+	if json.Unmarshal(m.Content.PlayerStats, &raw) != nil || len(raw) == 0 {
+		return nil
+	}
+	stats := make(map[int]*api.PlayerMatchStats, len(raw))
+	for key, p := range raw {
+		id := p.ID
+		if id == 0 {
+			id = parseInt(key)
+		}
+		s := &api.PlayerMatchStats{}
+		duelsLost := 0
+		for _, group := range p.Stats {
+			for _, item := range group.Stats {
+				value := statInt(item.Stat.Value)
+				switch item.Key {
+				case "minutes_played":
+					s.Minutes = value
+				case "goals":
+					s.Goals = value
+				case "assists":
+					s.Assists = value
+				case "total_shots":
+					s.Shots = value
+				case "ShotsOnTarget":
+					s.ShotsOnTarget = value
+				case "accurate_passes":
+					s.PassesCompleted = value
+					s.Passes = statInt(item.Stat.Total)
+				case "duel_won":
+					s.DuelsWon = value
+				case "duel_lost":
+					duelsLost = value
+				}
+			}
+		}
+		s.Duels = s.DuelsWon + duelsLost
+		stats[id] = s
+	}
+	return stats
+}
+
+// statInt reads a whole-number playerStats value.
+func statInt(v any) int {
+	f, _ := statFloat(v)
+	return int(math.Round(f))
+}
+
 // fotmobTableRow represents a single row in the league table from FotMob
 // Matches the structure at table[0].data.table.all[]
 type fotmobTableRow struct {
@@ -1,6 +1,8 @@
 package fotmob
 
 import (
+	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
@@ -436,3 +438,202 @@ func TestToAPIMatchDetails_NoAggregateScore(t *testing.T) {
 		t.Errorf("WhoLostOnAggregate = %q, want empty for non-knockout match", got.WhoLostOnAggregate)
 	}
 }
+
+func TestXGTimeline_StoppageTimeKeepsMatchOrder(t *testing.T) {
+	const body = `{
+	 "general": {"homeTeam": {"id": 8456}, "awayTeam": {"id": 9825}},
+	 "content": {"shotmap": {"shots": [
+	  {"eventType": "Miss", "teamId": 9825, "playerName": "Rice", "min": 46, "expectedGoals": 0.2},
+	  {"eventType": "Miss", "teamId": 8456, "playerName": "Foden", "min": 45, "minAdded": 3, "expectedGoals": 0.1}
+	 ]}}
+	}`
+	var m fotmobMatchDetails
+	if err := json.Unmarshal([]byte(body), &m); err != nil {
+		t.Fatal(err)
+	}
+	got := m.toAPIMatchDetails().XGTimeline
+
+	// 45+3 comes before 46 and keeps its regular minute.
+	want := []api.XGPoint{
+		{Minute: 45, AddedMinute: 3, HomeXG: 0.1},
+		{Minute: 46, HomeXG: 0.1, AwayXG: 0.2},
+	}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("XGTimeline = %+v, want %+v", got, want)
+	}
+}
+
+func TestToAPIMatchDetails_MomentumAndShots(t *testing.T) {
+	const body = `{
+	 "general": {"homeTeam": {"id": 8456}, "awayTeam": {"id": 9825}},
+	 "content": {
+	  "momentum": {"main": {"data": [{"minute": 1, "value": 42.6}, {"minute": 1.5, "value": -80}]}},
+	  "shotmap": {"shots": [
+	   {"eventType": "AttemptSaved", "teamId": 9825, "playerName": "Saka", "min": 30, "isBlocked": true, "expectedGoals": 0.05, "x": 90, "y": 30},
+	   {"eventType": "Goal", "teamId": 8456, "playerId": 1, "playerName": "Haaland", "min": 12, "expectedGoals": 0.76, "expectedGoalsOnTarget": 0.9, "shotType": "LeftFoot", "situation": "RegularPlay", "x": 98.2, "y": 33.1},
+	   {"eventType": "Goal", "teamId": 9825, "playerName": "Dias", "min": 45, "minAdded": 2, "isOwnGoal": true, "expectedGoals": 0.3},
+	   {"eventType": "Miss", "teamId": 9825, "playerName": "Rice", "min": 45, "minAdded": 1, "expectedGoals": 0.1},
+	   {"eventType": "Post", "teamId": 8456, "playerName": "Foden", "min": 80, "expectedGoals": 0.2}
+	  ]}
+	 }
+	}`
+	var m fotmobMatchDetails
+	if err := json.Unmarshal([]byte(body), &m); err != nil {
+		t.Fatal(err)
+	}
+	got := m.toAPIMatchDetails()
+
+	if len(got.Momentum) != 2 || got.Momentum[0] != (api.MomentumPoint{Minute: 1, Value: 43}) || got.Momentum[1].Value != -80 {
+		t.Errorf("Momentum = %+v", got.Momentum)
+	}
+
+	wantOutcomes := []string{api.ShotOutcomeGoal, api.ShotOutcomeBlocked, api.ShotOutcomeMissed, api.ShotOutcomeGoal, api.ShotOutcomePost}
+	if len(got.Shots) != len(wantOutcomes) {
+		t.Fatalf("Shots = %+v", got.Shots)
+	}
+	for i, want := range wantOutcomes {
+		if got.Shots[i].Outcome != want {
+			t.Errorf("shot %d outcome = %q, want %q", i, got.Shots[i].Outcome, want)
+		}
+	}
+	if s := got.Shots[0]; !s.IsHome || s.Player != "Haaland" || s.XG != 0.76 || s.XGOT == nil || *s.XGOT != 0.9 || s.X != 98.2 || s.ShotType != "LeftFoot" {
+		t.Errorf("first shot = %+v", s)
+	}
+	if got.Shots[1].IsHome {
+		t.Error("away shot marked as home")
+	}
+
+	// The own goal is skipped; the rest accumulate per side.
+	wantTimeline := []api.XGPoint{
+		{Minute: 12, HomeXG: 0.76},
+		{Minute: 30, HomeXG: 0.76, AwayXG: 0.05},
+		{Minute: 45, AddedMinute: 1, HomeXG: 0.76, AwayXG: 0.15},
+		{Minute: 80, HomeXG: 0.96, AwayXG: 0.15},
+	}
+	if len(got.XGTimeline) != len(wantTimeline) {
+		t.Fatalf("XGTimeline = %+v", got.XGTimeline)
+	}
+	for i, want := range wantTimeline {
+		if got.XGTimeline[i] != want {
+			t.Errorf("XGTimeline[%d] = %+v, want %+v", i, got.XGTimeline[i], want)
+		}
+	}
+	if got.HomeXG == nil || *got.HomeXG != 0.96 || *got.AwayXG != 0.15 {
+		t.Errorf("xG from shots = %v - %v, want 0.96 - 0.15", got.HomeXG, got.AwayXG)
+	}
+}
+
+func TestToAPIMatchDetails_ExpectedGoalsStat(t *testing.T) {
+	const body = `{"content": {
+	 "momentum": false,
+	 "shotmap": false,
+	 "stats": {"periods": {"all": {"stats": [{"title": "Top stats", "stats": [
+	  {"key": "expected_goals", "title": "Expected goals (xG)", "stats": ["1.45", "0.67"]}
+	 ]}]}}}
+	}}`
+	var m fotmobMatchDetails
+	if err := json.Unmarshal([]byte(body), &m); err != nil {
+		t.Fatal(err)
+	}
+	got := m.toAPIMatchDetails()
+
+	if got.Momentum != nil || got.Shots != nil || got.XGTimeline != nil {
+		t.Errorf("unavailable momentum/shotmap: got %+v %+v %+v", got.Momentum, got.Shots, got.XGTimeline)
+	}
+	if got.HomeXG == nil || *got.HomeXG != 1.45 || *got.AwayXG != 0.67 {
+		t.Errorf("xG = %v - %v, want 1.45 - 0.67", got.HomeXG, got.AwayXG)
+	}
+}
+
+func TestToAPIMatchDetails_PlayerStats(t *testing.T) {
+	const body = `{"content": {
+	 "lineup": {
+	  "homeTeam": {"id": 8456, "formation": "4-3-3", "starters": [
+	   {"id": 737066, "name": "Erling Haaland", "shirtNumber": "9", "performance": {"rating": 8.1, "events": [{"type": "goal"}, {"type": "yellowCard"}]}}
+	  ]},
+	  "awayTeam": {"id": 9825, "formation": "4-2-3-1", "starters": [
+	   {"id": 961995, "name": "Bukayo Saka", "shirtNumber": "7", "performance": {"rating": 6.9}}
+	  ]}
+	 },
+	 "playerStats": {
+	  "737066": {"id": 737066, "stats": [
+	   {"key": "top_stats", "stats": {
+	    "Minutes played": {"key": "minutes_played", "stat": {"value": 90}},
+	    "Goals": {"key": "goals", "stat": {"value": 1}},
+	    "Total shots": {"key": "total_shots", "stat": {"value": 4}},
+	    "Accurate passes": {"key": "accurate_passes", "stat": {"value": 17, "total": 21}}
+	   }},
+	   {"key": "attack", "stats": {"Shots on target": {"key": "ShotsOnTarget", "stat": {"value": 2}}}},
+	   {"key": "duels", "stats": {
+	    "Duels won": {"key": "duel_won", "stat": {"value": 3}},
+	    "Duels lost": {"key": "duel_lost", "stat": {"value": "5"}}
+	   }}
+	  ]}
+	 }
+	}}`
+	var m fotmobMatchDetails
+	if err := json.Unmarshal([]byte(body), &m); err != nil {
+		t.Fatal(err)
+	}
+	got := m.toAPIMatchDetails()
+
+	if len(got.HomeStarting) != 1 || got.HomeStarting[0].Stats == nil {
+		t.Fatalf("HomeStarting = %+v", got.HomeStarting)
+	}
+	want := api.PlayerMatchStats{
+		Minutes: 90, Goals: 1, Shots: 4, ShotsOnTarget: 2,
+		Passes: 21, PassesCompleted: 17, Duels: 8, DuelsWon: 3, YellowCards: 1,
+	}
+	if s := *got.HomeStarting[0].Stats; s != want {
+		t.Errorf("Haaland stats = %+v, want %+v", s, want)
+	}
+	if len(got.AwayStarting) != 1 || got.AwayStarting[0].Stats != nil {
+		t.Errorf("player without playerStats entry: %+v", got.AwayStarting)
+	}
+}
+
+func TestToAPIMatchDetails_HeadToHead(t *testing.T) {
+	const body = `{
+	 "general": {"matchId": "4813580", "homeTeam": {"id": 8668}, "awayTeam": {"id": 8602}},
+	 "content": {"h2h": {
+	  "summary": [5, 3, 2],
+	  "matches": [
+	   {"matchUrl": "/matches/everton-vs-wolverhampton-wanderers/2o21#4813580", "home": {"id": 8668, "name": "Everton"}, "away": {"id": 8602, "name": "Wolves"},
+	    "league": {"id": 47, "name": "Premier League"}, "status": {"utcTime": "2026-01-07T19:30:00.000Z", "started": true}},
+	   {"matchUrl": "/matches/everton-vs-wolverhampton-wanderers/2o21#4193450", "home": {"id": "8602", "name": "Wolves"}, "away": {"id": "8668", "name": "Everton"},
+	    "league": {"id": 47, "name": "Premier League"}, "status": {"utcTime": "2024-02-17T15:00:00.000Z", "finished": true, "started": true, "scoreStr": "1 - 2"}},
+	   {"matchUrl": "/matches/everton-vs-wolverhampton-wanderers/2o21#4506500", "home": {"id": 8668, "name": "Everton"}, "away": {"id": 8602, "name": "Wolves"},
+	    "league": {"id": 47, "name": "Premier League"}, "status": {"utcTime": "2025-09-20T14:00:00.000Z", "finished": true, "started": true, "scoreStr": "0 - 0"}}
+	  ]
+	 }}
+	}`
+	var m fotmobMatchDetails
+	if err := json.Unmarshal([]byte(body), &m); err != nil {
+		t.Fatal(err)
+	}
+	h := m.toAPIMatchDetails().HeadToHead
+	if h == nil {
+		t.Fatal("HeadToHead = nil")
+	}
+	if h.HomeWins != 5 || h.Draws != 3 || h.AwayWins != 2 {
+		t.Errorf("record = %d-%d-%d, want the summary 5-3-2", h.HomeWins, h.Draws, h.AwayWins)
+	}
+	// The match itself is left out; the rest are most recent first.
+	if len(h.Matches) != 2 || h.Matches[0].ID != 4506500 || h.Matches[1].ID != 4193450 {
+		t.Fatalf("matches = %+v", h.Matches)
+	}
+	if got := h.Matches[1]; got.HomeTeam.ID != 8602 || got.HomeScore == nil || *got.HomeScore != 1 || *got.AwayScore != 2 ||
+		got.PageURL != "/matches/everton-vs-wolverhampton-wanderers/2o21" {
+		t.Errorf("meeting = %+v", got)
+	}
+
+	// Without a summary the listed meetings are tallied.
+	m.Content.H2H = json.RawMessage(strings.Replace(string(m.Content.H2H), `"summary": [5, 3, 2],`, "", 1))
+	if h := m.toAPIMatchDetails().HeadToHead; h.HomeWins != 1 || h.Draws != 1 || h.AwayWins != 0 {
+		t.Errorf("tallied record = %d-%d-%d, want 1-1-0", h.HomeWins, h.Draws, h.AwayWins)
+	}
+	m.Content.H2H = json.RawMessage(`false`)
+	if h := m.toAPIMatchDetails().HeadToHead; h != nil {
+		t.Errorf("unavailable h2h = %+v, want nil", h)
+	}
+}
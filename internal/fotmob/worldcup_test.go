@@ -306,49 +306,36 @@ func TestConvertMatchup_NotYetPlayed(t *testing.T) {
 	}
 }
 
-func TestParseWCTopScorers_Empty(t *testing.T) {
-	got := parseWCTopScorers(wcStatList{})
+func TestParseStatList_Empty(t *testing.T) {
+	got := parseStatList(fotmobStatList{})
 	if got != nil {
 		t.Errorf("expected nil for empty stat list, got %v", got)
 	}
 }
 
-func TestParseWCTopScorers_RoundTrip(t *testing.T) {
-	fixture := wcStatList{
-		TopLists: []struct {
-			StatList []struct {
-				ParticipantName string  `json:"ParticipantName"`
-				TeamName        string  `json:"TeamName"`
-				StatValue       float64 `json:"StatValue"`
-				Rank            int     `json:"Rank"`
-			} `json:"StatList"`
-		}{
-			{
-				StatList: []struct {
-					ParticipantName string  `json:"ParticipantName"`
-					TeamName        string  `json:"TeamName"`
-					StatValue       float64 `json:"StatValue"`
-					Rank            int     `json:"Rank"`
-				}{
-					{ParticipantName: "Lionel Messi", TeamName: "Argentina", StatValue: 6, Rank: 1},
-					{ParticipantName: "Kylian Mbappé", TeamName: "France", StatValue: 4, Rank: 2},
-				},
-			},
-		},
-	}
+func TestParseStatList_RoundTrip(t *testing.T) {
+	fixture := fotmobStatList{TopLists: []fotmobTopList{{StatList: []fotmobStatEntry{
+		{ParticipantName: "Lionel Messi", ParticipantID: 30981, TeamName: "Argentina", TeamID: 6706, StatValue: 6, Rank: 1},
+		{ParticipantName: "Kylian Mbappé", TeamName: "France", StatValue: 4},
+	}}}}
 
-	got := parseWCTopScorers(fixture)
+	got := parseStatList(fixture)
 	if len(got) != 2 {
-		t.Fatalf("len(scorers) = %d, want 2", len(got))
+		t.Fatalf("len(entries) = %d, want 2", len(got))
 	}
-	if got[0].PlayerName != "Lionel Messi" {
-		t.Errorf("scorers[0].PlayerName = %q, want %q", got[0].PlayerName, "Lionel Messi")
+	if got[0].PlayerName != "Lionel Messi" || got[0].PlayerID != 30981 || got[0].TeamID != 6706 {
+		t.Errorf("entries[0] = %+v, want Lionel Messi (30981) of team 6706", got[0])
 	}
-	if got[0].Goals != 6 {
-		t.Errorf("scorers[0].Goals = %d, want 6", got[0].Goals)
+	if got[0].Value != 6 {
+		t.Errorf("entries[0].Value = %v, want 6", got[0].Value)
 	}
-	if got[1].Team != "France" {
-		t.Errorf("scorers[1].Team = %q, want %q", got[1].Team, "France")
+	if got[1].Team != "France" || got[1].Rank != 2 {
+		t.Errorf("entries[1] = %+v, want France ranked 2 (from its position)", got[1])
+	}
+
+	scorers := wcTopScorers(got)
+	if len(scorers) != 2 || scorers[0].Goals != 6 || scorers[1].PlayerName != "Kylian Mbappé" {
+		t.Errorf("wcTopScorers = %+v", scorers)
 	}
 }
 
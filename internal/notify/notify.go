@@ -5,7 +5,9 @@ package notify
 import (
 	"fmt"
 	"os"
+	"os/exec"
 	"path/filepath"
+	"runtime"
 	"sync"
 
 	"github.com/0xjuanma/golazo/internal/api"
@@ -127,3 +129,31 @@ func formatGoalMessage(event api.MatchEvent, homeTeam, awayTeam api.Team, homeSc
 		awayTeam.ShortName,
 	)
 }
+
+// Available reports which backend desktop notifications would use on this
+// system, mirroring the lookups beeep performs, or an error when none is
+// found. It sends nothing. Used by `golazo doctor`.
+func Available() (backend string, err error) {
+	switch runtime.GOOS {
+	case "darwin":
+		for _, bin := range []string{"terminal-notifier", "osascript"} {
+			if _, err := exec.LookPath(bin); err == nil {
+				return bin, nil
+			}
+		}
+		return "", fmt.Errorf("neither terminal-notifier nor osascript found in PATH")
+	case "windows":
+		return "windows toast", nil
+	case "linux", "freebsd", "netbsd", "openbsd", "illumos":
+		if os.Getenv("DBUS_SESSION_BUS_ADDRESS") != "" {
+			return "dbus", nil
+		}
+		for _, bin := range []string{"notify-send", "kdialog"} {
+			if _, err := exec.LookPath(bin); err == nil {
+				return bin, nil
+			}
+		}
+		return "", fmt.Errorf("no D-Bus session bus and neither notify-send nor kdialog found in PATH")
+	}
+	return "", fmt.Errorf("desktop notifications are not supported on %s", runtime.GOOS)
+}
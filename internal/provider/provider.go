@@ -0,0 +1,80 @@
+// Package provider selects the football data source behind api.Client.
+//
+// FotMob is the default. football-data.org is the alternative for when
+// FotMob's pages break: it covers fewer leagues and no World Cup bracket, but
+// is a documented REST API. The provider comes from --provider, else the
+// "provider" key in settings.yaml.
+package provider
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/data"
+	"github.com/0xjuanma/golazo/internal/footballdata"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+)
+
+// Provider names accepted by --provider and settings.yaml.
+const (
+	FotMob       = "fotmob"
+	FootballData = "football-data"
+
+	Default = FotMob
+)
+
+// EnvFootballDataToken overrides the football-data.org token from settings.
+const EnvFootballDataToken = "GOLAZO_FOOTBALL_DATA_TOKEN"
+
+// Names returns the valid provider names.
+func Names() []string {
+	return []string{FotMob, FootballData}
+}
+
+// Resolve picks the provider: flag when set, else settings.yaml, else
+// Default. Unknown names are an error.
+func Resolve(flag string) (string, error) {
+	name := flag
+	if name == "" {
+		if settings, err := data.LoadSettings(); err == nil {
+			name = settings.Provider
+		}
+	}
+	if name == "" {
+		return Default, nil
+	}
+	for _, n := range Names() {
+		if name == n {
+			return name, nil
+		}
+	}
+	return "", fmt.Errorf("unknown provider %q (want one of: %s)", name, strings.Join(Names(), ", "))
+}
+
+// New builds a client for the named provider with logger wired.
+func New(name string, logger *slog.Logger) (api.Client, error) {
+	switch name {
+	case "", FotMob:
+		c := fotmob.NewClient()
+		c.SetLogger(logger)
+		return c, nil
+	case FootballData:
+		settings, _ := data.LoadSettings()
+		token := settings.FootballData.Token
+		if env := os.Getenv(EnvFootballDataToken); env != "" {
+			token = env
+		}
+		c := footballdata.NewClient(settings.FootballData.BaseURL, token)
+		c.SetLogger(logger)
+		return c, nil
+	}
+	return nil, fmt.Errorf("unknown provider %q", name)
+}
+
+var (
+	_ api.Client = (*fotmob.Client)(nil)
+	_ api.Client = (*footballdata.Client)(nil)
+)
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/footballdata"
+	"github.com/0xjuanma/golazo/internal/fotmob"
+)
+
+// useSettings points the config dir at a temp dir holding settingsYAML.
+func useSettings(t *testing.T, settingsYAML string) {
+	t.Helper()
+	tmp := t.TempDir()
+	t.Setenv("HOME", tmp)
+	t.Setenv("XDG_CONFIG_HOME", tmp)
+	t.Setenv("XDG_CACHE_HOME", filepath.Join(tmp, "cache"))
+	dir := filepath.Join(tmp, "golazo")
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "settings.yaml"), []byte(settingsYAML), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestResolve(t *testing.T) {
+	useSettings(t, "provider: football-data\n")
+
+	for _, tc := range []struct {
+		flag, want string
+	}{
+		{"", FootballData},
+		{"fotmob", FotMob},
+	} {
+		if got, err := Resolve(tc.flag); err != nil || got != tc.want {
+			t.Errorf("Resolve(%q) = %q, %v; want %q", tc.flag, got, err, tc.want)
+		}
+	}
+	if _, err := Resolve("espn"); err == nil {
+		t.Error("unknown provider: want an error")
+	}
+
+	useSettings(t, "selected_leagues: [47]\n")
+	if got, err := Resolve(""); err != nil || got != Default {
+		t.Errorf("no provider in settings: got %q, %v; want %q", got, err, Default)
+	}
+}
+
+func TestNew(t *testing.T) {
+	useSettings(t, "football_data:\n  base_url: http://127.0.0.1:1/v4\n")
+
+	c, err := New(FootballData, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := c.(*footballdata.Client); !ok {
+		t.Errorf("New(%q) = %T", FootballData, c)
+	}
+	if c, err := New(FotMob, nil); err != nil {
+		t.Fatal(err)
+	} else if _, ok := c.(*fotmob.Client); !ok {
+		t.Errorf("New(%q) = %T", FotMob, c)
+	}
+	if _, err := New("espn", nil); err == nil {
+		t.Error("unknown provider: want an error")
+	}
+}
@@ -1,41 +1,251 @@
+// Package ratelimit provides context-aware token-bucket rate limiting for
+// outbound API requests.
+//
+// A Limiter holds one bucket per key, so a single limiter can pace several
+// kinds of traffic independently (e.g. FotMob JSON API calls and page HTML
+// fetches). Keys without an explicit limit share the limiter's default
+// limit, each in its own bucket.
 package ratelimit
 
 import (
+	"context"
+	"sort"
 	"sync"
 	"time"
 )
 
-// Limiter provides interval-based rate limiting for API requests.
+// Limit describes a token bucket: it holds up to Burst tokens and refills one
+// token every Interval. Each request takes one token. A zero Interval means
+// unlimited.
+type Limit struct {
+	Interval time.Duration
+	Burst    int
+}
+
+// Every returns a limit of one request per interval with no burst.
+func Every(interval time.Duration) Limit {
+	return Limit{Interval: interval, Burst: 1}
+}
+
+func (l Limit) normalize() Limit {
+	if l.Interval < 0 {
+		l.Interval = 0
+	}
+	if l.Burst < 1 {
+		l.Burst = 1
+	}
+	return l
+}
+
+// Clock abstracts time so tests can drive a Limiter without sleeping.
+type Clock interface {
+	Now() time.Time
+	// After returns a channel that receives once d has elapsed.
+	After(d time.Duration) <-chan time.Time
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time                         { return time.Now() }
+func (realClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// Stats is a snapshot of one key's bucket.
+type Stats struct {
+	Key   string
+	Limit Limit
+	// Tokens currently available. Negative while waiters hold reservations.
+	Tokens float64
+	// Requests is the number of Wait calls that were granted a token.
+	Requests int64
+	// Delayed counts granted requests that had to wait for a token.
+	Delayed int64
+	// Cancelled counts Wait calls abandoned because their context ended.
+	Cancelled int64
+	// TotalWait is the time granted requests spent waiting.
+	TotalWait time.Duration
+}
+
+type bucket struct {
+	limit  Limit
+	tokens float64
+	last   time.Time
+	stats  Stats
+}
+
+// refill adds the tokens earned since the last update, up to the burst.
+func (b *bucket) refill(now time.Time) {
+	if b.limit.Interval == 0 {
+		b.tokens = float64(b.limit.Burst)
+		b.last = now
+		return
+	}
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens += float64(elapsed) / float64(b.limit.Interval)
+		b.last = now
+	}
+	if burst := float64(b.limit.Burst); b.tokens > burst {
+		b.tokens = burst
+	}
+}
+
+// Limiter rate-limits requests per key with token buckets. Waiting never
+// holds the limiter's lock, so a cancelled caller returns immediately instead
+// of queueing behind other waiters. Safe for concurrent use.
 type Limiter struct {
-	mu              sync.Mutex
-	lastRequestTime time.Time
-	minInterval     time.Duration
+	mu      sync.Mutex
+	clock   Clock
+	def     Limit
+	limits  map[string]Limit
+	buckets map[string]*bucket
 }
 
-// New creates a rate limiter with the given minimum interval between requests.
+// New creates a limiter that allows one request per minInterval for every
+// key, without burst. A zero or negative interval disables limiting.
 func New(minInterval time.Duration) *Limiter {
-	if minInterval < 0 {
-		minInterval = 0
+	return NewWithLimit(Every(minInterval))
+}
+
+// NewFromRate creates a limiter from a requests-per-minute value.
+func NewFromRate(requestsPerMinute int) *Limiter {
+	if requestsPerMinute <= 0 {
+		return New(0)
 	}
+	return New(time.Minute / time.Duration(requestsPerMinute))
+}
+
+// NewWithLimit creates a limiter whose keys default to def.
+func NewWithLimit(def Limit) *Limiter {
 	return &Limiter{
-		minInterval: minInterval,
+		clock:   realClock{},
+		def:     def.normalize(),
+		limits:  make(map[string]Limit),
+		buckets: make(map[string]*bucket),
 	}
 }
 
-// NewFromRate creates a rate limiter from a requests-per-minute value.
-func NewFromRate(requestsPerMinute int) *Limiter {
-	interval := time.Minute / time.Duration(requestsPerMinute)
-	return New(interval)
+// SetLimit sets the limit for key. An existing bucket keeps its tokens,
+// capped at the new burst.
+func (l *Limiter) SetLimit(key string, limit Limit) {
+	limit = limit.normalize()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.limits[key] = limit
+	if b, ok := l.buckets[key]; ok {
+		b.refill(l.clock.Now())
+		b.limit = limit
+		b.stats.Limit = limit
+		b.refill(l.clock.Now())
+	}
+}
+
+// SetClock replaces the time source. Call it before the first Wait.
+func (l *Limiter) SetClock(c Clock) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.clock = c
+}
+
+// Wait blocks until the default key has a token or ctx ends.
+func (l *Limiter) Wait(ctx context.Context) error {
+	return l.WaitKey(ctx, "")
+}
+
+// WaitKey blocks until key has a token or ctx ends, in which case it returns
+// ctx.Err() and gives the reserved token back.
+func (l *Limiter) WaitKey(ctx context.Context, key string) error {
+	if err := ctx.Err(); err != nil {
+		l.mu.Lock()
+		l.bucket(key).stats.Cancelled++
+		l.mu.Unlock()
+		return err
+	}
+
+	l.mu.Lock()
+	b := l.bucket(key)
+	now := l.clock.Now()
+	b.refill(now)
+	b.tokens--
+	var wait time.Duration
+	if b.tokens < 0 {
+		wait = time.Duration(-b.tokens * float64(b.limit.Interval))
+	}
+	clock := l.clock
+	if wait == 0 {
+		b.stats.Requests++
+		l.mu.Unlock()
+		return nil
+	}
+	l.mu.Unlock()
+
+	select {
+	case <-clock.After(wait):
+		l.mu.Lock()
+		b.stats.Requests++
+		b.stats.Delayed++
+		b.stats.TotalWait += wait
+		l.mu.Unlock()
+		return nil
+	case <-ctx.Done():
+		l.mu.Lock()
+		b.refill(l.clock.Now())
+		b.tokens++
+		b.stats.Cancelled++
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// Finish marks the end of a request on the default key. See FinishKey.
+func (l *Limiter) Finish() {
+	l.FinishKey("")
+}
+
+// FinishKey restarts key's refill from now and drops the tokens earned while
+// the request was in flight, so the next token arrives a full interval after
+// the request ended rather than after it started. With Burst 1 this keeps a
+// fixed gap between slow requests. Tokens reserved by waiters are kept.
+func (l *Limiter) FinishKey(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	b := l.bucket(key)
+	b.refill(l.clock.Now())
+	b.last = l.clock.Now()
+	if b.tokens > 0 {
+		b.tokens = 0
+	}
 }
 
-// Wait blocks until the minimum interval has elapsed since the last request.
-func (l *Limiter) Wait() {
+// Stats returns a snapshot of every bucket used so far, sorted by key.
+func (l *Limiter) Stats() []Stats {
 	l.mu.Lock()
 	defer l.mu.Unlock()
+	now := l.clock.Now()
+	out := make([]Stats, 0, len(l.buckets))
+	for _, b := range l.buckets {
+		b.refill(now)
+		s := b.stats
+		s.Tokens = b.tokens
+		out = append(out, s)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Key < out[j].Key })
+	return out
+}
 
-	elapsed := time.Since(l.lastRequestTime)
-	if elapsed < l.minInterval {
-		time.Sleep(l.minInterval - elapsed)
+// bucket returns key's bucket, creating it full. Callers hold mu.
+func (l *Limiter) bucket(key string) *bucket {
+	if b, ok := l.buckets[key]; ok {
+		return b
+	}
+	limit, ok := l.limits[key]
+	if !ok {
+		limit = l.def
+	}
+	b := &bucket{
+		limit:  limit,
+		tokens: float64(limit.Burst),
+		last:   l.clock.Now(),
+		stats:  Stats{Key: key, Limit: limit},
 	}
-	l.lastRequestTime = time.Now()
+	l.buckets[key] = b
+	return b
 }
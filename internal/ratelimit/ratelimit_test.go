@@ -1,64 +1,293 @@
 package ratelimit
 
 import (
+	"context"
+	"errors"
+	"sync"
 	"testing"
 	"time"
 )
 
-func TestNew(t *testing.T) {
-	tests := []struct {
-		name        string
-		interval    time.Duration
-		wantNonNil  bool
-	}{
-		{"positive interval", 100 * time.Millisecond, true},
-		{"zero interval", 0, true},
-		{"negative interval clamped to zero", -1 * time.Second, true},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			rl := New(tt.interval)
-			if rl == nil {
-				t.Fatal("New returned nil")
-			}
-		})
+// fakeClock is a manually advanced Clock. After channels fire once Advance
+// moves the clock past their deadline.
+type fakeClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []fakeWaiter
+}
+
+type fakeWaiter struct {
+	at time.Time
+	ch chan time.Time
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2026, 1, 7, 12, 0, 0, 0, time.UTC)}
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+	c.waiters = append(c.waiters, fakeWaiter{at: c.now.Add(d), ch: ch})
+	return ch
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	pending := c.waiters[:0]
+	for _, w := range c.waiters {
+		if !w.at.After(c.now) {
+			w.ch <- c.now
+			continue
+		}
+		pending = append(pending, w)
+	}
+	c.waiters = pending
+}
+
+// Pending returns how many After channels have not fired yet.
+func (c *fakeClock) Pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.waiters)
+}
+
+func waitForPending(t *testing.T, c *fakeClock, n int) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for c.Pending() != n {
+		if time.Now().After(deadline) {
+			t.Fatalf("pending waiters = %d, want %d", c.Pending(), n)
+		}
+		time.Sleep(time.Millisecond)
 	}
 }
 
+func newTestLimiter(def Limit) (*Limiter, *fakeClock) {
+	clock := newFakeClock()
+	l := NewWithLimit(def)
+	l.SetClock(clock)
+	return l, clock
+}
+
 func TestNewFromRate(t *testing.T) {
-	rl := NewFromRate(60) // 60 req/min = 1 per second
-	if rl == nil {
-		t.Fatal("NewFromRate returned nil")
+	if got := NewFromRate(60).def; got != Every(time.Second) {
+		t.Errorf("60/min limit = %+v, want one per second", got)
+	}
+	if got := NewFromRate(0).def.Interval; got != 0 {
+		t.Errorf("0/min interval = %v, want unlimited", got)
+	}
+	if got := New(-time.Second).def.Interval; got != 0 {
+		t.Errorf("negative interval = %v, want clamped to zero", got)
+	}
+}
+
+func TestWait_ZeroIntervalNeverBlocks(t *testing.T) {
+	l, clock := newTestLimiter(Every(0))
+	for i := 0; i < 100; i++ {
+		if err := l.Wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if clock.Pending() != 0 {
+		t.Error("unlimited limiter should never wait")
+	}
+}
+
+func TestWait_BurstThenRefill(t *testing.T) {
+	l, clock := newTestLimiter(Limit{Interval: 100 * time.Millisecond, Burst: 3})
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if clock.Pending() != 0 {
+		t.Fatal("burst requests should not wait")
+	}
+
+	done := make(chan error, 1)
+	go func() { done <- l.Wait(ctx) }()
+	waitForPending(t, clock, 1)
+	select {
+	case <-done:
+		t.Fatal("fourth request should wait for a refill")
+	default:
+	}
+	clock.Advance(100 * time.Millisecond)
+	if err := <-done; err != nil {
+		t.Fatal(err)
 	}
-	if rl.minInterval != time.Second {
-		t.Errorf("expected interval %v, got %v", time.Second, rl.minInterval)
+
+	// Idle time refills the bucket, but never past the burst.
+	clock.Advance(time.Hour)
+	for i := 0; i < 3; i++ {
+		if err := l.Wait(ctx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	s := l.Stats()[0]
+	if s.Requests != 7 || s.Delayed != 1 || s.TotalWait != 100*time.Millisecond {
+		t.Errorf("stats = %+v, want 7 requests, 1 delayed by 100ms", s)
+	}
+	if s.Tokens != 0 {
+		t.Errorf("tokens = %v, want the refilled burst used up", s.Tokens)
 	}
 }
 
-func TestWait_enforcesMinInterval(t *testing.T) {
-	rl := New(50 * time.Millisecond)
+func TestWait_WaitersQueueInOrder(t *testing.T) {
+	l, clock := newTestLimiter(Every(time.Second))
+	ctx := context.Background()
+	if err := l.Wait(ctx); err != nil {
+		t.Fatal(err)
+	}
 
-	start := time.Now()
-	rl.Wait() // first call, no wait
-	rl.Wait() // second call, should wait ~50ms
-	elapsed := time.Since(start)
+	done := make(chan int, 2)
+	for i := 1; i <= 2; i++ {
+		go func() {
+			if err := l.Wait(ctx); err == nil {
+				done <- i
+			}
+		}()
+		waitForPending(t, clock, i)
+	}
+	clock.Advance(time.Second)
+	if got := <-done; got != 1 {
+		t.Errorf("first released waiter = %d, want 1", got)
+	}
+	select {
+	case <-done:
+		t.Fatal("second waiter released one interval early")
+	default:
+	}
+	clock.Advance(time.Second)
+	if got := <-done; got != 2 {
+		t.Errorf("second released waiter = %d, want 2", got)
+	}
+}
+
+func TestWait_CancelledWaiterReturnsAndGivesTokenBack(t *testing.T) {
+	l, clock := newTestLimiter(Every(time.Second))
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	// A waiter blocked on a long reservation must not hold up a cancelled one.
+	slow := make(chan error, 1)
+	go func() { slow <- l.Wait(context.Background()) }()
+	waitForPending(t, clock, 1)
 
-	if elapsed < 40*time.Millisecond {
-		t.Errorf("expected at least ~50ms between calls, got %v", elapsed)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancelled := make(chan error, 1)
+	go func() { cancelled <- l.Wait(ctx) }()
+	waitForPending(t, clock, 2)
+	cancel()
+	select {
+	case err := <-cancelled:
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("err = %v, want context.Canceled", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("cancelled Wait did not return")
+	}
+
+	clock.Advance(time.Second)
+	if err := <-slow; err != nil {
+		t.Fatal(err)
+	}
+	// The cancelled reservation was returned, so the next token is one
+	// interval away rather than two.
+	clock.Advance(time.Second)
+	next := make(chan error, 1)
+	go func() { next <- l.Wait(context.Background()) }()
+	select {
+	case err := <-next:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("returned token was not reusable")
+	}
+
+	if err := l.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("already-cancelled ctx: err = %v", err)
+	}
+	if s := l.Stats()[0]; s.Cancelled != 2 || s.Requests != 3 {
+		t.Errorf("stats = %+v, want 3 requests and 2 cancelled", s)
+	}
+}
+
+func TestWaitKey_IndependentBuckets(t *testing.T) {
+	l, clock := newTestLimiter(Every(time.Second))
+	l.SetLimit("page", Limit{Interval: time.Second, Burst: 2})
+	ctx := context.Background()
+
+	for _, key := range []string{"api", "page", "page", "reddit"} {
+		if err := l.WaitKey(ctx, key); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if clock.Pending() != 0 {
+		t.Fatal("each key should start with its own full bucket")
+	}
+
+	stats := l.Stats()
+	if len(stats) != 3 || stats[0].Key != "api" || stats[1].Key != "page" || stats[2].Key != "reddit" {
+		t.Fatalf("stats keys = %+v, want api, page, reddit", stats)
+	}
+	if stats[1].Limit.Burst != 2 || stats[1].Requests != 2 || stats[0].Limit != Every(time.Second) {
+		t.Errorf("stats = %+v", stats)
 	}
 }
 
-func TestWait_zeroInterval_noDelay(t *testing.T) {
-	rl := New(0)
+func TestFinish_GapFromRequestEnd(t *testing.T) {
+	l, clock := newTestLimiter(Every(30 * time.Second))
+	ctx := context.Background()
+
+	if err := l.Wait(ctx); err != nil {
+		t.Fatal(err)
+	}
+	clock.Advance(20 * time.Second) // a slow request
+	l.Finish()
 
-	start := time.Now()
-	rl.Wait()
-	rl.Wait()
-	rl.Wait()
-	elapsed := time.Since(start)
+	done := make(chan error, 1)
+	go func() { done <- l.Wait(ctx) }()
+	waitForPending(t, clock, 1)
+	clock.Advance(29 * time.Second)
+	select {
+	case <-done:
+		t.Fatal("next request started before a full interval after the last one ended")
+	default:
+	}
+	clock.Advance(time.Second)
+	if err := <-done; err != nil {
+		t.Fatal(err)
+	}
 
-	if elapsed > 10*time.Millisecond {
-		t.Errorf("zero interval should not delay, got %v", elapsed)
+	// After an idle interval the bucket is full again and nothing waits.
+	l.Finish()
+	clock.Advance(30 * time.Second)
+	if err := l.Wait(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if clock.Pending() != 0 {
+		t.Error("request after an idle interval should not wait")
+	}
+	if s := l.Stats()[0]; s.Requests != 3 || s.Delayed != 1 || s.TotalWait != 30*time.Second {
+		t.Errorf("stats = %+v, want 3 requests, 1 delayed by 30s", s)
 	}
 }
@@ -12,7 +12,8 @@ import (
 )
 
 const (
-	goalLinksFileName = "goal_links.json"
+	// GoalLinksFileName is the name of the goal link cache file in the config dir.
+	GoalLinksFileName = "goal_links.json"
 	// CacheTTL defines how long goal links are stored.
 	// 7 days keeps the cache file small while covering recent matches.
 	CacheTTL = 7 * 24 * time.Hour // 7 days
@@ -35,6 +36,20 @@ type GoalLinkCache struct {
 
 // NewGoalLinkCache creates a new cache, loading existing data from disk.
 func NewGoalLinkCache() (*GoalLinkCache, error) {
+	cache, err := OpenGoalLinkCache()
+	if err != nil {
+		return nil, err
+	}
+
+	// Clean expired entries on startup to keep file size manageable
+	_ = cache.CleanExpired()
+
+	return cache, nil
+}
+
+// OpenGoalLinkCache loads the cache like NewGoalLinkCache but leaves expired
+// entries in place, so Expired can count them. Used by `golazo cache`.
+func OpenGoalLinkCache() (*GoalLinkCache, error) {
 	dir, err := data.ConfigDir()
 	if err != nil {
 		return nil, fmt.Errorf("get config dir: %w", err)
@@ -42,15 +57,12 @@ func NewGoalLinkCache() (*GoalLinkCache, error) {
 
 	cache := &GoalLinkCache{
 		links:    make(map[string]GoalLink),
-		filePath: filepath.Join(dir, goalLinksFileName),
+		filePath: filepath.Join(dir, GoalLinksFileName),
 	}
 
 	// Load existing cache from disk (silently ignore errors - start with empty cache)
 	_ = cache.load()
 
-	// Clean expired entries on startup to keep file size manageable
-	_ = cache.CleanExpired()
-
 	return cache, nil
 }
 
@@ -147,19 +159,9 @@ func (c *GoalLinkCache) CleanExpired() error {
 
 	cleaned := false
 	for key, link := range c.links {
-		age := time.Since(link.FetchedAt)
-
-		// Use shorter TTL for "not found" entries
-		if link.URL == NotFoundMarker {
-			if age > NotFoundTTL {
-				delete(c.links, key)
-				cleaned = true
-			}
-		} else {
-			if age > CacheTTL {
-				delete(c.links, key)
-				cleaned = true
-			}
+		if isExpired(link) {
+			delete(c.links, key)
+			cleaned = true
 		}
 	}
 
@@ -170,6 +172,15 @@ func (c *GoalLinkCache) CleanExpired() error {
 	return nil
 }
 
+// isExpired reports whether link has outlived its TTL: NotFoundTTL for
+// "not found" markers, CacheTTL otherwise.
+func isExpired(link GoalLink) bool {
+	if link.URL == NotFoundMarker {
+		return time.Since(link.FetchedAt) > NotFoundTTL
+	}
+	return time.Since(link.FetchedAt) > CacheTTL
+}
+
 // load reads the cache from disk.
 func (c *GoalLinkCache) load() error {
 	data, err := os.ReadFile(c.filePath)
@@ -220,3 +231,17 @@ func (c *GoalLinkCache) Size() int {
 	defer c.mu.RUnlock()
 	return len(c.links)
 }
+
+// Expired returns the number of cached entries past their TTL.
+func (c *GoalLinkCache) Expired() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	n := 0
+	for _, link := range c.links {
+		if isExpired(link) {
+			n++
+		}
+	}
+	return n
+}
@@ -1,6 +1,7 @@
 package reddit
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,6 +13,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/0xjuanma/golazo/internal/cassette"
+	"github.com/0xjuanma/golazo/internal/fold"
 	"github.com/0xjuanma/golazo/internal/ratelimit"
 )
 
@@ -48,21 +51,29 @@ func NewPublicJSONFetcher() *PublicJSONFetcher {
 	return &PublicJSONFetcher{
 		httpClient: &http.Client{
 			Timeout: 10 * time.Second,
-			Transport: &http.Transport{
+			Transport: cassette.Wrap(&http.Transport{
 				MaxIdleConns:        10,
 				MaxIdleConnsPerHost: 10,
 				IdleConnTimeout:     90 * time.Second,
-			},
+			}),
 		},
 		rateLimiter: ratelimit.NewFromRate(10), // 10 requests per minute for public API
 	}
 }
 
+// SetTransport replaces the HTTP transport, e.g. with a cassette.Transport
+// for record/replay.
+func (f *PublicJSONFetcher) SetTransport(rt http.RoundTripper) {
+	f.httpClient.Transport = rt
+}
+
 // Search performs a search on r/soccer for Media posts matching the query.
 // matchTime is used to filter results to posts created around the match date.
 // sort controls the result ordering (e.g., "relevance", "top", "new", "hot").
 func (f *PublicJSONFetcher) Search(query string, limit int, matchTime time.Time, sort string) ([]SearchResult, error) {
-	f.rateLimiter.Wait()
+	if err := f.rateLimiter.Wait(context.Background()); err != nil {
+		return nil, err
+	}
 
 	// Build timestamp range for filtering. Aligned with the matcher's
 	// accepted date window (matcher.go: -24h .. +48h) so search results are
@@ -133,6 +144,39 @@ func (f *PublicJSONFetcher) Search(query string, limit int, matchTime time.Time,
 	return results, nil
 }
 
+// Ping sends a one-result search to the r/soccer search endpoint the fetcher
+// uses and returns the HTTP status code. A 403 wraps ErrBlocked, the
+// response that sends the goal-link queue into its CooldownPeriod. Used by
+// `golazo doctor`.
+func (f *PublicJSONFetcher) Ping(ctx context.Context) (int, error) {
+	if err := f.rateLimiter.Wait(ctx); err != nil {
+		return 0, err
+	}
+
+	searchURL := "https://old.reddit.com/r/soccer/search.json?q=goal+flair:Media&restrict_sr=on&sort=new&limit=1"
+	req, err := http.NewRequestWithContext(ctx, "GET", searchURL, nil)
+	if err != nil {
+		return 0, fmt.Errorf("create request: %w", err)
+	}
+	req.Header.Set("User-Agent", browserUserAgent)
+	req.Header.Set("Accept", "application/json, text/plain, */*")
+	req.Header.Set("Accept-Language", "en-US,en;q=0.9")
+
+	resp, err := f.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("fetch from reddit: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	switch {
+	case resp.StatusCode == http.StatusForbidden:
+		return resp.StatusCode, ErrBlocked
+	case resp.StatusCode != http.StatusOK:
+		return resp.StatusCode, fmt.Errorf("reddit API error: status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
 // Client provides goal replay link fetching from Reddit r/soccer.
 // Uses Reddit's public JSON API for goal link retrieval.
 type Client struct {
@@ -397,7 +441,7 @@ func scorerLastToken(name string) string {
 	if name == "" {
 		return ""
 	}
-	folded := foldDiacritics(name)
+	folded := fold.Diacritics(name)
 	fields := strings.Fields(folded)
 	if len(fields) == 0 {
 		return ""
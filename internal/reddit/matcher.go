@@ -6,11 +6,8 @@ import (
 	"strings"
 	"sync"
 	"time"
-	"unicode"
 
-	"golang.org/x/text/runes"
-	"golang.org/x/text/transform"
-	"golang.org/x/text/unicode/norm"
+	"github.com/0xjuanma/golazo/internal/fold"
 )
 
 var (
@@ -18,28 +15,8 @@ var (
 	reNonAlphaSpace    = regexp.MustCompile(`[^a-z\s]`)
 	teamNameCache      sync.Map // map[string]string
 	playerNameCache    sync.Map // map[string]string
-
-	// diacriticFolder folds Unicode diacritics so "Vinícius" → "Vinicius",
-	// "Müller" → "Muller", "Türkiye" → "Turkiye" (preserving the base letter)
-	// instead of stripping the entire grapheme. Applied before the lowercase
-	// regex strip so player/team-name matching stays loose across sources that
-	// use anglicized spellings.
-	diacriticFolder = transform.Chain(
-		norm.NFD,
-		runes.Remove(runes.In(unicode.Mn)),
-		norm.NFC,
-	)
 )
 
-// foldDiacritics returns s with combining diacritical marks removed.
-func foldDiacritics(s string) string {
-	out, _, err := transform.String(diacriticFolder, s)
-	if err != nil {
-		return s
-	}
-	return out
-}
-
 // countryAliases maps the normalized form of a national-team name (as produced
 // by normalizeTeamName) to additional normalized variants that may appear in
 // Reddit goal-post titles. Lookup is exact-key on the goal's normalized team
@@ -182,7 +159,7 @@ func normalizeTeamName(name string) string {
 func normalizeTeamNameUncached(name string) string {
 	// Fold diacritics first ("Türkiye" → "Turkiye") so the lowercase strip
 	// below preserves the anglicized base letters instead of dropping them.
-	norm := foldDiacritics(name)
+	norm := fold.Diacritics(name)
 	norm = strings.ToLower(norm)
 
 	// Remove common prefixes (e.g., "fc barcelona" -> "barcelona")
@@ -217,7 +194,7 @@ func normalizeName(name string) string {
 func normalizeNameUncached(name string) string {
 	// Fold diacritics so "Müller" → "muller", "Vinícius" → "vinicius" — the
 	// anglicized base letters survive the lowercase strip below.
-	norm := foldDiacritics(name)
+	norm := fold.Diacritics(name)
 	norm = strings.ToLower(norm)
 	// Remove remaining special characters but keep spaces.
 	norm = reNonAlphaSpace.ReplaceAllString(norm, "")
@@ -1,10 +1,13 @@
 package reddit
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sync"
 	"time"
+
+	"github.com/0xjuanma/golazo/internal/ratelimit"
 )
 
 // Defaults for the goal-link queue. Exposed as variables (not constants) so
@@ -51,7 +54,7 @@ type queuedWork struct {
 // leak a goroutine.
 type goalQueue struct {
 	keys     chan GoalLinkKey
-	interval time.Duration
+	limiter  *ratelimit.Limiter // one fetch per interval, measured from the end of the last
 	cooldown time.Duration
 
 	mu            sync.Mutex
@@ -76,7 +79,7 @@ func newGoalQueue(fetch fetchFunc, cache *GoalLinkCache, log DebugLogger, interv
 	}
 	return &goalQueue{
 		keys:     make(chan GoalLinkKey, 1024),
-		interval: interval,
+		limiter:  ratelimit.New(interval),
 		cooldown: cooldown,
 		items:    make(map[GoalLinkKey]*queuedWork),
 		fetch:    fetch,
@@ -116,8 +119,6 @@ func (q *goalQueue) start() {
 }
 
 func (q *goalQueue) run() {
-	var lastFetch time.Time
-
 	for key := range q.keys {
 		q.mu.Lock()
 		item := q.items[key]
@@ -139,17 +140,15 @@ func (q *goalQueue) run() {
 			continue
 		}
 
-		// Pacing: wait out the interval since the last fetch attempt. The
-		// gate applies to every fetch, not only the first, so the queue's
-		// rate-limit guarantee holds even after a cache-hit-skipped item.
-		if !lastFetch.IsZero() {
-			if wait := q.interval - time.Since(lastFetch); wait > 0 {
-				time.Sleep(wait)
-			}
-		}
+		// Pacing: wait out the interval since the last fetch attempt ended.
+		// The gate applies to every fetch, not only the first, so the
+		// queue's rate-limit guarantee holds even after a cache-hit-skipped
+		// item. The worker has no caller context to honour, so Wait cannot
+		// fail.
+		_ = q.limiter.Wait(context.Background())
 
 		link, err := q.fetch(item.goal)
-		lastFetch = time.Now()
+		q.limiter.Finish()
 
 		if err != nil && errors.Is(err, ErrBlocked) {
 			q.mu.Lock()
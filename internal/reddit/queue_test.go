@@ -93,6 +93,99 @@ func TestQueueIntervalPacing(t *testing.T) {
 	}
 }
 
+// manualClock is a ratelimit.Clock the test advances by hand.
+type manualClock struct {
+	mu      sync.Mutex
+	now     time.Time
+	waiters []manualWaiter
+}
+
+type manualWaiter struct {
+	at time.Time
+	ch chan time.Time
+}
+
+func (c *manualClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *manualClock) After(d time.Duration) <-chan time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ch := make(chan time.Time, 1)
+	if d <= 0 {
+		ch <- c.now
+		return ch
+	}
+	c.waiters = append(c.waiters, manualWaiter{at: c.now.Add(d), ch: ch})
+	return ch
+}
+
+func (c *manualClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	pending := c.waiters[:0]
+	for _, w := range c.waiters {
+		if !w.at.After(c.now) {
+			w.ch <- c.now
+			continue
+		}
+		pending = append(pending, w)
+	}
+	c.waiters = pending
+}
+
+func (c *manualClock) pending() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.waiters)
+}
+
+// TestQueueIntervalFromFetchEnd drives the worker with an injected clock: a
+// slow fetch must not eat into the interval, which runs from the end of one
+// fetch to the start of the next.
+func TestQueueIntervalFromFetchEnd(t *testing.T) {
+	clock := &manualClock{now: time.Date(2026, 1, 7, 12, 0, 0, 0, time.UTC)}
+	var calls atomic.Int32
+	fetch := func(GoalInfo) (*GoalLink, error) {
+		calls.Add(1)
+		clock.Advance(20 * time.Second) // a slow Reddit search
+		return nil, nil
+	}
+	q := newGoalQueue(fetch, newTestCache(t), nil, QueueInterval, time.Minute)
+	q.limiter.SetClock(clock)
+
+	replies := make(chan GoalResult, 2)
+	q.Enqueue(GoalInfo{MatchID: 1, Minute: 1}, replies)
+	q.Enqueue(GoalInfo{MatchID: 1, Minute: 2}, replies)
+	<-replies
+
+	deadline := time.Now().Add(time.Second)
+	for clock.pending() != 1 {
+		if time.Now().After(deadline) {
+			t.Fatal("second fetch did not wait for the limiter")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	clock.Advance(QueueInterval - time.Second)
+	time.Sleep(10 * time.Millisecond)
+	if n := calls.Load(); n != 1 {
+		t.Fatalf("fetches = %d before a full interval after the first ended, want 1", n)
+	}
+	clock.Advance(time.Second)
+	select {
+	case <-replies:
+	case <-time.After(time.Second):
+		t.Fatal("second fetch did not run after the interval")
+	}
+	if n := calls.Load(); n != 2 {
+		t.Errorf("fetches = %d, want 2", n)
+	}
+}
+
 // TestQueueCooldownOnBlocked verifies that an ErrBlocked response sets the
 // cooldown window such that subsequent goals are dropped (no fetch attempt)
 // while inside the window.
@@ -2,6 +2,7 @@
 package ui
 
 import (
+	"github.com/0xjuanma/golazo/internal/api"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -18,6 +19,9 @@ const (
 	FormationsDialogID = "formations"
 	StatisticsDialogID = "statistics"
 	TopScorersDialogID = "top_scorers"
+	TeamDialogID       = "team"
+	PlayerDialogID     = "player"
+	HeadToHeadDialogID = "head_to_head"
 )
 
 // DialogAction represents an action returned by a dialog after handling a message.
@@ -26,6 +30,23 @@ type DialogAction any
 // DialogActionClose signals that the dialog should be closed.
 type DialogActionClose struct{}
 
+// DialogActionOpen signals that Dialog should be opened on top of the
+// current one, which stays open underneath.
+type DialogActionOpen struct {
+	Dialog Dialog
+}
+
+// DialogActionNext signals that the dialog should be replaced by one for the
+// next item it is browsing, e.g. the next upcoming match.
+type DialogActionNext struct{}
+
+// DialogActionSwitchStat asks for League's Stat list (see api.TopStats) to
+// replace the dialog's.
+type DialogActionSwitchStat struct {
+	League api.League
+	Stat   string
+}
+
 // Dialog is a component that can be displayed as an overlay on top of the UI.
 type Dialog interface {
 	// ID returns the unique identifier of the dialog.
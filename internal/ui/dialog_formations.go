@@ -19,6 +19,7 @@ type FormationsDialog struct {
 	homeStarting  []api.PlayerInfo
 	awayStarting  []api.PlayerInfo
 	focusedTeam   int // 0 = home, 1 = away
+	selected      int // Index of the selected player in the focused team
 }
 
 // NewFormationsDialog creates a new formations dialog.
@@ -51,13 +52,38 @@ func (d *FormationsDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
 		case "esc", "f", "q":
 			return d, DialogActionClose{}
 		case "tab", "h", "l", "left", "right":
-			// Toggle between home and away
+			// Toggle between home and away, keeping the row where possible
 			d.focusedTeam = 1 - d.focusedTeam
+			d.selected = min(d.selected, max(len(d.focusedPlayers())-1, 0))
+		case "j", "down":
+			d.selected = scrollDown(d.selected, len(d.focusedPlayers())-1)
+		case "k", "up":
+			d.selected = scrollUp(d.selected)
+		case "enter":
+			players := d.focusedPlayers()
+			if d.selected < len(players) {
+				return d, DialogActionOpen{Dialog: NewPlayerDialog(players[d.selected], d.focusedTeamName())}
+			}
 		}
 	}
 	return d, nil
 }
 
+// focusedPlayers returns the focused team's starting lineup.
+func (d *FormationsDialog) focusedPlayers() []api.PlayerInfo {
+	if d.focusedTeam == 1 {
+		return d.awayStarting
+	}
+	return d.homeStarting
+}
+
+func (d *FormationsDialog) focusedTeamName() string {
+	if d.focusedTeam == 1 {
+		return d.awayTeam
+	}
+	return d.homeTeam
+}
+
 // View renders the formations view.
 func (d *FormationsDialog) View(width, height int) string {
 	// Larger dimensions for better readability
@@ -117,8 +143,8 @@ func (d *FormationsDialog) renderTeamPanel(teamName, formation string, players [
 		noData := dialogDimStyle.Width(width).Align(lipgloss.Center).Render("Lineup not available")
 		lines = append(lines, noData)
 	} else {
-		for _, player := range players {
-			playerLine := d.renderPlayerLine(player, width, focused)
+		for i, player := range players {
+			playerLine := d.renderPlayerLine(player, width, focused, focused && i == d.selected)
 			lines = append(lines, playerLine)
 		}
 	}
@@ -127,7 +153,8 @@ func (d *FormationsDialog) renderTeamPanel(teamName, formation string, players [
 }
 
 // renderPlayerLine renders a single player line with number, position, and rating.
-func (d *FormationsDialog) renderPlayerLine(player api.PlayerInfo, width int, focused bool) string {
+// The selected player's name is highlighted.
+func (d *FormationsDialog) renderPlayerLine(player api.PlayerInfo, width int, focused, selected bool) string {
 	// Number
 	numStr := ""
 	if player.Number > 0 {
@@ -160,6 +187,9 @@ func (d *FormationsDialog) renderPlayerLine(player api.PlayerInfo, width int, fo
 		posStyle = dialogDimStyle
 		nameStyle = dialogDimStyle
 	}
+	if selected {
+		nameStyle = dialogTeamStyle
+	}
 
 	// Render rating with badge for high ratings
 	ratingRendered := d.renderRating(player.Rating, focused)
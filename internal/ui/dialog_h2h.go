@@ -0,0 +1,128 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// HeadToHeadDialog displays the record and recent meetings of a match's two
+// teams.
+type HeadToHeadDialog struct {
+	match       api.Match
+	h2h         *api.HeadToHead
+	browsing    bool // V moves on to the next upcoming match
+	scrollIndex int
+}
+
+// NewHeadToHeadDialog creates a new head-to-head dialog. With browsing set,
+// V returns DialogActionNext.
+func NewHeadToHeadDialog(match api.Match, h2h *api.HeadToHead, browsing bool) *HeadToHeadDialog {
+	return &HeadToHeadDialog{
+		match:       match,
+		h2h:         h2h,
+		browsing:    browsing,
+		scrollIndex: 0,
+	}
+}
+
+// ID returns the dialog identifier.
+func (d *HeadToHeadDialog) ID() string {
+	return HeadToHeadDialogID
+}
+
+// Update handles input for the head-to-head dialog.
+func (d *HeadToHeadDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "v", "q":
+			return d, DialogActionClose{}
+		case "V":
+			if d.browsing {
+				return d, DialogActionNext{}
+			}
+			return d, DialogActionClose{}
+		case "j", "down":
+			d.scrollIndex = scrollDown(d.scrollIndex, len(d.renderLines(0))-1)
+		case "k", "up":
+			d.scrollIndex = scrollUp(d.scrollIndex)
+		}
+	}
+	return d, nil
+}
+
+// View renders the head-to-head record.
+func (d *HeadToHeadDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 72, 30)
+	innerWidth := dialogWidth - 6 // account for padding and border
+	visibleRows := dialogHeight - 8
+	if visibleRows < 1 {
+		visibleRows = 10
+	}
+
+	lines := d.renderLines(innerWidth)
+	start := min(d.scrollIndex, max(len(lines)-1, 0))
+	end := min(start+visibleRows, len(lines))
+	content := lipgloss.JoinVertical(lipgloss.Left, lines[start:end]...)
+
+	help := constants.HelpHeadToHeadDialog
+	if d.browsing {
+		help = constants.HelpHeadToHeadUpcoming
+	}
+	return RenderDialogFrameWithHelp("Head-to-Head", content, help, dialogWidth, dialogHeight)
+}
+
+// renderLines renders every line of the dialog; View shows a scrolled window.
+func (d *HeadToHeadDialog) renderLines(width int) []string {
+	home, away := teamDisplayName(d.match.HomeTeam), teamDisplayName(d.match.AwayTeam)
+	fixture := dialogTeamStyle.Render(home) + dialogDimStyle.Render(" vs ") + dialogTeamStyle.Render(away)
+	if d.match.MatchTime != nil {
+		fixture += dialogDimStyle.Render("  " + d.match.MatchTime.Local().Format("Mon 02 Jan 15:04"))
+	}
+	lines := []string{fixture, ""}
+
+	if d.h2h == nil {
+		return append(lines, dialogDimStyle.Render("No head-to-head data available"))
+	}
+	lines = append(lines,
+		dialogLabelStyle.Render(home)+dialogValueStyle.Render(fmt.Sprintf("%d wins", d.h2h.HomeWins)),
+		dialogLabelStyle.Render("Draws")+dialogValueStyle.Render(fmt.Sprintf("%d", d.h2h.Draws)),
+		dialogLabelStyle.Render(away)+dialogValueStyle.Render(fmt.Sprintf("%d wins", d.h2h.AwayWins)),
+		"",
+		dialogHeaderStyle.Render("Recent Meetings"),
+		dialogSeparatorStyle.Render(strings.Repeat("─", max(width, 0))),
+	)
+	if len(d.h2h.Matches) == 0 {
+		return append(lines, dialogDimStyle.Render("No previous meetings"))
+	}
+	for _, m := range d.h2h.Matches {
+		date, middle := "", "vs"
+		if m.MatchTime != nil {
+			date = m.MatchTime.Local().Format("02 Jan 2006")
+		}
+		if m.HomeScore != nil && m.AwayScore != nil {
+			middle = fmt.Sprintf("%d-%d", *m.HomeScore, *m.AwayScore)
+		}
+		lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top,
+			dialogDimStyle.Width(13).Render(date),
+			dialogValueStyle.Render(teamDisplayName(m.HomeTeam)),
+			dialogValueStyle.Render(" "+middle+" "),
+			dialogValueStyle.Render(teamDisplayName(m.AwayTeam)),
+			dialogDimStyle.Render("  "+m.League.Name),
+		))
+	}
+	return lines
+}
+
+// teamDisplayName prefers a team's short name.
+func teamDisplayName(t api.Team) string {
+	if t.ShortName != "" {
+		return t.ShortName
+	}
+	return t.Name
+}
@@ -0,0 +1,42 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestHeadToHeadDialog_View(t *testing.T) {
+	city := api.Team{ID: 50, Name: "Manchester City", ShortName: "Man City"}
+	arsenal := api.Team{ID: 42, Name: "Arsenal"}
+	played := time.Date(2025, 3, 2, 16, 30, 0, 0, time.UTC)
+	one, two := 1, 2
+	d := NewHeadToHeadDialog(api.Match{ID: 1, HomeTeam: city, AwayTeam: arsenal}, &api.HeadToHead{
+		HomeWins: 3, Draws: 1, AwayWins: 2,
+		Matches: []api.Match{{ID: 2, HomeTeam: arsenal, AwayTeam: city, HomeScore: &two, AwayScore: &one,
+			MatchTime: &played, League: api.League{Name: "Premier League"}}},
+	}, false)
+	if d.ID() != HeadToHeadDialogID {
+		t.Errorf("ID() = %q, want %q", d.ID(), HeadToHeadDialogID)
+	}
+	out := d.View(120, 60)
+	for _, want := range []string{"Man City vs Arsenal", "3 wins", "2 wins", "Recent Meetings", "2025", "Arsenal 2-1 Man City", "Premier League"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("View() missing %q", want)
+		}
+	}
+
+	if _, action := d.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("V")}); action != (DialogActionClose{}) {
+		t.Errorf("V outside the upcoming list: action = %#v, want DialogActionClose", action)
+	}
+	browsing := NewHeadToHeadDialog(api.Match{HomeTeam: city, AwayTeam: arsenal}, nil, true)
+	if _, action := browsing.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("V")}); action != (DialogActionNext{}) {
+		t.Errorf("V in the upcoming list: action = %#v, want DialogActionNext", action)
+	}
+	if out := browsing.View(120, 60); !strings.Contains(out, "No head-to-head data available") {
+		t.Errorf("View() without data = %q", out)
+	}
+}
@@ -0,0 +1,109 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// PlayerDialog displays one lineup player's match stats. It is opened on top
+// of the formations dialog.
+type PlayerDialog struct {
+	player   api.PlayerInfo
+	teamName string
+}
+
+// NewPlayerDialog creates a new player dialog.
+func NewPlayerDialog(player api.PlayerInfo, teamName string) *PlayerDialog {
+	return &PlayerDialog{
+		player:   player,
+		teamName: teamName,
+	}
+}
+
+// ID returns the dialog identifier.
+func (d *PlayerDialog) ID() string {
+	return PlayerDialogID
+}
+
+// Update handles input for the player dialog.
+func (d *PlayerDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "enter", "q":
+			return d, DialogActionClose{}
+		}
+	}
+	return d, nil
+}
+
+// View renders the player's match stats.
+func (d *PlayerDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 52, 20)
+	content := lipgloss.JoinVertical(lipgloss.Left, d.renderLines()...)
+	return RenderDialogFrameWithHelp(d.player.Name, content, constants.HelpPlayerDialog, dialogWidth, dialogHeight)
+}
+
+// renderLines renders the player's team, shirt and rating, then their stats.
+func (d *PlayerDialog) renderLines() []string {
+	p := d.player
+	header := []string{d.teamName}
+	if p.Number > 0 {
+		header = append(header, fmt.Sprintf("#%d", p.Number))
+	}
+	if p.Position != "" {
+		header = append(header, p.Position)
+	}
+	lines := []string{dialogTeamStyle.Render(strings.Join(header, " · "))}
+	if p.Rating != "" {
+		lines = append(lines, d.row("Rating", p.Rating))
+	}
+	lines = append(lines, "")
+
+	s := p.Stats
+	if s == nil {
+		return append(lines, dialogDimStyle.Render("No match stats available"))
+	}
+	lines = append(lines,
+		d.row("Minutes", fmt.Sprintf("%d'", s.Minutes)),
+		d.row("Goals", fmt.Sprintf("%d", s.Goals)),
+		d.row("Assists", fmt.Sprintf("%d", s.Assists)),
+		d.row("Shots", fmt.Sprintf("%d (%d on target)", s.Shots, s.ShotsOnTarget)),
+		d.row("Passes", ratio(s.PassesCompleted, s.Passes)),
+		d.row("Duels won", ratio(s.DuelsWon, s.Duels)),
+		d.row("Cards", cards(s.YellowCards, s.RedCards)),
+	)
+	return lines
+}
+
+func (d *PlayerDialog) row(label, value string) string {
+	return dialogLabelStyle.Render(label) + dialogValueStyle.Render(value)
+}
+
+// ratio formats a success count as "17/21 (81%)".
+func ratio(won, total int) string {
+	if total == 0 {
+		return fmt.Sprintf("%d/0", won)
+	}
+	return fmt.Sprintf("%d/%d (%d%%)", won, total, (won*100+total/2)/total)
+}
+
+// cards formats card counts as "1 yellow, 1 red", or "None".
+func cards(yellow, red int) string {
+	var parts []string
+	if yellow > 0 {
+		parts = append(parts, fmt.Sprintf("%d yellow", yellow))
+	}
+	if red > 0 {
+		parts = append(parts, fmt.Sprintf("%d red", red))
+	}
+	if len(parts) == 0 {
+		return "None"
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestPlayerDialog_View(t *testing.T) {
+	d := NewPlayerDialog(api.PlayerInfo{
+		ID: 737066, Name: "Erling Haaland", Number: 9, Rating: "8.1",
+		Stats: &api.PlayerMatchStats{Minutes: 90, Goals: 1, Shots: 4, ShotsOnTarget: 2, Passes: 21, PassesCompleted: 17, Duels: 8, DuelsWon: 3, YellowCards: 1},
+	}, "Man City")
+	if d.ID() != PlayerDialogID {
+		t.Errorf("ID() = %q, want %q", d.ID(), PlayerDialogID)
+	}
+	out := d.View(120, 60)
+	for _, want := range []string{"Erling Haaland", "Man City · #9", "8.1", "90'", "4 (2 on target)", "17/21 (81%)", "3/8", "1 yellow"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("View() missing %q", want)
+		}
+	}
+
+	if out := NewPlayerDialog(api.PlayerInfo{Name: "Rodri"}, "Man City").View(120, 60); !strings.Contains(out, "No match stats available") {
+		t.Errorf("View() without stats = %q", out)
+	}
+}
+
+func TestFormationsDialog_EnterOpensSelectedPlayer(t *testing.T) {
+	home := []api.PlayerInfo{{ID: 1, Name: "Ederson"}, {ID: 2, Name: "Ruben Dias"}}
+	away := []api.PlayerInfo{{ID: 3, Name: "David Raya"}}
+	d := NewFormationsDialog("Man City", "Arsenal", "4-3-3", "4-2-3-1", home, away)
+
+	d.Update(tea.KeyMsg{Type: tea.KeyDown})
+	d.Update(tea.KeyMsg{Type: tea.KeyDown}) // capped at the last player
+	_, action := d.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	open, ok := action.(DialogActionOpen)
+	if !ok {
+		t.Fatalf("Enter: action = %#v, want DialogActionOpen", action)
+	}
+	if p := open.Dialog.(*PlayerDialog); p.player.ID != 2 || p.teamName != "Man City" {
+		t.Errorf("opened %+v for %q, want Ruben Dias for Man City", p.player, p.teamName)
+	}
+
+	// Switching team clamps the selection to the shorter lineup.
+	d.Update(tea.KeyMsg{Type: tea.KeyTab})
+	_, action = d.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if p := action.(DialogActionOpen).Dialog.(*PlayerDialog); p.player.ID != 3 || p.teamName != "Arsenal" {
+		t.Errorf("after Tab opened %+v for %q, want David Raya for Arsenal", p.player, p.teamName)
+	}
+}
@@ -0,0 +1,223 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	"github.com/0xjuanma/golazo/internal/constants"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TeamDialog displays a team's standing, form, recent results, upcoming
+// fixtures and squad.
+type TeamDialog struct {
+	team        *api.TeamOverview
+	scrollIndex int
+}
+
+// NewTeamDialog creates a new team dialog.
+func NewTeamDialog(team *api.TeamOverview) *TeamDialog {
+	return &TeamDialog{
+		team:        team,
+		scrollIndex: 0,
+	}
+}
+
+// ID returns the dialog identifier.
+func (d *TeamDialog) ID() string {
+	return TeamDialogID
+}
+
+// Update handles input for the team dialog.
+func (d *TeamDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "esc", "t", "T", "q":
+			return d, DialogActionClose{}
+		case "j", "down":
+			d.scrollIndex = scrollDown(d.scrollIndex, len(d.renderLines(0))-1)
+		case "k", "up":
+			d.scrollIndex = scrollUp(d.scrollIndex)
+		}
+	}
+	return d, nil
+}
+
+// View renders the team overview.
+func (d *TeamDialog) View(width, height int) string {
+	dialogWidth, dialogHeight := DialogSize(width, height, 72, 36)
+	innerWidth := dialogWidth - 6 // account for padding and border
+	visibleRows := dialogHeight - 8
+	if visibleRows < 1 {
+		visibleRows = 10
+	}
+
+	lines := d.renderLines(innerWidth)
+	start := min(d.scrollIndex, max(len(lines)-1, 0))
+	end := min(start+visibleRows, len(lines))
+	content := lipgloss.JoinVertical(lipgloss.Left, lines[start:end]...)
+
+	title := "Team"
+	if d.team != nil {
+		title = d.team.Team.Name
+	}
+	return RenderDialogFrameWithHelp(title, content, constants.HelpTeamDialog, dialogWidth, dialogHeight)
+}
+
+// renderLines renders every line of the dialog; View shows a scrolled window.
+func (d *TeamDialog) renderLines(width int) []string {
+	if d.team == nil {
+		return []string{dialogDimStyle.Render("No team data available")}
+	}
+	t := d.team
+	var lines []string
+
+	if t.Standing != nil {
+		league := ""
+		if t.League != nil {
+			league = t.League.Name + "  "
+		}
+		lines = append(lines, dialogLabelStyle.Render(league)+dialogValueStyle.Render(
+			fmt.Sprintf("%s · %d pts · %d played", ordinal(t.Standing.Position), t.Standing.Points, t.Standing.Played)))
+	} else if t.League != nil {
+		lines = append(lines, dialogLabelStyle.Render(t.League.Name))
+	}
+	if t.Form != "" {
+		lines = append(lines, dialogLabelStyle.Render("Form  ")+renderForm(t.Form))
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, d.renderSectionHeader("Last Results", width)...)
+	lines = append(lines, d.renderMatchLines(t.Results, "No recent results")...)
+	lines = append(lines, "")
+	lines = append(lines, d.renderSectionHeader("Next Fixtures", width)...)
+	lines = append(lines, d.renderMatchLines(t.Fixtures, "No upcoming fixtures")...)
+
+	lines = append(lines, "")
+	lines = append(lines, d.renderSectionHeader("Squad", width)...)
+	if len(t.Squad) == 0 {
+		lines = append(lines, dialogDimStyle.Render("No squad data available"))
+	}
+	position := ""
+	for _, p := range t.Squad {
+		if p.Position != position {
+			position = p.Position
+			lines = append(lines, dialogHeaderStyle.Render(squadGroupTitle(position)))
+		}
+		number := "" // Coaches have no shirt number
+		if p.Number > 0 {
+			number = fmt.Sprintf("%d", p.Number)
+		}
+		detail := p.Country
+		if p.Age > 0 {
+			detail = strings.TrimPrefix(fmt.Sprintf("%s, %d", p.Country, p.Age), ", ")
+		}
+		lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top,
+			dialogAlignRight(3, number),
+			"  ",
+			dialogValueStyle.Render(p.Name),
+			"  ",
+			dialogDimStyle.Render(detail),
+		))
+	}
+	return lines
+}
+
+// renderSectionHeader renders a section title and its separator as two lines.
+func (d *TeamDialog) renderSectionHeader(title string, width int) []string {
+	return []string{
+		dialogHeaderStyle.Render(title),
+		dialogSeparatorStyle.Render(strings.Repeat("─", max(width, 0))),
+	}
+}
+
+// renderMatchLines renders one line per match: date, teams and the score
+// (or kickoff time), with this team's name highlighted.
+func (d *TeamDialog) renderMatchLines(matches []api.Match, empty string) []string {
+	if len(matches) == 0 {
+		return []string{dialogDimStyle.Render(empty)}
+	}
+	lines := make([]string, 0, len(matches))
+	for _, m := range matches {
+		date, middle := "", "vs"
+		if m.MatchTime != nil {
+			local := m.MatchTime.Local()
+			date = local.Format("02 Jan")
+			middle = local.Format("15:04")
+		}
+		if m.HomeScore != nil && m.AwayScore != nil {
+			middle = fmt.Sprintf("%d-%d", *m.HomeScore, *m.AwayScore)
+		}
+		lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top,
+			dialogDimStyle.Width(8).Render(date),
+			d.teamName(m.HomeTeam),
+			dialogValueStyle.Render(" "+middle+" "),
+			d.teamName(m.AwayTeam),
+		))
+	}
+	return lines
+}
+
+func (d *TeamDialog) teamName(t api.Team) string {
+	name := t.ShortName
+	if name == "" {
+		name = t.Name
+	}
+	if t.ID == d.team.Team.ID {
+		return dialogTeamStyle.Render(name)
+	}
+	return dialogValueStyle.Render(name)
+}
+
+// renderForm colors a W/D/L form string: wins cyan, draws dim, losses red.
+func renderForm(form string) string {
+	var b strings.Builder
+	for _, r := range form {
+		style := dialogDimStyle
+		switch r {
+		case 'W':
+			style = lipgloss.NewStyle().Foreground(neonCyan).Bold(true)
+		case 'L':
+			style = lipgloss.NewStyle().Foreground(neonRed).Bold(true)
+		}
+		b.WriteString(style.Render(string(r)))
+		b.WriteString(" ")
+	}
+	return strings.TrimSuffix(b.String(), " ")
+}
+
+// squadGroupTitle is the squad section heading for an api position.
+func squadGroupTitle(position string) string {
+	switch position {
+	case api.PositionCoach:
+		return "Coach"
+	case api.PositionGoalkeeper:
+		return "Goalkeepers"
+	case api.PositionDefender:
+		return "Defenders"
+	case api.PositionMidfielder:
+		return "Midfielders"
+	case api.PositionForward:
+		return "Forwards"
+	}
+	return position
+}
+
+// ordinal formats a league position as "1st", "2nd", "3rd", "4th", ...
+func ordinal(n int) string {
+	suffix := "th"
+	if n%100 < 11 || n%100 > 13 {
+		switch n % 10 {
+		case 1:
+			suffix = "st"
+		case 2:
+			suffix = "nd"
+		case 3:
+			suffix = "rd"
+		}
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
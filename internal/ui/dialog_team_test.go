@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/0xjuanma/golazo/internal/api"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func stubTeamOverview() *api.TeamOverview {
+	home, away := 3, 1
+	kickoff := time.Date(2026, 1, 7, 19, 30, 0, 0, time.UTC)
+	city := api.Team{ID: 8456, Name: "Manchester City", ShortName: "Man City"}
+	return &api.TeamOverview{
+		Team:     city,
+		League:   &api.League{ID: 47, Name: "Premier League"},
+		Standing: &api.LeagueTableEntry{Position: 2, Points: 41, Played: 20},
+		Form:     "WDL",
+		Results: []api.Match{{
+			ID: 1, HomeTeam: city, AwayTeam: api.Team{ID: 10204, Name: "Brighton"},
+			HomeScore: &home, AwayScore: &away, MatchTime: &kickoff, Status: api.MatchStatusFinished,
+		}},
+		Squad: []api.SquadMember{
+			{ID: 1, Name: "Pep Guardiola", Position: api.PositionCoach},
+			{ID: 2, Name: "Erling Haaland", Number: 9, Position: api.PositionForward, Country: "Norway", Age: 26},
+		},
+	}
+}
+
+func TestTeamDialog_View(t *testing.T) {
+	d := NewTeamDialog(stubTeamOverview())
+	if d.ID() != TeamDialogID {
+		t.Errorf("ID() = %q, want %q", d.ID(), TeamDialogID)
+	}
+	out := d.View(120, 60)
+	for _, want := range []string{"Manchester City", "2nd · 41 pts", "3-1", "Brighton", "No upcoming fixtures", "Forwards", "Erling Haaland", "Norway, 26"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("View() missing %q", want)
+		}
+	}
+}
+
+func TestTeamDialog_ScrollAndClose(t *testing.T) {
+	d := NewTeamDialog(stubTeamOverview())
+	for range 100 {
+		d.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	if last := len(d.renderLines(0)) - 1; d.scrollIndex != last {
+		t.Errorf("scrollIndex = %d, want it capped at %d", d.scrollIndex, last)
+	}
+	if _, action := d.Update(tea.KeyMsg{Type: tea.KeyEsc}); action == nil {
+		t.Error("Esc: want a close action")
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	for n, want := range map[int]string{1: "1st", 2: "2nd", 3: "3rd", 4: "4th", 11: "11th", 12: "12th", 13: "13th", 21: "21st", 22: "22nd"} {
+		if got := ordinal(n); got != want {
+			t.Errorf("ordinal(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
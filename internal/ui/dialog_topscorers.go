@@ -10,16 +10,49 @@ import (
 	"github.com/charmbracelet/lipgloss"
 )
 
-// TopScorersDialog displays the World Cup top scorers table.
+// topStatLabels holds each league stat's dialog title and column header.
+var topStatLabels = map[string]struct{ title, column string }{
+	api.StatGoals:       {"Top Scorers", "Goals"},
+	api.StatAssists:     {"Top Assists", "Assists"},
+	api.StatRating:      {"Top Rated", "Rating"},
+	api.StatCleanSheets: {"Clean Sheets", "CS"},
+	api.StatYellowCards: {"Yellow Cards", "Yellow"},
+	api.StatRedCards:    {"Red Cards", "Red"},
+}
+
+// TopScorersDialog displays a ranked player stat list: the World Cup top
+// scorers, or a league's goals, assists, ratings, clean sheets or cards.
 type TopScorersDialog struct {
-	scorers     []api.WCTopScorer
+	title       string
+	stat        string
+	league      *api.League // set for league dialogs, whose stat can be switched
+	entries     []api.LeagueStatEntry
 	scrollIndex int
 }
 
-// NewTopScorersDialog creates a new top scorers dialog.
+// NewTopScorersDialog creates the World Cup top scorers dialog.
 func NewTopScorersDialog(scorers []api.WCTopScorer) *TopScorersDialog {
+	entries := make([]api.LeagueStatEntry, 0, len(scorers))
+	for i, s := range scorers {
+		entries = append(entries, api.LeagueStatEntry{Rank: i + 1, PlayerName: s.PlayerName, Team: s.Team, Value: float64(s.Goals)})
+	}
 	return &TopScorersDialog{
-		scorers:     scorers,
+		title:       "World Cup Top Scorers",
+		stat:        api.StatGoals,
+		entries:     entries,
+		scrollIndex: 0,
+	}
+}
+
+// NewLeagueStatsDialog creates a dialog for one of a league's stat lists
+// (see api.TopStats). Tab and ←/→ ask for the league's other stats with
+// DialogActionSwitchStat.
+func NewLeagueStatsDialog(league api.League, stat string, entries []api.LeagueStatEntry) *TopScorersDialog {
+	return &TopScorersDialog{
+		title:       league.Name + " " + topStatLabels[stat].title,
+		stat:        stat,
+		league:      &league,
+		entries:     entries,
 		scrollIndex: 0,
 	}
 }
@@ -34,10 +67,14 @@ func (d *TopScorersDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch msg.String() {
-		case "esc", "s", "q":
+		case "esc", "s", "p", "q":
 			return d, DialogActionClose{}
+		case "tab", "l", "right":
+			return d, d.switchStat(1)
+		case "shift+tab", "h", "left":
+			return d, d.switchStat(-1)
 		case "j", "down":
-			d.scrollIndex = scrollDown(d.scrollIndex, len(d.scorers)-1)
+			d.scrollIndex = scrollDown(d.scrollIndex, len(d.entries)-1)
 		case "k", "up":
 			d.scrollIndex = scrollUp(d.scrollIndex)
 		}
@@ -45,23 +82,60 @@ func (d *TopScorersDialog) Update(msg tea.Msg) (Dialog, DialogAction) {
 	return d, nil
 }
 
-// View renders the top scorers table.
+// switchStat returns the action asking for the stat step places away in
+// api.TopStats, or nil for the World Cup dialog.
+func (d *TopScorersDialog) switchStat(step int) DialogAction {
+	if d.league == nil {
+		return nil
+	}
+	i := 0
+	for j, s := range api.TopStats {
+		if s == d.stat {
+			i = j
+		}
+	}
+	n := len(api.TopStats)
+	return DialogActionSwitchStat{League: *d.league, Stat: api.TopStats[((i+step)%n+n)%n]}
+}
+
+// View renders the stat table.
 func (d *TopScorersDialog) View(width, height int) string {
 	dialogWidth, dialogHeight := DialogSize(width, height, 72, 34)
 	innerWidth := dialogWidth - 6 // account for padding and border
-	content := d.renderTable(innerWidth, dialogHeight-8)
-	return RenderDialogFrameWithHelp("World Cup Top Scorers", content, constants.HelpTopScorersDialog, dialogWidth, dialogHeight)
+	if d.league == nil {
+		content := d.renderTable(innerWidth, dialogHeight-8)
+		return RenderDialogFrameWithHelp(d.title, content, constants.HelpTopScorersDialog, dialogWidth, dialogHeight)
+	}
+	content := lipgloss.JoinVertical(lipgloss.Left, d.renderStatTabs(), "", d.renderTable(innerWidth, dialogHeight-10))
+	return RenderDialogFrameWithHelp(d.title, content, constants.HelpLeagueStatsDialog, dialogWidth, dialogHeight)
+}
+
+// renderStatTabs lists the switchable stats, highlighting the current one.
+func (d *TopScorersDialog) renderStatTabs() string {
+	tabs := make([]string, 0, len(api.TopStats))
+	for _, s := range api.TopStats {
+		label := topStatLabels[s].column
+		if s == d.stat {
+			tabs = append(tabs, dialogHeaderStyle.Render(label))
+		} else {
+			tabs = append(tabs, dialogDimStyle.Render(label))
+		}
+	}
+	return strings.Join(tabs, dialogDimStyle.Render(" · "))
 }
 
 // Column widths
 const (
 	scorerColRank  = 4
-	scorerColGoals = 6
+	scorerColValue = 8
 )
 
 func (d *TopScorersDialog) renderTable(width, visibleRows int) string {
-	if len(d.scorers) == 0 {
-		return dialogDimStyle.Render("No scorer data available")
+	if len(d.entries) == 0 {
+		if d.stat == api.StatGoals {
+			return dialogDimStyle.Render("No scorer data available")
+		}
+		return dialogDimStyle.Render("No player stats available")
 	}
 
 	if visibleRows < 1 {
@@ -69,7 +143,7 @@ func (d *TopScorersDialog) renderTable(width, visibleRows int) string {
 	}
 
 	teamWidth := 16
-	nameWidth := width - scorerColRank - teamWidth - scorerColGoals - 4
+	nameWidth := width - scorerColRank - teamWidth - scorerColValue - 4
 
 	var lines []string
 
@@ -79,7 +153,7 @@ func (d *TopScorersDialog) renderTable(width, visibleRows int) string {
 		"  ",
 		dialogHeaderStyle.Width(nameWidth).Align(lipgloss.Left).Render("Player"),
 		dialogHeaderStyle.Width(teamWidth).Align(lipgloss.Left).Render("Team"),
-		dialogHeaderStyle.Width(scorerColGoals).Align(lipgloss.Right).Render("Goals"),
+		dialogHeaderStyle.Width(scorerColValue).Align(lipgloss.Right).Render(topStatLabels[d.stat].column),
 	)
 	lines = append(lines, header)
 
@@ -89,31 +163,39 @@ func (d *TopScorersDialog) renderTable(width, visibleRows int) string {
 	// Visible window
 	start := d.scrollIndex
 	end := start + visibleRows
-	if end > len(d.scorers) {
-		end = len(d.scorers)
+	if end > len(d.entries) {
+		end = len(d.entries)
 	}
 
-	for i, s := range d.scorers[start:end] {
-		rank := start + i + 1
-		lines = append(lines, d.renderScorerRow(rank, s, nameWidth, teamWidth, width))
+	for i, e := range d.entries[start:end] {
+		lines = append(lines, d.renderEntryRow(start+i, e, nameWidth, teamWidth, width))
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
 }
 
-func (d *TopScorersDialog) renderScorerRow(rank int, s api.WCTopScorer, nameWidth, teamWidth, rowWidth int) string {
-	name := truncateString(s.PlayerName, nameWidth-1)
-	team := truncateString(s.Team, teamWidth-1)
+// renderEntryRow renders the entry at index; the leader's row is highlighted.
+func (d *TopScorersDialog) renderEntryRow(index int, e api.LeagueStatEntry, nameWidth, teamWidth, rowWidth int) string {
+	name := truncateString(e.PlayerName, nameWidth-1)
+	team := truncateString(e.Team, teamWidth-1)
+	rank := e.Rank
+	if rank == 0 {
+		rank = index + 1
+	}
+	value := fmt.Sprintf("%d", int(e.Value))
+	if d.stat == api.StatRating {
+		value = fmt.Sprintf("%.2f", e.Value)
+	}
 
 	row := lipgloss.JoinHorizontal(lipgloss.Top,
 		dialogAlignRight(scorerColRank, fmt.Sprintf("%d", rank)),
 		"  ",
 		dialogAlignLeft(nameWidth, name),
 		dialogAlignLeft(teamWidth, team),
-		dialogAlignRight(scorerColGoals, fmt.Sprintf("%d", s.Goals)),
+		dialogAlignRight(scorerColValue, value),
 	)
 
-	if rank == 1 {
+	if index == 0 {
 		return lipgloss.NewStyle().
 			Background(neonDark).
 			Foreground(neonCyan).
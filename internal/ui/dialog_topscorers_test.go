@@ -80,3 +80,30 @@ func TestTopScorersDialog_EmptyScorers(t *testing.T) {
 		t.Error("View() with nil scorers should show empty state message")
 	}
 }
+
+func TestLeagueStatsDialog_ViewAndSwitchStat(t *testing.T) {
+	league := api.League{ID: 47, Name: "Premier League"}
+	d := NewLeagueStatsDialog(league, api.StatRating, []api.LeagueStatEntry{
+		{Rank: 1, PlayerName: "Mohamed Salah", Team: "Liverpool", Value: 7.92},
+	})
+	out := d.View(120, 40)
+	for _, want := range []string{"Premier League Top Rated", "Rating", "Mohamed Salah", "7.92", "Assists"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("View() missing %q", want)
+		}
+	}
+
+	_, action := d.Update(tea.KeyMsg{Type: tea.KeyTab})
+	if got, ok := action.(DialogActionSwitchStat); !ok || got.Stat != api.StatCleanSheets || got.League.ID != 47 {
+		t.Errorf("Tab: action = %#v, want clean sheets of league 47", action)
+	}
+	// ← from the first stat wraps around to the last.
+	first := NewLeagueStatsDialog(league, api.StatGoals, nil)
+	if _, action := first.Update(tea.KeyMsg{Type: tea.KeyLeft}); action != (DialogActionSwitchStat{League: league, Stat: api.StatRedCards}) {
+		t.Errorf("←: action = %#v, want red cards", action)
+	}
+	// The World Cup dialog has no other stats.
+	if _, action := NewTopScorersDialog(stubTopScorers()).Update(tea.KeyMsg{Type: tea.KeyTab}); action != nil {
+		t.Errorf("World Cup Tab: action = %#v, want nil", action)
+	}
+}
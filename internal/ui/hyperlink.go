@@ -85,6 +85,12 @@ func CreateGoalLinkDisplay(goalText, replayURL string) string {
 	return goalText + " ▶ [" + replayURL + "]"
 }
 
+// SupportsHyperlinks reports whether goal replay links will render as
+// clickable OSC 8 hyperlinks in this terminal. Used by `golazo doctor`.
+func SupportsHyperlinks() bool {
+	return supportsHyperlinks()
+}
+
 // supportsHyperlinks detects if the terminal likely supports OSC 8 hyperlinks.
 // This is a best-effort detection based on common terminal identifiers.
 func supportsHyperlinks() bool {
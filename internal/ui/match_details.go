@@ -2,6 +2,8 @@ package ui
 
 import (
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/0xjuanma/golazo/internal/api"
@@ -23,6 +25,8 @@ type MatchDetailsConfig struct {
 
 	// Live view state
 	LiveUpdates    []string
+	Commentary     []api.CommentaryEntry // Shown instead of LiveUpdates when ShowCommentary
+	ShowCommentary bool
 	PollingSpinner *RandomCharSpinner
 	IsPolling      bool
 	Loading        bool
@@ -132,6 +136,13 @@ func RenderMatchDetails(cfg MatchDetailsConfig) (headerContent, scrollableConten
 			statsSection := renderStatisticsSection(cfg, contentWidth, homeTeam, awayTeam)
 			scrollableLines = append(scrollableLines, statsSection)
 		}
+
+		// Momentum, xG and best chances (stats view only)
+		if cfg.ShowStatistics {
+			if flowSection := renderMatchFlowSection(cfg, contentWidth); flowSection != "" {
+				scrollableLines = append(scrollableLines, flowSection)
+			}
+		}
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Left, headerLines...),
@@ -436,6 +447,116 @@ func renderStatisticsSection(cfg MatchDetailsConfig, contentWidth int, homeTeam,
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
 }
 
+// renderMatchFlowSection shows the xG totals, a momentum graph and the
+// biggest chances. Returns "" when the provider sent none of them.
+func renderMatchFlowSection(cfg MatchDetailsConfig, contentWidth int) string {
+	details := cfg.Details
+	if details.HomeXG == nil && len(details.Momentum) == 0 && len(details.Shots) == 0 {
+		return ""
+	}
+
+	var lines []string
+	lines = append(lines, "")
+	lines = append(lines, neonHeaderStyle.Render("Match Flow"))
+
+	centerStyle := lipgloss.NewStyle().Width(contentWidth).Align(lipgloss.Center)
+
+	if details.HomeXG != nil && details.AwayXG != nil {
+		lines = append(lines, "")
+		xgLine := renderStatComparison("Expected Goals (xG)",
+			strconv.FormatFloat(*details.HomeXG, 'f', 2, 64),
+			strconv.FormatFloat(*details.AwayXG, 'f', 2, 64), contentWidth)
+		lines = append(lines, centerStyle.Render(xgLine))
+	}
+
+	if len(details.Momentum) > 0 {
+		home, away := momentumSparkline(details.Momentum, min(statBarWidth*2, contentWidth-4))
+		lines = append(lines, "")
+		lines = append(lines, centerStyle.Render(lipgloss.NewStyle().Foreground(neonDim).Render("Momentum")))
+		lines = append(lines, centerStyle.Render(lipgloss.NewStyle().Foreground(neonCyan).Render(home)))
+		lines = append(lines, centerStyle.Render(lipgloss.NewStyle().Foreground(neonGray).Render(away)))
+	}
+
+	if chances := bestChances(details.Shots, 3); len(chances) > 0 {
+		lines = append(lines, "")
+		lines = append(lines, centerStyle.Render(lipgloss.NewStyle().Foreground(neonDim).Render("Best Chances")))
+		for _, shot := range chances {
+			minute := fmt.Sprintf("%d'", shot.Minute)
+			if shot.AddedMinute > 0 {
+				minute = fmt.Sprintf("%d+%d'", shot.Minute, shot.AddedMinute)
+			}
+			content := fmt.Sprintf("%s %.2f xG (%s)", shot.Player, shot.XG, shot.Outcome)
+			lines = append(lines, renderCenterAlignedEvent(minute, neonValueStyle.Render(content), shot.IsHome, contentWidth))
+		}
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// sparkBlocks are the bar heights used by momentumSparkline, lowest first.
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// momentumSparkline squeezes the momentum series into width columns. The
+// home row shows positive (home) pressure and the away row negative (away)
+// pressure; a column is blank on the side that did not have the upper hand.
+func momentumSparkline(points []api.MomentumPoint, width int) (home, away string) {
+	if len(points) == 0 || width <= 0 {
+		return "", ""
+	}
+	width = min(width, len(points))
+
+	var homeRow, awayRow []rune
+	for col := range width {
+		from := col * len(points) / width
+		to := (col + 1) * len(points) / width
+		sum := 0
+		for _, p := range points[from:to] {
+			sum += p.Value
+		}
+		avg := sum / (to - from)
+
+		magnitude := avg
+		if magnitude < 0 {
+			magnitude = -magnitude
+		}
+		level := min(magnitude*len(sparkBlocks)/101, len(sparkBlocks)-1)
+		switch {
+		case avg > 0:
+			homeRow = append(homeRow, sparkBlocks[level])
+			awayRow = append(awayRow, ' ')
+		case avg < 0:
+			homeRow = append(homeRow, ' ')
+			awayRow = append(awayRow, sparkBlocks[level])
+		default:
+			homeRow = append(homeRow, ' ')
+			awayRow = append(awayRow, ' ')
+		}
+	}
+	return string(homeRow), string(awayRow)
+}
+
+// bestChances returns the n non-own-goal shots with the highest xG, in
+// match order.
+func bestChances(shots []api.Shot, n int) []api.Shot {
+	var candidates []api.Shot
+	for _, s := range shots {
+		if !s.OwnGoal && s.XG > 0 {
+			candidates = append(candidates, s)
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].XG > candidates[j].XG })
+	if len(candidates) > n {
+		candidates = candidates[:n]
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Minute != candidates[j].Minute {
+			return candidates[i].Minute < candidates[j].Minute
+		}
+		return candidates[i].AddedMinute < candidates[j].AddedMinute
+	})
+	return candidates
+}
+
 func renderLiveUpdatesSection(cfg MatchDetailsConfig, contentWidth int) string {
 	var lines []string
 
@@ -443,6 +564,8 @@ func renderLiveUpdatesSection(cfg MatchDetailsConfig, contentWidth int) string {
 	if cfg.IsPolling && cfg.Loading && cfg.PollingSpinner != nil {
 		pollingView := cfg.PollingSpinner.View()
 		titleText = "Updating...  " + pollingView
+	} else if cfg.ShowCommentary {
+		titleText = constants.PanelCommentary
 	} else {
 		titleText = constants.PanelUpdates
 	}
@@ -458,6 +581,10 @@ func renderLiveUpdatesSection(cfg MatchDetailsConfig, contentWidth int) string {
 		Render(titleText)
 	lines = append(lines, updatesTitle)
 
+	if cfg.ShowCommentary {
+		return lipgloss.JoinVertical(lipgloss.Left, append(lines, renderCommentaryLines(cfg.Commentary, contentWidth)...)...)
+	}
+
 	if len(cfg.LiveUpdates) == 0 && !cfg.Loading && !cfg.IsPolling {
 		emptyUpdates := lipgloss.NewStyle().
 			Foreground(neonDim).
@@ -474,6 +601,28 @@ func renderLiveUpdatesSection(cfg MatchDetailsConfig, contentWidth int) string {
 	return lipgloss.JoinVertical(lipgloss.Left, lines...)
 }
 
+// renderCommentaryLines renders the commentary newest first, like the
+// updates list: the minute in a fixed-width gutter and the text wrapped
+// beside it.
+func renderCommentaryLines(entries []api.CommentaryEntry, contentWidth int) []string {
+	if len(entries) == 0 {
+		return []string{lipgloss.NewStyle().Foreground(neonDim).Render(constants.EmptyNoCommentary)}
+	}
+
+	const gutter = 8
+	minuteStyle := lipgloss.NewStyle().Foreground(neonCyan).Bold(true).Width(gutter)
+	textStyle := lipgloss.NewStyle().Foreground(neonWhite).Width(max(contentWidth-gutter, 10))
+
+	lines := make([]string, 0, len(entries))
+	for i := len(entries) - 1; i >= 0; i-- {
+		e := entries[i]
+		lines = append(lines, lipgloss.JoinHorizontal(lipgloss.Top,
+			minuteStyle.Render(e.DisplayMinute),
+			textStyle.Render(e.Text)))
+	}
+	return lines
+}
+
 // Statistics rendering functions
 
 const statBarWidth = 20
@@ -94,3 +94,71 @@ func TestRenderMatchDetails_NoAggregateSection_WhenEmpty(t *testing.T) {
 		t.Errorf("RenderMatchDetails header should NOT contain %q for non-knockout match", "AGG.")
 	}
 }
+
+func TestMomentumSparkline(t *testing.T) {
+	points := []api.MomentumPoint{{Minute: 1, Value: 100}, {Minute: 2, Value: 100}, {Minute: 3, Value: -50}, {Minute: 4, Value: -10}, {Minute: 5, Value: 0}, {Minute: 6, Value: 0}}
+
+	home, away := momentumSparkline(points, 3)
+	if home != "█  " || away != " ▃ " {
+		t.Errorf("momentumSparkline = %q / %q", home, away)
+	}
+
+	home, _ = momentumSparkline(points, 50)
+	if len([]rune(home)) != len(points) {
+		t.Errorf("width wider than the series: got %d columns, want %d", len([]rune(home)), len(points))
+	}
+}
+
+func TestRenderMatchFlowSection(t *testing.T) {
+	homeXG, awayXG := 1.45, 0.3
+	details := &api.MatchDetails{
+		HomeXG:   &homeXG,
+		AwayXG:   &awayXG,
+		Momentum: []api.MomentumPoint{{Minute: 1, Value: 40}, {Minute: 2, Value: -60}},
+		Shots: []api.Shot{
+			{Minute: 10, Player: "Low", XG: 0.02, IsHome: true, Outcome: api.ShotOutcomeMissed},
+			{Minute: 20, Player: "Haaland", XG: 0.76, IsHome: true, Outcome: api.ShotOutcomeGoal},
+			{Minute: 30, Player: "Own", XG: 0.9, OwnGoal: true, Outcome: api.ShotOutcomeGoal},
+		},
+	}
+
+	out := renderMatchFlowSection(MatchDetailsConfig{Details: details}, 80)
+	for _, want := range []string{"Match Flow", "1.45", "0.30", "Momentum", "Haaland 0.76 xG (goal)"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q in:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Own") {
+		t.Errorf("own goal listed as a chance:\n%s", out)
+	}
+
+	if got := renderMatchFlowSection(MatchDetailsConfig{Details: &api.MatchDetails{}}, 80); got != "" {
+		t.Errorf("no flow data: got %q, want empty", got)
+	}
+}
+
+func TestRenderLiveUpdatesSection_Commentary(t *testing.T) {
+	cfg := MatchDetailsConfig{
+		Width:          80,
+		Details:        &api.MatchDetails{},
+		LiveUpdates:    []string{"● 12' Goal"},
+		ShowCommentary: true,
+		Commentary: []api.CommentaryEntry{
+			{ID: "a", Text: "Kick-off."},
+			{ID: "b", Minute: 45, AddedMinute: 2, DisplayMinute: "45+2'", Text: "Half-time whistle."},
+		},
+	}
+
+	out := renderLiveUpdatesSection(cfg, 74)
+	if !strings.Contains(out, "Commentary") || strings.Contains(out, "12' Goal") {
+		t.Errorf("want the commentary feed instead of updates, got:\n%s", out)
+	}
+	if strings.Index(out, "Half-time whistle.") > strings.Index(out, "Kick-off.") {
+		t.Errorf("want newest first, got:\n%s", out)
+	}
+
+	cfg.Commentary = nil
+	if out := renderLiveUpdatesSection(cfg, 74); !strings.Contains(out, "No commentary") {
+		t.Errorf("empty feed: got:\n%s", out)
+	}
+}
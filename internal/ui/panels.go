@@ -73,12 +73,12 @@ func renderCenterAlignedEvent(minuteStr string, eventContent string, isHomeTeam
 }
 
 // renderMatchDetailsPanelWithPolling renders the right panel with polling spinner support.
-func renderMatchDetailsPanelWithPolling(width, height int, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap) string {
-	return renderMatchDetailsPanelFull(width, height, details, liveUpdates, sp, loading, true, pollingSpinner, isPolling, goalLinks)
+func renderMatchDetailsPanelWithPolling(width, height int, details *api.MatchDetails, liveUpdates []string, commentary []api.CommentaryEntry, showCommentary bool, sp spinner.Model, loading bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap) string {
+	return renderMatchDetailsPanelFull(width, height, details, liveUpdates, commentary, showCommentary, sp, loading, true, pollingSpinner, isPolling, goalLinks)
 }
 
 // renderMatchDetailsPanelFull renders the right panel with match details using unified rendering.
-func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, liveUpdates []string, sp spinner.Model, loading bool, showTitle bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap) string {
+func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, liveUpdates []string, commentary []api.CommentaryEntry, showCommentary bool, sp spinner.Model, loading bool, showTitle bool, pollingSpinner *RandomCharSpinner, isPolling bool, goalLinks GoalLinksMap) string {
 	detailsPanelStyle := lipgloss.NewStyle().Padding(0, 1)
 
 	if details == nil {
@@ -111,6 +111,8 @@ func renderMatchDetailsPanelFull(width, height int, details *api.MatchDetails, l
 		ShowStatistics: false,
 		ShowHighlights: false,
 		LiveUpdates:    liveUpdates,
+		Commentary:     commentary,
+		ShowCommentary: showCommentary,
 		PollingSpinner: pollingSpinner,
 		IsPolling:      isPolling,
 		Loading:        loading,
@@ -144,9 +144,9 @@ func (s *SettingsState) Save() error {
 		}
 	}
 
-	settings := &data.Settings{
-		SelectedLeagues: selectedIDs,
-	}
+	// Keep the keys this screen does not edit (provider and its config).
+	settings, _ := data.LoadSettings()
+	settings.SelectedLeagues = selectedIDs
 
 	err := data.SaveSettings(settings)
 	if err == nil {